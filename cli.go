@@ -0,0 +1,172 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"pont/internal/config"
+)
+
+// cliCommands are the subcommands that talk to a running Pont instance
+var cliCommands = map[string]func(c *apiClient, args []string) error{
+	"list":  cliList,
+	"start": cliStart,
+	"stop":  cliStop,
+}
+
+// runCLI runs a client subcommand against the API of a running instance
+func runCLI(cfg *config.AppConfig, name string, args []string) error {
+	cmd, ok := cliCommands[name]
+	if !ok {
+		return fmt.Errorf("unknown command %q, available: list, start, stop, relocate, migrate", name)
+	}
+	c := &apiClient{
+		baseURL: cfg.APIBaseURL(),
+		token:   cfg.AuthToken,
+		http:    &http.Client{Timeout: 60 * time.Second},
+	}
+	if socket := cfg.CLISocket(); socket != "" {
+		c.socket = socket
+		c.http.Transport = &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				return (&net.Dialer{}).DialContext(ctx, "unix", socket)
+			},
+		}
+	}
+	return cmd(c, args)
+}
+
+// apiClient is a minimal client for the Pont HTTP API
+type apiClient struct {
+	baseURL string
+	socket  string // UNIX_SOCKET the requests go through, if any
+	token   string
+	http    *http.Client
+}
+
+// do sends a request and decodes a JSON response into out, if given
+func (c *apiClient) do(method, path string, out interface{}) error {
+	req, err := http.NewRequest(method, c.baseURL+path, nil)
+	if err != nil {
+		return err
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+	resp, err := c.http.Do(req)
+	if err != nil {
+		if c.socket != "" {
+			return fmt.Errorf("cannot reach Pont on socket %s (set PONT_URL if it runs elsewhere): %w", c.socket, err)
+		}
+		return fmt.Errorf("cannot reach Pont at %s (set PONT_URL if it runs elsewhere): %w", c.baseURL, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("%s", strings.TrimSpace(string(body)))
+	}
+	if out == nil {
+		return nil
+	}
+	return json.Unmarshal(body, out)
+}
+
+// resolveTunnel accepts a tunnel ID or name and returns the ID
+func (c *apiClient) resolveTunnel(ref string) (string, error) {
+	var tunnels []config.TunnelConfig
+	if err := c.do(http.MethodGet, "/api/tunnels", &tunnels); err != nil {
+		return "", err
+	}
+	for _, t := range tunnels {
+		if t.ID == ref || t.Name == ref {
+			return t.ID, nil
+		}
+	}
+	return "", fmt.Errorf("no tunnel with ID or name %q", ref)
+}
+
+// cliList prints every tunnel with its current status
+func cliList(c *apiClient, args []string) error {
+	asJSON := len(args) == 1 && args[0] == "--json"
+	if len(args) > 0 && !asJSON {
+		return fmt.Errorf("usage: pont list [--json]")
+	}
+
+	var tunnels []config.TunnelConfig
+	if err := c.do(http.MethodGet, "/api/tunnels", &tunnels); err != nil {
+		return err
+	}
+	var statuses map[string]struct {
+		Status    string `json:"status"`
+		PublicURL string `json:"public_url"`
+	}
+	if err := c.do(http.MethodGet, "/api/status", &statuses); err != nil {
+		return err
+	}
+
+	type row struct {
+		ID        string `json:"id"`
+		Name      string `json:"name"`
+		Type      string `json:"type"`
+		Status    string `json:"status"`
+		PublicURL string `json:"public_url,omitempty"`
+	}
+	rows := make([]row, len(tunnels))
+	for i, t := range tunnels {
+		rows[i] = row{ID: t.ID, Name: t.Name, Type: string(t.Type), Status: "stopped"}
+		if st, ok := statuses[t.ID]; ok {
+			rows[i].Status = st.Status
+			rows[i].PublicURL = st.PublicURL
+		}
+	}
+
+	if asJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(rows)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tNAME\tTYPE\tSTATUS\tPUBLIC URL")
+	for _, r := range rows {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", r.ID, r.Name, r.Type, r.Status, r.PublicURL)
+	}
+	return w.Flush()
+}
+
+// cliStart starts a tunnel by ID or name
+func cliStart(c *apiClient, args []string) error {
+	return cliTunnelAction(c, args, "start")
+}
+
+// cliStop stops a tunnel by ID or name
+func cliStop(c *apiClient, args []string) error {
+	return cliTunnelAction(c, args, "stop")
+}
+
+func cliTunnelAction(c *apiClient, args []string, action string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("usage: pont %s <id|name>", action)
+	}
+	id, err := c.resolveTunnel(args[0])
+	if err != nil {
+		return err
+	}
+	if err := c.do(http.MethodPost, "/api/tunnels/"+id+"/"+action, nil); err != nil {
+		return err
+	}
+	fmt.Printf("Tunnel %s: %s requested\n", args[0], action)
+	return nil
+}
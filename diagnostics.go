@@ -0,0 +1,137 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"runtime"
+	"runtime/pprof"
+	"sort"
+	"strings"
+	"time"
+
+	"pont/internal/logger"
+	"pont/internal/service"
+)
+
+// Stack dumps kept in the log directory, and the least time between two
+// diagnostics dumps; signals arriving sooner are ignored
+const (
+	maxStackDumps     = 5
+	minDiagnosticsGap = 5 * time.Second
+)
+
+// diagnostics logs a snapshot of the instance when it receives SIGUSR1, for
+// looking into a misbehaving instance without pprof
+type diagnostics struct {
+	logDir string
+	svcMgr *service.Manager
+	last   time.Time
+}
+
+// startDiagnostics dumps diagnostics on SIGUSR1 until stop is called. stop
+// handles a pending signal and waits for the dump in progress, so it must
+// be called before the database behind svcMgr is closed.
+func startDiagnostics(logDir string, svcMgr *service.Manager) (stop func()) {
+	sigs := make(chan os.Signal, 1)
+	notifyDiagnostics(sigs)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		(&diagnostics{logDir: logDir, svcMgr: svcMgr}).handle(sigs)
+	}()
+	return func() {
+		signal.Stop(sigs)
+		close(sigs)
+		<-done
+	}
+}
+
+// handle dumps diagnostics for every signal received on sigs until it is
+// closed
+func (d *diagnostics) handle(sigs <-chan os.Signal) {
+	for range sigs {
+		if time.Since(d.last) < minDiagnosticsGap {
+			logger.Sugar.Infof("Diagnostics: ignoring signal, last dump was %s ago", time.Since(d.last).Round(time.Second))
+			continue
+		}
+		d.last = time.Now()
+		d.dump()
+	}
+}
+
+// dump logs the goroutine count, memory use, log subscribers and tunnel
+// states, and writes the goroutine stacks to the log directory
+func (d *diagnostics) dump() {
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+
+	statuses := d.svcMgr.GetAllStatuses()
+	ids := make([]string, 0, len(statuses))
+	byStatus := make(map[string]int)
+	for id, state := range statuses {
+		ids = append(ids, id)
+		byStatus[state.Status]++
+	}
+	sort.Strings(ids)
+
+	logger.Sugar.Infow("Diagnostics",
+		"goroutines", runtime.NumGoroutine(),
+		"heap_alloc_bytes", mem.HeapAlloc,
+		"log_subscribers", logger.SubscriberCount(),
+		"tunnels", byStatus,
+	)
+	for _, id := range ids {
+		state := statuses[id]
+		var details []string
+		if !state.StartedAt.IsZero() {
+			details = append(details, "started "+state.StartedAt.Format(time.RFC3339))
+		}
+		if state.RestartCount > 0 {
+			details = append(details, fmt.Sprintf("%d restarts", state.RestartCount))
+		}
+		if state.Error != "" {
+			details = append(details, "error: "+state.Error)
+		}
+		msg := fmt.Sprintf("Diagnostics: tunnel %s is %s", id, state.Status)
+		if len(details) > 0 {
+			msg += " (" + strings.Join(details, ", ") + ")"
+		}
+		logger.ForTunnel(id).Info(msg)
+	}
+
+	path, err := d.writeStacks()
+	if err != nil {
+		logger.Sugar.Warnf("Diagnostics: failed to write goroutine stacks: %v", err)
+		return
+	}
+	logger.Sugar.Infof("Diagnostics: goroutine stacks written to %s", path)
+}
+
+// writeStacks writes the stacks of all goroutines to a new file in the log
+// directory and removes all but the newest maxStackDumps
+func (d *diagnostics) writeStacks() (string, error) {
+	path := filepath.Join(d.logDir, "goroutines-"+time.Now().UTC().Format("20060102T150405")+".txt")
+	f, err := os.Create(path)
+	if err != nil {
+		return "", err
+	}
+	if err := pprof.Lookup("goroutine").WriteTo(f, 2); err != nil {
+		f.Close()
+		return "", err
+	}
+	if err := f.Close(); err != nil {
+		return "", err
+	}
+
+	// The timestamp sorts lexically, oldest first
+	dumps, err := filepath.Glob(filepath.Join(d.logDir, "goroutines-*.txt"))
+	if err == nil && len(dumps) > maxStackDumps {
+		sort.Strings(dumps)
+		for _, old := range dumps[:len(dumps)-maxStackDumps] {
+			os.Remove(old)
+		}
+	}
+	return path, nil
+}
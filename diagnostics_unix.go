@@ -0,0 +1,14 @@
+//go:build !windows
+
+package main
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// notifyDiagnostics relays SIGUSR1 to sigs
+func notifyDiagnostics(sigs chan<- os.Signal) {
+	signal.Notify(sigs, syscall.SIGUSR1)
+}
@@ -0,0 +1,8 @@
+//go:build windows
+
+package main
+
+import "os"
+
+// notifyDiagnostics does nothing: Windows has no SIGUSR1
+func notifyDiagnostics(sigs chan<- os.Signal) {}
@@ -11,8 +11,11 @@ import (
 
 	"pont/ent/migrate"
 
+	"pont/ent/auditlog"
+	"pont/ent/ngrokcredential"
 	"pont/ent/setting"
 	"pont/ent/tunnel"
+	"pont/ent/urlhistory"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
@@ -25,10 +28,16 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
+	// NgrokCredential is the client for interacting with the NgrokCredential builders.
+	NgrokCredential *NgrokCredentialClient
 	// Setting is the client for interacting with the Setting builders.
 	Setting *SettingClient
 	// Tunnel is the client for interacting with the Tunnel builders.
 	Tunnel *TunnelClient
+	// URLHistory is the client for interacting with the URLHistory builders.
+	URLHistory *URLHistoryClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -40,8 +49,11 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.AuditLog = NewAuditLogClient(c.config)
+	c.NgrokCredential = NewNgrokCredentialClient(c.config)
 	c.Setting = NewSettingClient(c.config)
 	c.Tunnel = NewTunnelClient(c.config)
+	c.URLHistory = NewURLHistoryClient(c.config)
 }
 
 type (
@@ -132,10 +144,13 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:     ctx,
-		config:  cfg,
-		Setting: NewSettingClient(cfg),
-		Tunnel:  NewTunnelClient(cfg),
+		ctx:             ctx,
+		config:          cfg,
+		AuditLog:        NewAuditLogClient(cfg),
+		NgrokCredential: NewNgrokCredentialClient(cfg),
+		Setting:         NewSettingClient(cfg),
+		Tunnel:          NewTunnelClient(cfg),
+		URLHistory:      NewURLHistoryClient(cfg),
 	}, nil
 }
 
@@ -153,17 +168,20 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:     ctx,
-		config:  cfg,
-		Setting: NewSettingClient(cfg),
-		Tunnel:  NewTunnelClient(cfg),
+		ctx:             ctx,
+		config:          cfg,
+		AuditLog:        NewAuditLogClient(cfg),
+		NgrokCredential: NewNgrokCredentialClient(cfg),
+		Setting:         NewSettingClient(cfg),
+		Tunnel:          NewTunnelClient(cfg),
+		URLHistory:      NewURLHistoryClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Setting.
+//		AuditLog.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -185,29 +203,307 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
+	c.AuditLog.Use(hooks...)
+	c.NgrokCredential.Use(hooks...)
 	c.Setting.Use(hooks...)
 	c.Tunnel.Use(hooks...)
+	c.URLHistory.Use(hooks...)
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
+	c.AuditLog.Intercept(interceptors...)
+	c.NgrokCredential.Intercept(interceptors...)
 	c.Setting.Intercept(interceptors...)
 	c.Tunnel.Intercept(interceptors...)
+	c.URLHistory.Intercept(interceptors...)
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *AuditLogMutation:
+		return c.AuditLog.mutate(ctx, m)
+	case *NgrokCredentialMutation:
+		return c.NgrokCredential.mutate(ctx, m)
 	case *SettingMutation:
 		return c.Setting.mutate(ctx, m)
 	case *TunnelMutation:
 		return c.Tunnel.mutate(ctx, m)
+	case *URLHistoryMutation:
+		return c.URLHistory.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
+// AuditLogClient is a client for the AuditLog schema.
+type AuditLogClient struct {
+	config
+}
+
+// NewAuditLogClient returns a client for the AuditLog from the given config.
+func NewAuditLogClient(c config) *AuditLogClient {
+	return &AuditLogClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `auditlog.Hooks(f(g(h())))`.
+func (c *AuditLogClient) Use(hooks ...Hook) {
+	c.hooks.AuditLog = append(c.hooks.AuditLog, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `auditlog.Intercept(f(g(h())))`.
+func (c *AuditLogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AuditLog = append(c.inters.AuditLog, interceptors...)
+}
+
+// Create returns a builder for creating a AuditLog entity.
+func (c *AuditLogClient) Create() *AuditLogCreate {
+	mutation := newAuditLogMutation(c.config, OpCreate)
+	return &AuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AuditLog entities.
+func (c *AuditLogClient) CreateBulk(builders ...*AuditLogCreate) *AuditLogCreateBulk {
+	return &AuditLogCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AuditLogClient) MapCreateBulk(slice any, setFunc func(*AuditLogCreate, int)) *AuditLogCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AuditLogCreateBulk{err: fmt.Errorf("calling to AuditLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AuditLogCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AuditLogCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AuditLog.
+func (c *AuditLogClient) Update() *AuditLogUpdate {
+	mutation := newAuditLogMutation(c.config, OpUpdate)
+	return &AuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AuditLogClient) UpdateOne(_m *AuditLog) *AuditLogUpdateOne {
+	mutation := newAuditLogMutation(c.config, OpUpdateOne, withAuditLog(_m))
+	return &AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AuditLogClient) UpdateOneID(id int) *AuditLogUpdateOne {
+	mutation := newAuditLogMutation(c.config, OpUpdateOne, withAuditLogID(id))
+	return &AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AuditLog.
+func (c *AuditLogClient) Delete() *AuditLogDelete {
+	mutation := newAuditLogMutation(c.config, OpDelete)
+	return &AuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AuditLogClient) DeleteOne(_m *AuditLog) *AuditLogDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AuditLogClient) DeleteOneID(id int) *AuditLogDeleteOne {
+	builder := c.Delete().Where(auditlog.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AuditLogDeleteOne{builder}
+}
+
+// Query returns a query builder for AuditLog.
+func (c *AuditLogClient) Query() *AuditLogQuery {
+	return &AuditLogQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAuditLog},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AuditLog entity by its id.
+func (c *AuditLogClient) Get(ctx context.Context, id int) (*AuditLog, error) {
+	return c.Query().Where(auditlog.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AuditLogClient) GetX(ctx context.Context, id int) *AuditLog {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AuditLogClient) Hooks() []Hook {
+	return c.hooks.AuditLog
+}
+
+// Interceptors returns the client interceptors.
+func (c *AuditLogClient) Interceptors() []Interceptor {
+	return c.inters.AuditLog
+}
+
+func (c *AuditLogClient) mutate(ctx context.Context, m *AuditLogMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AuditLog mutation op: %q", m.Op())
+	}
+}
+
+// NgrokCredentialClient is a client for the NgrokCredential schema.
+type NgrokCredentialClient struct {
+	config
+}
+
+// NewNgrokCredentialClient returns a client for the NgrokCredential from the given config.
+func NewNgrokCredentialClient(c config) *NgrokCredentialClient {
+	return &NgrokCredentialClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `ngrokcredential.Hooks(f(g(h())))`.
+func (c *NgrokCredentialClient) Use(hooks ...Hook) {
+	c.hooks.NgrokCredential = append(c.hooks.NgrokCredential, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `ngrokcredential.Intercept(f(g(h())))`.
+func (c *NgrokCredentialClient) Intercept(interceptors ...Interceptor) {
+	c.inters.NgrokCredential = append(c.inters.NgrokCredential, interceptors...)
+}
+
+// Create returns a builder for creating a NgrokCredential entity.
+func (c *NgrokCredentialClient) Create() *NgrokCredentialCreate {
+	mutation := newNgrokCredentialMutation(c.config, OpCreate)
+	return &NgrokCredentialCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of NgrokCredential entities.
+func (c *NgrokCredentialClient) CreateBulk(builders ...*NgrokCredentialCreate) *NgrokCredentialCreateBulk {
+	return &NgrokCredentialCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NgrokCredentialClient) MapCreateBulk(slice any, setFunc func(*NgrokCredentialCreate, int)) *NgrokCredentialCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NgrokCredentialCreateBulk{err: fmt.Errorf("calling to NgrokCredentialClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NgrokCredentialCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NgrokCredentialCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for NgrokCredential.
+func (c *NgrokCredentialClient) Update() *NgrokCredentialUpdate {
+	mutation := newNgrokCredentialMutation(c.config, OpUpdate)
+	return &NgrokCredentialUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NgrokCredentialClient) UpdateOne(_m *NgrokCredential) *NgrokCredentialUpdateOne {
+	mutation := newNgrokCredentialMutation(c.config, OpUpdateOne, withNgrokCredential(_m))
+	return &NgrokCredentialUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NgrokCredentialClient) UpdateOneID(id int) *NgrokCredentialUpdateOne {
+	mutation := newNgrokCredentialMutation(c.config, OpUpdateOne, withNgrokCredentialID(id))
+	return &NgrokCredentialUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for NgrokCredential.
+func (c *NgrokCredentialClient) Delete() *NgrokCredentialDelete {
+	mutation := newNgrokCredentialMutation(c.config, OpDelete)
+	return &NgrokCredentialDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NgrokCredentialClient) DeleteOne(_m *NgrokCredential) *NgrokCredentialDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NgrokCredentialClient) DeleteOneID(id int) *NgrokCredentialDeleteOne {
+	builder := c.Delete().Where(ngrokcredential.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NgrokCredentialDeleteOne{builder}
+}
+
+// Query returns a query builder for NgrokCredential.
+func (c *NgrokCredentialClient) Query() *NgrokCredentialQuery {
+	return &NgrokCredentialQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNgrokCredential},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a NgrokCredential entity by its id.
+func (c *NgrokCredentialClient) Get(ctx context.Context, id int) (*NgrokCredential, error) {
+	return c.Query().Where(ngrokcredential.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NgrokCredentialClient) GetX(ctx context.Context, id int) *NgrokCredential {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *NgrokCredentialClient) Hooks() []Hook {
+	return c.hooks.NgrokCredential
+}
+
+// Interceptors returns the client interceptors.
+func (c *NgrokCredentialClient) Interceptors() []Interceptor {
+	return c.inters.NgrokCredential
+}
+
+func (c *NgrokCredentialClient) mutate(ctx context.Context, m *NgrokCredentialMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NgrokCredentialCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NgrokCredentialUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NgrokCredentialUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NgrokCredentialDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown NgrokCredential mutation op: %q", m.Op())
+	}
+}
+
 // SettingClient is a client for the Setting schema.
 type SettingClient struct {
 	config
@@ -474,12 +770,145 @@ func (c *TunnelClient) mutate(ctx context.Context, m *TunnelMutation) (Value, er
 	}
 }
 
+// URLHistoryClient is a client for the URLHistory schema.
+type URLHistoryClient struct {
+	config
+}
+
+// NewURLHistoryClient returns a client for the URLHistory from the given config.
+func NewURLHistoryClient(c config) *URLHistoryClient {
+	return &URLHistoryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `urlhistory.Hooks(f(g(h())))`.
+func (c *URLHistoryClient) Use(hooks ...Hook) {
+	c.hooks.URLHistory = append(c.hooks.URLHistory, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `urlhistory.Intercept(f(g(h())))`.
+func (c *URLHistoryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.URLHistory = append(c.inters.URLHistory, interceptors...)
+}
+
+// Create returns a builder for creating a URLHistory entity.
+func (c *URLHistoryClient) Create() *URLHistoryCreate {
+	mutation := newURLHistoryMutation(c.config, OpCreate)
+	return &URLHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of URLHistory entities.
+func (c *URLHistoryClient) CreateBulk(builders ...*URLHistoryCreate) *URLHistoryCreateBulk {
+	return &URLHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *URLHistoryClient) MapCreateBulk(slice any, setFunc func(*URLHistoryCreate, int)) *URLHistoryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &URLHistoryCreateBulk{err: fmt.Errorf("calling to URLHistoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*URLHistoryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &URLHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for URLHistory.
+func (c *URLHistoryClient) Update() *URLHistoryUpdate {
+	mutation := newURLHistoryMutation(c.config, OpUpdate)
+	return &URLHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *URLHistoryClient) UpdateOne(_m *URLHistory) *URLHistoryUpdateOne {
+	mutation := newURLHistoryMutation(c.config, OpUpdateOne, withURLHistory(_m))
+	return &URLHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *URLHistoryClient) UpdateOneID(id int) *URLHistoryUpdateOne {
+	mutation := newURLHistoryMutation(c.config, OpUpdateOne, withURLHistoryID(id))
+	return &URLHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for URLHistory.
+func (c *URLHistoryClient) Delete() *URLHistoryDelete {
+	mutation := newURLHistoryMutation(c.config, OpDelete)
+	return &URLHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *URLHistoryClient) DeleteOne(_m *URLHistory) *URLHistoryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *URLHistoryClient) DeleteOneID(id int) *URLHistoryDeleteOne {
+	builder := c.Delete().Where(urlhistory.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &URLHistoryDeleteOne{builder}
+}
+
+// Query returns a query builder for URLHistory.
+func (c *URLHistoryClient) Query() *URLHistoryQuery {
+	return &URLHistoryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeURLHistory},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a URLHistory entity by its id.
+func (c *URLHistoryClient) Get(ctx context.Context, id int) (*URLHistory, error) {
+	return c.Query().Where(urlhistory.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *URLHistoryClient) GetX(ctx context.Context, id int) *URLHistory {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *URLHistoryClient) Hooks() []Hook {
+	return c.hooks.URLHistory
+}
+
+// Interceptors returns the client interceptors.
+func (c *URLHistoryClient) Interceptors() []Interceptor {
+	return c.inters.URLHistory
+}
+
+func (c *URLHistoryClient) mutate(ctx context.Context, m *URLHistoryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&URLHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&URLHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&URLHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&URLHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown URLHistory mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Setting, Tunnel []ent.Hook
+		AuditLog, NgrokCredential, Setting, Tunnel, URLHistory []ent.Hook
 	}
 	inters struct {
-		Setting, Tunnel []ent.Interceptor
+		AuditLog, NgrokCredential, Setting, Tunnel, URLHistory []ent.Interceptor
 	}
 )
@@ -6,8 +6,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"pont/ent/auditlog"
+	"pont/ent/ngrokcredential"
 	"pont/ent/setting"
 	"pont/ent/tunnel"
+	"pont/ent/urlhistory"
 	"reflect"
 	"sync"
 
@@ -74,8 +77,11 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			setting.Table: setting.ValidColumn,
-			tunnel.Table:  tunnel.ValidColumn,
+			auditlog.Table:        auditlog.ValidColumn,
+			ngrokcredential.Table: ngrokcredential.ValidColumn,
+			setting.Table:         setting.ValidColumn,
+			tunnel.Table:          tunnel.ValidColumn,
+			urlhistory.Table:      urlhistory.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -8,6 +8,47 @@ import (
 )
 
 var (
+	// AuditLogsColumns holds the columns for the "audit_logs" table.
+	AuditLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "actor", Type: field.TypeString},
+		{Name: "action", Type: field.TypeString},
+		{Name: "tunnel_id", Type: field.TypeString, Nullable: true},
+		{Name: "details", Type: field.TypeString, Nullable: true},
+	}
+	// AuditLogsTable holds the schema information for the "audit_logs" table.
+	AuditLogsTable = &schema.Table{
+		Name:       "audit_logs",
+		Columns:    AuditLogsColumns,
+		PrimaryKey: []*schema.Column{AuditLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditlog_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[1]},
+			},
+			{
+				Name:    "auditlog_tunnel_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[4]},
+			},
+		},
+	}
+	// NgrokCredentialsColumns holds the columns for the "ngrok_credentials" table.
+	NgrokCredentialsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "authtoken", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// NgrokCredentialsTable holds the schema information for the "ngrok_credentials" table.
+	NgrokCredentialsTable = &schema.Table{
+		Name:       "ngrok_credentials",
+		Columns:    NgrokCredentialsColumns,
+		PrimaryKey: []*schema.Column{NgrokCredentialsColumns[0]},
+	}
 	// SettingsColumns holds the columns for the "settings" table.
 	SettingsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -30,15 +71,39 @@ var (
 	// TunnelsColumns holds the columns for the "tunnels" table.
 	TunnelsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
-		{Name: "name", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString, Size: 255},
 		{Name: "type", Type: field.TypeEnum, Enums: []string{"cloudflare", "ngrok"}},
-		{Name: "target", Type: field.TypeString},
+		{Name: "target", Type: field.TypeString, Size: 2048},
+		{Name: "group", Type: field.TypeString, Nullable: true},
 		{Name: "enabled", Type: field.TypeBool, Default: true},
-		{Name: "mcp_enabled", Type: field.TypeBool, Default: true},
+		{Name: "mcp_enabled", Type: field.TypeBool, Default: false},
+		{Name: "favorite", Type: field.TypeBool, Default: false},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "ngrok_authtoken", Type: field.TypeString, Nullable: true},
+		{Name: "ngrok_credential", Type: field.TypeString, Nullable: true},
 		{Name: "ngrok_domain", Type: field.TypeString, Nullable: true},
+		{Name: "ngrok_webhook_provider", Type: field.TypeString, Nullable: true},
+		{Name: "ngrok_webhook_secret", Type: field.TypeString, Nullable: true},
+		{Name: "ngrok_internal", Type: field.TypeBool, Default: false},
+		{Name: "ngrok_rate_limit", Type: field.TypeString, Nullable: true},
+		{Name: "cloudflare_connect_timeout", Type: field.TypeString, Nullable: true},
+		{Name: "cloudflare_no_tls_verify", Type: field.TypeBool, Default: false},
+		{Name: "cloudflare_http_host_header", Type: field.TypeString, Nullable: true},
+		{Name: "cloudflare_region", Type: field.TypeString, Nullable: true},
+		{Name: "cloudflare_env", Type: field.TypeJSON, Nullable: true},
+		{Name: "cloudflare_error_page", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "error_grace", Type: field.TypeString, Nullable: true},
+		{Name: "archived", Type: field.TypeBool, Default: false},
+		{Name: "paused", Type: field.TypeBool, Default: false},
+		{Name: "probe_enabled", Type: field.TypeBool, Default: false},
+		{Name: "probe_interval", Type: field.TypeString, Nullable: true},
+		{Name: "probe_timeout", Type: field.TypeString, Nullable: true},
+		{Name: "probe_retries", Type: field.TypeInt, Default: 0},
+		{Name: "probe_path", Type: field.TypeString, Nullable: true},
+		{Name: "idle_timeout", Type: field.TypeString, Nullable: true},
+		{Name: "pre_start_hook", Type: field.TypeString, Nullable: true},
+		{Name: "post_stop_hook", Type: field.TypeString, Nullable: true},
 	}
 	// TunnelsTable holds the schema information for the "tunnels" table.
 	TunnelsTable = &schema.Table{
@@ -46,10 +111,33 @@ var (
 		Columns:    TunnelsColumns,
 		PrimaryKey: []*schema.Column{TunnelsColumns[0]},
 	}
+	// URLHistoriesColumns holds the columns for the "url_histories" table.
+	URLHistoriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "tunnel_id", Type: field.TypeString},
+		{Name: "url", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// URLHistoriesTable holds the schema information for the "url_histories" table.
+	URLHistoriesTable = &schema.Table{
+		Name:       "url_histories",
+		Columns:    URLHistoriesColumns,
+		PrimaryKey: []*schema.Column{URLHistoriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "urlhistory_tunnel_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{URLHistoriesColumns[1], URLHistoriesColumns[3]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AuditLogsTable,
+		NgrokCredentialsTable,
 		SettingsTable,
 		TunnelsTable,
+		URLHistoriesTable,
 	}
 )
 
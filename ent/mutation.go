@@ -6,9 +6,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"pont/ent/auditlog"
+	"pont/ent/ngrokcredential"
 	"pont/ent/predicate"
 	"pont/ent/setting"
 	"pont/ent/tunnel"
+	"pont/ent/urlhistory"
 	"sync"
 	"time"
 
@@ -26,35 +29,41 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeSetting = "Setting"
-	TypeTunnel  = "Tunnel"
+	TypeAuditLog        = "AuditLog"
+	TypeNgrokCredential = "NgrokCredential"
+	TypeSetting         = "Setting"
+	TypeTunnel          = "Tunnel"
+	TypeURLHistory      = "URLHistory"
 )
 
-// SettingMutation represents an operation that mutates the Setting nodes in the graph.
-type SettingMutation struct {
+// AuditLogMutation represents an operation that mutates the AuditLog nodes in the graph.
+type AuditLogMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
-	key           *string
-	value         *string
+	created_at    *time.Time
+	actor         *string
+	action        *string
+	tunnel_id     *string
+	details       *string
 	clearedFields map[string]struct{}
 	done          bool
-	oldValue      func(context.Context) (*Setting, error)
-	predicates    []predicate.Setting
+	oldValue      func(context.Context) (*AuditLog, error)
+	predicates    []predicate.AuditLog
 }
 
-var _ ent.Mutation = (*SettingMutation)(nil)
+var _ ent.Mutation = (*AuditLogMutation)(nil)
 
-// settingOption allows management of the mutation configuration using functional options.
-type settingOption func(*SettingMutation)
+// auditlogOption allows management of the mutation configuration using functional options.
+type auditlogOption func(*AuditLogMutation)
 
-// newSettingMutation creates new mutation for the Setting entity.
-func newSettingMutation(c config, op Op, opts ...settingOption) *SettingMutation {
-	m := &SettingMutation{
+// newAuditLogMutation creates new mutation for the AuditLog entity.
+func newAuditLogMutation(c config, op Op, opts ...auditlogOption) *AuditLogMutation {
+	m := &AuditLogMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSetting,
+		typ:           TypeAuditLog,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -63,20 +72,20 @@ func newSettingMutation(c config, op Op, opts ...settingOption) *SettingMutation
 	return m
 }
 
-// withSettingID sets the ID field of the mutation.
-func withSettingID(id int) settingOption {
-	return func(m *SettingMutation) {
+// withAuditLogID sets the ID field of the mutation.
+func withAuditLogID(id int) auditlogOption {
+	return func(m *AuditLogMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Setting
+			value *AuditLog
 		)
-		m.oldValue = func(ctx context.Context) (*Setting, error) {
+		m.oldValue = func(ctx context.Context) (*AuditLog, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Setting.Get(ctx, id)
+					value, err = m.Client().AuditLog.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -85,10 +94,10 @@ func withSettingID(id int) settingOption {
 	}
 }
 
-// withSetting sets the old Setting of the mutation.
-func withSetting(node *Setting) settingOption {
-	return func(m *SettingMutation) {
-		m.oldValue = func(context.Context) (*Setting, error) {
+// withAuditLog sets the old AuditLog of the mutation.
+func withAuditLog(node *AuditLog) auditlogOption {
+	return func(m *AuditLogMutation) {
+		m.oldValue = func(context.Context) (*AuditLog, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -97,7 +106,7 @@ func withSetting(node *Setting) settingOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SettingMutation) Client() *Client {
+func (m AuditLogMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -105,7 +114,7 @@ func (m SettingMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SettingMutation) Tx() (*Tx, error) {
+func (m AuditLogMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -116,7 +125,7 @@ func (m SettingMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SettingMutation) ID() (id int, exists bool) {
+func (m *AuditLogMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -127,7 +136,7 @@ func (m *SettingMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SettingMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *AuditLogMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -136,93 +145,227 @@ func (m *SettingMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Setting.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AuditLog.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetKey sets the "key" field.
-func (m *SettingMutation) SetKey(s string) {
-	m.key = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *AuditLogMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Key returns the value of the "key" field in the mutation.
-func (m *SettingMutation) Key() (r string, exists bool) {
-	v := m.key
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AuditLogMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldKey returns the old "key" field's value of the Setting entity.
-// If the Setting object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingMutation) OldKey(ctx context.Context) (v string, err error) {
+func (m *AuditLogMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldKey requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Key, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetKey resets all changes to the "key" field.
-func (m *SettingMutation) ResetKey() {
-	m.key = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AuditLogMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetValue sets the "value" field.
-func (m *SettingMutation) SetValue(s string) {
-	m.value = &s
+// SetActor sets the "actor" field.
+func (m *AuditLogMutation) SetActor(s string) {
+	m.actor = &s
 }
 
-// Value returns the value of the "value" field in the mutation.
-func (m *SettingMutation) Value() (r string, exists bool) {
-	v := m.value
+// Actor returns the value of the "actor" field in the mutation.
+func (m *AuditLogMutation) Actor() (r string, exists bool) {
+	v := m.actor
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldValue returns the old "value" field's value of the Setting entity.
-// If the Setting object wasn't provided to the builder, the object is fetched from the database.
+// OldActor returns the old "actor" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingMutation) OldValue(ctx context.Context) (v string, err error) {
+func (m *AuditLogMutation) OldActor(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+		return v, errors.New("OldActor is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldValue requires an ID field in the mutation")
+		return v, errors.New("OldActor requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+		return v, fmt.Errorf("querying old value for OldActor: %w", err)
 	}
-	return oldValue.Value, nil
+	return oldValue.Actor, nil
 }
 
-// ResetValue resets all changes to the "value" field.
-func (m *SettingMutation) ResetValue() {
-	m.value = nil
+// ResetActor resets all changes to the "actor" field.
+func (m *AuditLogMutation) ResetActor() {
+	m.actor = nil
 }
 
-// Where appends a list predicates to the SettingMutation builder.
-func (m *SettingMutation) Where(ps ...predicate.Setting) {
+// SetAction sets the "action" field.
+func (m *AuditLogMutation) SetAction(s string) {
+	m.action = &s
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *AuditLogMutation) Action() (r string, exists bool) {
+	v := m.action
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAction returns the old "action" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldAction(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
+}
+
+// ResetAction resets all changes to the "action" field.
+func (m *AuditLogMutation) ResetAction() {
+	m.action = nil
+}
+
+// SetTunnelID sets the "tunnel_id" field.
+func (m *AuditLogMutation) SetTunnelID(s string) {
+	m.tunnel_id = &s
+}
+
+// TunnelID returns the value of the "tunnel_id" field in the mutation.
+func (m *AuditLogMutation) TunnelID() (r string, exists bool) {
+	v := m.tunnel_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTunnelID returns the old "tunnel_id" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldTunnelID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTunnelID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTunnelID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTunnelID: %w", err)
+	}
+	return oldValue.TunnelID, nil
+}
+
+// ClearTunnelID clears the value of the "tunnel_id" field.
+func (m *AuditLogMutation) ClearTunnelID() {
+	m.tunnel_id = nil
+	m.clearedFields[auditlog.FieldTunnelID] = struct{}{}
+}
+
+// TunnelIDCleared returns if the "tunnel_id" field was cleared in this mutation.
+func (m *AuditLogMutation) TunnelIDCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldTunnelID]
+	return ok
+}
+
+// ResetTunnelID resets all changes to the "tunnel_id" field.
+func (m *AuditLogMutation) ResetTunnelID() {
+	m.tunnel_id = nil
+	delete(m.clearedFields, auditlog.FieldTunnelID)
+}
+
+// SetDetails sets the "details" field.
+func (m *AuditLogMutation) SetDetails(s string) {
+	m.details = &s
+}
+
+// Details returns the value of the "details" field in the mutation.
+func (m *AuditLogMutation) Details() (r string, exists bool) {
+	v := m.details
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDetails returns the old "details" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldDetails(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDetails is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDetails requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDetails: %w", err)
+	}
+	return oldValue.Details, nil
+}
+
+// ClearDetails clears the value of the "details" field.
+func (m *AuditLogMutation) ClearDetails() {
+	m.details = nil
+	m.clearedFields[auditlog.FieldDetails] = struct{}{}
+}
+
+// DetailsCleared returns if the "details" field was cleared in this mutation.
+func (m *AuditLogMutation) DetailsCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldDetails]
+	return ok
+}
+
+// ResetDetails resets all changes to the "details" field.
+func (m *AuditLogMutation) ResetDetails() {
+	m.details = nil
+	delete(m.clearedFields, auditlog.FieldDetails)
+}
+
+// Where appends a list predicates to the AuditLogMutation builder.
+func (m *AuditLogMutation) Where(ps ...predicate.AuditLog) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SettingMutation builder. Using this method,
+// WhereP appends storage-level predicates to the AuditLogMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SettingMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Setting, len(ps))
+func (m *AuditLogMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AuditLog, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -230,30 +373,39 @@ func (m *SettingMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SettingMutation) Op() Op {
+func (m *AuditLogMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SettingMutation) SetOp(op Op) {
+func (m *AuditLogMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Setting).
-func (m *SettingMutation) Type() string {
+// Type returns the node type of this mutation (AuditLog).
+func (m *AuditLogMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SettingMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.key != nil {
-		fields = append(fields, setting.FieldKey)
+func (m *AuditLogMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.created_at != nil {
+		fields = append(fields, auditlog.FieldCreatedAt)
 	}
-	if m.value != nil {
-		fields = append(fields, setting.FieldValue)
+	if m.actor != nil {
+		fields = append(fields, auditlog.FieldActor)
+	}
+	if m.action != nil {
+		fields = append(fields, auditlog.FieldAction)
+	}
+	if m.tunnel_id != nil {
+		fields = append(fields, auditlog.FieldTunnelID)
+	}
+	if m.details != nil {
+		fields = append(fields, auditlog.FieldDetails)
 	}
 	return fields
 }
@@ -261,12 +413,18 @@ func (m *SettingMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SettingMutation) Field(name string) (ent.Value, bool) {
+func (m *AuditLogMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case setting.FieldKey:
-		return m.Key()
-	case setting.FieldValue:
-		return m.Value()
+	case auditlog.FieldCreatedAt:
+		return m.CreatedAt()
+	case auditlog.FieldActor:
+		return m.Actor()
+	case auditlog.FieldAction:
+		return m.Action()
+	case auditlog.FieldTunnelID:
+		return m.TunnelID()
+	case auditlog.FieldDetails:
+		return m.Details()
 	}
 	return nil, false
 }
@@ -274,174 +432,220 @@ func (m *SettingMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SettingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AuditLogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case setting.FieldKey:
-		return m.OldKey(ctx)
-	case setting.FieldValue:
-		return m.OldValue(ctx)
+	case auditlog.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case auditlog.FieldActor:
+		return m.OldActor(ctx)
+	case auditlog.FieldAction:
+		return m.OldAction(ctx)
+	case auditlog.FieldTunnelID:
+		return m.OldTunnelID(ctx)
+	case auditlog.FieldDetails:
+		return m.OldDetails(ctx)
 	}
-	return nil, fmt.Errorf("unknown Setting field %s", name)
+	return nil, fmt.Errorf("unknown AuditLog field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SettingMutation) SetField(name string, value ent.Value) error {
+func (m *AuditLogMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case setting.FieldKey:
+	case auditlog.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case auditlog.FieldActor:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetKey(v)
+		m.SetActor(v)
 		return nil
-	case setting.FieldValue:
+	case auditlog.FieldAction:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetValue(v)
+		m.SetAction(v)
+		return nil
+	case auditlog.FieldTunnelID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTunnelID(v)
+		return nil
+	case auditlog.FieldDetails:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDetails(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Setting field %s", name)
+	return fmt.Errorf("unknown AuditLog field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SettingMutation) AddedFields() []string {
+func (m *AuditLogMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SettingMutation) AddedField(name string) (ent.Value, bool) {
+func (m *AuditLogMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SettingMutation) AddField(name string, value ent.Value) error {
+func (m *AuditLogMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Setting numeric field %s", name)
+	return fmt.Errorf("unknown AuditLog numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SettingMutation) ClearedFields() []string {
-	return nil
+func (m *AuditLogMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(auditlog.FieldTunnelID) {
+		fields = append(fields, auditlog.FieldTunnelID)
+	}
+	if m.FieldCleared(auditlog.FieldDetails) {
+		fields = append(fields, auditlog.FieldDetails)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SettingMutation) FieldCleared(name string) bool {
+func (m *AuditLogMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SettingMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Setting nullable field %s", name)
+func (m *AuditLogMutation) ClearField(name string) error {
+	switch name {
+	case auditlog.FieldTunnelID:
+		m.ClearTunnelID()
+		return nil
+	case auditlog.FieldDetails:
+		m.ClearDetails()
+		return nil
+	}
+	return fmt.Errorf("unknown AuditLog nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SettingMutation) ResetField(name string) error {
+func (m *AuditLogMutation) ResetField(name string) error {
 	switch name {
-	case setting.FieldKey:
-		m.ResetKey()
+	case auditlog.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case setting.FieldValue:
-		m.ResetValue()
+	case auditlog.FieldActor:
+		m.ResetActor()
+		return nil
+	case auditlog.FieldAction:
+		m.ResetAction()
+		return nil
+	case auditlog.FieldTunnelID:
+		m.ResetTunnelID()
+		return nil
+	case auditlog.FieldDetails:
+		m.ResetDetails()
 		return nil
 	}
-	return fmt.Errorf("unknown Setting field %s", name)
+	return fmt.Errorf("unknown AuditLog field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SettingMutation) AddedEdges() []string {
+func (m *AuditLogMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SettingMutation) AddedIDs(name string) []ent.Value {
+func (m *AuditLogMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SettingMutation) RemovedEdges() []string {
+func (m *AuditLogMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SettingMutation) RemovedIDs(name string) []ent.Value {
+func (m *AuditLogMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SettingMutation) ClearedEdges() []string {
+func (m *AuditLogMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SettingMutation) EdgeCleared(name string) bool {
+func (m *AuditLogMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SettingMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Setting unique edge %s", name)
+func (m *AuditLogMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AuditLog unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SettingMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Setting edge %s", name)
+func (m *AuditLogMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AuditLog edge %s", name)
 }
 
-// TunnelMutation represents an operation that mutates the Tunnel nodes in the graph.
-type TunnelMutation struct {
+// NgrokCredentialMutation represents an operation that mutates the NgrokCredential nodes in the graph.
+type NgrokCredentialMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *uuid.UUID
-	name            *string
-	_type           *tunnel.Type
-	target          *string
-	enabled         *bool
-	mcp_enabled     *bool
-	created_at      *time.Time
-	updated_at      *time.Time
-	ngrok_authtoken *string
-	ngrok_domain    *string
-	clearedFields   map[string]struct{}
-	done            bool
-	oldValue        func(context.Context) (*Tunnel, error)
-	predicates      []predicate.Tunnel
+	op            Op
+	typ           string
+	id            *int
+	name          *string
+	authtoken     *string
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*NgrokCredential, error)
+	predicates    []predicate.NgrokCredential
 }
 
-var _ ent.Mutation = (*TunnelMutation)(nil)
+var _ ent.Mutation = (*NgrokCredentialMutation)(nil)
 
-// tunnelOption allows management of the mutation configuration using functional options.
-type tunnelOption func(*TunnelMutation)
+// ngrokcredentialOption allows management of the mutation configuration using functional options.
+type ngrokcredentialOption func(*NgrokCredentialMutation)
 
-// newTunnelMutation creates new mutation for the Tunnel entity.
-func newTunnelMutation(c config, op Op, opts ...tunnelOption) *TunnelMutation {
-	m := &TunnelMutation{
+// newNgrokCredentialMutation creates new mutation for the NgrokCredential entity.
+func newNgrokCredentialMutation(c config, op Op, opts ...ngrokcredentialOption) *NgrokCredentialMutation {
+	m := &NgrokCredentialMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTunnel,
+		typ:           TypeNgrokCredential,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -450,20 +654,20 @@ func newTunnelMutation(c config, op Op, opts ...tunnelOption) *TunnelMutation {
 	return m
 }
 
-// withTunnelID sets the ID field of the mutation.
-func withTunnelID(id uuid.UUID) tunnelOption {
-	return func(m *TunnelMutation) {
+// withNgrokCredentialID sets the ID field of the mutation.
+func withNgrokCredentialID(id int) ngrokcredentialOption {
+	return func(m *NgrokCredentialMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Tunnel
+			value *NgrokCredential
 		)
-		m.oldValue = func(ctx context.Context) (*Tunnel, error) {
+		m.oldValue = func(ctx context.Context) (*NgrokCredential, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Tunnel.Get(ctx, id)
+					value, err = m.Client().NgrokCredential.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -472,10 +676,10 @@ func withTunnelID(id uuid.UUID) tunnelOption {
 	}
 }
 
-// withTunnel sets the old Tunnel of the mutation.
-func withTunnel(node *Tunnel) tunnelOption {
-	return func(m *TunnelMutation) {
-		m.oldValue = func(context.Context) (*Tunnel, error) {
+// withNgrokCredential sets the old NgrokCredential of the mutation.
+func withNgrokCredential(node *NgrokCredential) ngrokcredentialOption {
+	return func(m *NgrokCredentialMutation) {
+		m.oldValue = func(context.Context) (*NgrokCredential, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -484,7 +688,7 @@ func withTunnel(node *Tunnel) tunnelOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TunnelMutation) Client() *Client {
+func (m NgrokCredentialMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -492,7 +696,7 @@ func (m TunnelMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TunnelMutation) Tx() (*Tx, error) {
+func (m NgrokCredentialMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -501,15 +705,9 @@ func (m TunnelMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Tunnel entities.
-func (m *TunnelMutation) SetID(id uuid.UUID) {
-	m.id = &id
-}
-
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TunnelMutation) ID() (id uuid.UUID, exists bool) {
+func (m *NgrokCredentialMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -520,28 +718,28 @@ func (m *TunnelMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TunnelMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *NgrokCredentialMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []uuid.UUID{id}, nil
+			return []int{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Tunnel.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().NgrokCredential.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetName sets the "name" field.
-func (m *TunnelMutation) SetName(s string) {
+func (m *NgrokCredentialMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *TunnelMutation) Name() (r string, exists bool) {
+func (m *NgrokCredentialMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -549,10 +747,10 @@ func (m *TunnelMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Tunnel entity.
-// If the Tunnel object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the NgrokCredential entity.
+// If the NgrokCredential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TunnelMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *NgrokCredentialMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -567,333 +765,3418 @@ func (m *TunnelMutation) OldName(ctx context.Context) (v string, err error) {
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *TunnelMutation) ResetName() {
+func (m *NgrokCredentialMutation) ResetName() {
 	m.name = nil
 }
 
-// SetType sets the "type" field.
-func (m *TunnelMutation) SetType(t tunnel.Type) {
-	m._type = &t
+// SetAuthtoken sets the "authtoken" field.
+func (m *NgrokCredentialMutation) SetAuthtoken(s string) {
+	m.authtoken = &s
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *TunnelMutation) GetType() (r tunnel.Type, exists bool) {
-	v := m._type
+// Authtoken returns the value of the "authtoken" field in the mutation.
+func (m *NgrokCredentialMutation) Authtoken() (r string, exists bool) {
+	v := m.authtoken
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the Tunnel entity.
-// If the Tunnel object wasn't provided to the builder, the object is fetched from the database.
+// OldAuthtoken returns the old "authtoken" field's value of the NgrokCredential entity.
+// If the NgrokCredential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TunnelMutation) OldType(ctx context.Context) (v tunnel.Type, err error) {
+func (m *NgrokCredentialMutation) OldAuthtoken(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldAuthtoken is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldAuthtoken requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldAuthtoken: %w", err)
 	}
-	return oldValue.Type, nil
+	return oldValue.Authtoken, nil
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *TunnelMutation) ResetType() {
-	m._type = nil
+// ResetAuthtoken resets all changes to the "authtoken" field.
+func (m *NgrokCredentialMutation) ResetAuthtoken() {
+	m.authtoken = nil
 }
 
-// SetTarget sets the "target" field.
-func (m *TunnelMutation) SetTarget(s string) {
-	m.target = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *NgrokCredentialMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Target returns the value of the "target" field in the mutation.
-func (m *TunnelMutation) Target() (r string, exists bool) {
-	v := m.target
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *NgrokCredentialMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTarget returns the old "target" field's value of the Tunnel entity.
-// If the Tunnel object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the NgrokCredential entity.
+// If the NgrokCredential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TunnelMutation) OldTarget(ctx context.Context) (v string, err error) {
+func (m *NgrokCredentialMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTarget is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTarget requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTarget: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Target, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetTarget resets all changes to the "target" field.
-func (m *TunnelMutation) ResetTarget() {
-	m.target = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *NgrokCredentialMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetEnabled sets the "enabled" field.
-func (m *TunnelMutation) SetEnabled(b bool) {
-	m.enabled = &b
+// SetUpdatedAt sets the "updated_at" field.
+func (m *NgrokCredentialMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// Enabled returns the value of the "enabled" field in the mutation.
-func (m *TunnelMutation) Enabled() (r bool, exists bool) {
-	v := m.enabled
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *NgrokCredentialMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEnabled returns the old "enabled" field's value of the Tunnel entity.
-// If the Tunnel object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the NgrokCredential entity.
+// If the NgrokCredential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TunnelMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+func (m *NgrokCredentialMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEnabled requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.Enabled, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetEnabled resets all changes to the "enabled" field.
-func (m *TunnelMutation) ResetEnabled() {
-	m.enabled = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *NgrokCredentialMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetMcpEnabled sets the "mcp_enabled" field.
-func (m *TunnelMutation) SetMcpEnabled(b bool) {
-	m.mcp_enabled = &b
+// Where appends a list predicates to the NgrokCredentialMutation builder.
+func (m *NgrokCredentialMutation) Where(ps ...predicate.NgrokCredential) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// McpEnabled returns the value of the "mcp_enabled" field in the mutation.
-func (m *TunnelMutation) McpEnabled() (r bool, exists bool) {
-	v := m.mcp_enabled
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the NgrokCredentialMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *NgrokCredentialMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.NgrokCredential, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldMcpEnabled returns the old "mcp_enabled" field's value of the Tunnel entity.
-// If the Tunnel object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TunnelMutation) OldMcpEnabled(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMcpEnabled is only allowed on UpdateOne operations")
+// Op returns the operation name.
+func (m *NgrokCredentialMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *NgrokCredentialMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (NgrokCredential).
+func (m *NgrokCredentialMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *NgrokCredentialMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.name != nil {
+		fields = append(fields, ngrokcredential.FieldName)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMcpEnabled requires an ID field in the mutation")
+	if m.authtoken != nil {
+		fields = append(fields, ngrokcredential.FieldAuthtoken)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMcpEnabled: %w", err)
+	if m.created_at != nil {
+		fields = append(fields, ngrokcredential.FieldCreatedAt)
 	}
-	return oldValue.McpEnabled, nil
+	if m.updated_at != nil {
+		fields = append(fields, ngrokcredential.FieldUpdatedAt)
+	}
+	return fields
 }
 
-// ResetMcpEnabled resets all changes to the "mcp_enabled" field.
-func (m *TunnelMutation) ResetMcpEnabled() {
-	m.mcp_enabled = nil
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NgrokCredentialMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case ngrokcredential.FieldName:
+		return m.Name()
+	case ngrokcredential.FieldAuthtoken:
+		return m.Authtoken()
+	case ngrokcredential.FieldCreatedAt:
+		return m.CreatedAt()
+	case ngrokcredential.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *TunnelMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NgrokCredentialMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case ngrokcredential.FieldName:
+		return m.OldName(ctx)
+	case ngrokcredential.FieldAuthtoken:
+		return m.OldAuthtoken(ctx)
+	case ngrokcredential.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case ngrokcredential.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown NgrokCredential field %s", name)
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *TunnelMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NgrokCredentialMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case ngrokcredential.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case ngrokcredential.FieldAuthtoken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthtoken(v)
+		return nil
+	case ngrokcredential.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case ngrokcredential.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown NgrokCredential field %s", name)
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Tunnel entity.
-// If the Tunnel object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TunnelMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NgrokCredentialMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NgrokCredentialMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NgrokCredentialMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return oldValue.CreatedAt, nil
+	return fmt.Errorf("unknown NgrokCredential numeric field %s", name)
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *TunnelMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NgrokCredentialMutation) ClearedFields() []string {
+	return nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *TunnelMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NgrokCredentialMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *TunnelMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NgrokCredentialMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown NgrokCredential nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NgrokCredentialMutation) ResetField(name string) error {
+	switch name {
+	case ngrokcredential.FieldName:
+		m.ResetName()
+		return nil
+	case ngrokcredential.FieldAuthtoken:
+		m.ResetAuthtoken()
+		return nil
+	case ngrokcredential.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case ngrokcredential.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown NgrokCredential field %s", name)
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Tunnel entity.
-// If the Tunnel object wasn't provided to the builder, the object is fetched from the database.
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NgrokCredentialMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NgrokCredentialMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NgrokCredentialMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NgrokCredentialMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NgrokCredentialMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NgrokCredentialMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NgrokCredentialMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown NgrokCredential unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NgrokCredentialMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown NgrokCredential edge %s", name)
+}
+
+// SettingMutation represents an operation that mutates the Setting nodes in the graph.
+type SettingMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	key           *string
+	value         *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Setting, error)
+	predicates    []predicate.Setting
+}
+
+var _ ent.Mutation = (*SettingMutation)(nil)
+
+// settingOption allows management of the mutation configuration using functional options.
+type settingOption func(*SettingMutation)
+
+// newSettingMutation creates new mutation for the Setting entity.
+func newSettingMutation(c config, op Op, opts ...settingOption) *SettingMutation {
+	m := &SettingMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSetting,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSettingID sets the ID field of the mutation.
+func withSettingID(id int) settingOption {
+	return func(m *SettingMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Setting
+		)
+		m.oldValue = func(ctx context.Context) (*Setting, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Setting.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSetting sets the old Setting of the mutation.
+func withSetting(node *Setting) settingOption {
+	return func(m *SettingMutation) {
+		m.oldValue = func(context.Context) (*Setting, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SettingMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SettingMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SettingMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SettingMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Setting.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetKey sets the "key" field.
+func (m *SettingMutation) SetKey(s string) {
+	m.key = &s
+}
+
+// Key returns the value of the "key" field in the mutation.
+func (m *SettingMutation) Key() (r string, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKey returns the old "key" field's value of the Setting entity.
+// If the Setting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingMutation) OldKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
+}
+
+// ResetKey resets all changes to the "key" field.
+func (m *SettingMutation) ResetKey() {
+	m.key = nil
+}
+
+// SetValue sets the "value" field.
+func (m *SettingMutation) SetValue(s string) {
+	m.value = &s
+}
+
+// Value returns the value of the "value" field in the mutation.
+func (m *SettingMutation) Value() (r string, exists bool) {
+	v := m.value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValue returns the old "value" field's value of the Setting entity.
+// If the Setting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingMutation) OldValue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+	}
+	return oldValue.Value, nil
+}
+
+// ResetValue resets all changes to the "value" field.
+func (m *SettingMutation) ResetValue() {
+	m.value = nil
+}
+
+// Where appends a list predicates to the SettingMutation builder.
+func (m *SettingMutation) Where(ps ...predicate.Setting) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SettingMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SettingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Setting, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SettingMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SettingMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Setting).
+func (m *SettingMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SettingMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.key != nil {
+		fields = append(fields, setting.FieldKey)
+	}
+	if m.value != nil {
+		fields = append(fields, setting.FieldValue)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SettingMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case setting.FieldKey:
+		return m.Key()
+	case setting.FieldValue:
+		return m.Value()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SettingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case setting.FieldKey:
+		return m.OldKey(ctx)
+	case setting.FieldValue:
+		return m.OldValue(ctx)
+	}
+	return nil, fmt.Errorf("unknown Setting field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SettingMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case setting.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case setting.FieldValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Setting field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SettingMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SettingMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SettingMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Setting numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SettingMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SettingMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SettingMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Setting nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SettingMutation) ResetField(name string) error {
+	switch name {
+	case setting.FieldKey:
+		m.ResetKey()
+		return nil
+	case setting.FieldValue:
+		m.ResetValue()
+		return nil
+	}
+	return fmt.Errorf("unknown Setting field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SettingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SettingMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SettingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SettingMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SettingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SettingMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SettingMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Setting unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SettingMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Setting edge %s", name)
+}
+
+// TunnelMutation represents an operation that mutates the Tunnel nodes in the graph.
+type TunnelMutation struct {
+	config
+	op                          Op
+	typ                         string
+	id                          *uuid.UUID
+	name                        *string
+	_type                       *tunnel.Type
+	target                      *string
+	group                       *string
+	enabled                     *bool
+	mcp_enabled                 *bool
+	favorite                    *bool
+	created_at                  *time.Time
+	updated_at                  *time.Time
+	ngrok_authtoken             *string
+	ngrok_credential            *string
+	ngrok_domain                *string
+	ngrok_webhook_provider      *string
+	ngrok_webhook_secret        *string
+	ngrok_internal              *bool
+	ngrok_rate_limit            *string
+	cloudflare_connect_timeout  *string
+	cloudflare_no_tls_verify    *bool
+	cloudflare_http_host_header *string
+	cloudflare_region           *string
+	cloudflare_env              *map[string]string
+	cloudflare_error_page       *string
+	error_grace                 *string
+	archived                    *bool
+	paused                      *bool
+	probe_enabled               *bool
+	probe_interval              *string
+	probe_timeout               *string
+	probe_retries               *int
+	addprobe_retries            *int
+	probe_path                  *string
+	idle_timeout                *string
+	pre_start_hook              *string
+	post_stop_hook              *string
+	clearedFields               map[string]struct{}
+	done                        bool
+	oldValue                    func(context.Context) (*Tunnel, error)
+	predicates                  []predicate.Tunnel
+}
+
+var _ ent.Mutation = (*TunnelMutation)(nil)
+
+// tunnelOption allows management of the mutation configuration using functional options.
+type tunnelOption func(*TunnelMutation)
+
+// newTunnelMutation creates new mutation for the Tunnel entity.
+func newTunnelMutation(c config, op Op, opts ...tunnelOption) *TunnelMutation {
+	m := &TunnelMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTunnel,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTunnelID sets the ID field of the mutation.
+func withTunnelID(id uuid.UUID) tunnelOption {
+	return func(m *TunnelMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Tunnel
+		)
+		m.oldValue = func(ctx context.Context) (*Tunnel, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Tunnel.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTunnel sets the old Tunnel of the mutation.
+func withTunnel(node *Tunnel) tunnelOption {
+	return func(m *TunnelMutation) {
+		m.oldValue = func(context.Context) (*Tunnel, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TunnelMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TunnelMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Tunnel entities.
+func (m *TunnelMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TunnelMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TunnelMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Tunnel.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *TunnelMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *TunnelMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Tunnel entity.
+// If the Tunnel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TunnelMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *TunnelMutation) ResetName() {
+	m.name = nil
+}
+
+// SetType sets the "type" field.
+func (m *TunnelMutation) SetType(t tunnel.Type) {
+	m._type = &t
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *TunnelMutation) GetType() (r tunnel.Type, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the Tunnel entity.
+// If the Tunnel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TunnelMutation) OldType(ctx context.Context) (v tunnel.Type, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *TunnelMutation) ResetType() {
+	m._type = nil
+}
+
+// SetTarget sets the "target" field.
+func (m *TunnelMutation) SetTarget(s string) {
+	m.target = &s
+}
+
+// Target returns the value of the "target" field in the mutation.
+func (m *TunnelMutation) Target() (r string, exists bool) {
+	v := m.target
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTarget returns the old "target" field's value of the Tunnel entity.
+// If the Tunnel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TunnelMutation) OldTarget(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTarget is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTarget requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTarget: %w", err)
+	}
+	return oldValue.Target, nil
+}
+
+// ResetTarget resets all changes to the "target" field.
+func (m *TunnelMutation) ResetTarget() {
+	m.target = nil
+}
+
+// SetGroup sets the "group" field.
+func (m *TunnelMutation) SetGroup(s string) {
+	m.group = &s
+}
+
+// Group returns the value of the "group" field in the mutation.
+func (m *TunnelMutation) Group() (r string, exists bool) {
+	v := m.group
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGroup returns the old "group" field's value of the Tunnel entity.
+// If the Tunnel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TunnelMutation) OldGroup(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGroup is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGroup requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGroup: %w", err)
+	}
+	return oldValue.Group, nil
+}
+
+// ClearGroup clears the value of the "group" field.
+func (m *TunnelMutation) ClearGroup() {
+	m.group = nil
+	m.clearedFields[tunnel.FieldGroup] = struct{}{}
+}
+
+// GroupCleared returns if the "group" field was cleared in this mutation.
+func (m *TunnelMutation) GroupCleared() bool {
+	_, ok := m.clearedFields[tunnel.FieldGroup]
+	return ok
+}
+
+// ResetGroup resets all changes to the "group" field.
+func (m *TunnelMutation) ResetGroup() {
+	m.group = nil
+	delete(m.clearedFields, tunnel.FieldGroup)
+}
+
+// SetEnabled sets the "enabled" field.
+func (m *TunnelMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *TunnelMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the Tunnel entity.
+// If the Tunnel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TunnelMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *TunnelMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// SetMcpEnabled sets the "mcp_enabled" field.
+func (m *TunnelMutation) SetMcpEnabled(b bool) {
+	m.mcp_enabled = &b
+}
+
+// McpEnabled returns the value of the "mcp_enabled" field in the mutation.
+func (m *TunnelMutation) McpEnabled() (r bool, exists bool) {
+	v := m.mcp_enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMcpEnabled returns the old "mcp_enabled" field's value of the Tunnel entity.
+// If the Tunnel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TunnelMutation) OldMcpEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMcpEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMcpEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMcpEnabled: %w", err)
+	}
+	return oldValue.McpEnabled, nil
+}
+
+// ResetMcpEnabled resets all changes to the "mcp_enabled" field.
+func (m *TunnelMutation) ResetMcpEnabled() {
+	m.mcp_enabled = nil
+}
+
+// SetFavorite sets the "favorite" field.
+func (m *TunnelMutation) SetFavorite(b bool) {
+	m.favorite = &b
+}
+
+// Favorite returns the value of the "favorite" field in the mutation.
+func (m *TunnelMutation) Favorite() (r bool, exists bool) {
+	v := m.favorite
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFavorite returns the old "favorite" field's value of the Tunnel entity.
+// If the Tunnel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TunnelMutation) OldFavorite(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFavorite is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFavorite requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFavorite: %w", err)
+	}
+	return oldValue.Favorite, nil
+}
+
+// ResetFavorite resets all changes to the "favorite" field.
+func (m *TunnelMutation) ResetFavorite() {
+	m.favorite = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TunnelMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TunnelMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Tunnel entity.
+// If the Tunnel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TunnelMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TunnelMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *TunnelMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *TunnelMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Tunnel entity.
+// If the Tunnel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TunnelMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *TunnelMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetNgrokAuthtoken sets the "ngrok_authtoken" field.
+func (m *TunnelMutation) SetNgrokAuthtoken(s string) {
+	m.ngrok_authtoken = &s
+}
+
+// NgrokAuthtoken returns the value of the "ngrok_authtoken" field in the mutation.
+func (m *TunnelMutation) NgrokAuthtoken() (r string, exists bool) {
+	v := m.ngrok_authtoken
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNgrokAuthtoken returns the old "ngrok_authtoken" field's value of the Tunnel entity.
+// If the Tunnel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TunnelMutation) OldNgrokAuthtoken(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNgrokAuthtoken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNgrokAuthtoken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNgrokAuthtoken: %w", err)
+	}
+	return oldValue.NgrokAuthtoken, nil
+}
+
+// ClearNgrokAuthtoken clears the value of the "ngrok_authtoken" field.
+func (m *TunnelMutation) ClearNgrokAuthtoken() {
+	m.ngrok_authtoken = nil
+	m.clearedFields[tunnel.FieldNgrokAuthtoken] = struct{}{}
+}
+
+// NgrokAuthtokenCleared returns if the "ngrok_authtoken" field was cleared in this mutation.
+func (m *TunnelMutation) NgrokAuthtokenCleared() bool {
+	_, ok := m.clearedFields[tunnel.FieldNgrokAuthtoken]
+	return ok
+}
+
+// ResetNgrokAuthtoken resets all changes to the "ngrok_authtoken" field.
+func (m *TunnelMutation) ResetNgrokAuthtoken() {
+	m.ngrok_authtoken = nil
+	delete(m.clearedFields, tunnel.FieldNgrokAuthtoken)
+}
+
+// SetNgrokCredential sets the "ngrok_credential" field.
+func (m *TunnelMutation) SetNgrokCredential(s string) {
+	m.ngrok_credential = &s
+}
+
+// NgrokCredential returns the value of the "ngrok_credential" field in the mutation.
+func (m *TunnelMutation) NgrokCredential() (r string, exists bool) {
+	v := m.ngrok_credential
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNgrokCredential returns the old "ngrok_credential" field's value of the Tunnel entity.
+// If the Tunnel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TunnelMutation) OldNgrokCredential(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNgrokCredential is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNgrokCredential requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNgrokCredential: %w", err)
+	}
+	return oldValue.NgrokCredential, nil
+}
+
+// ClearNgrokCredential clears the value of the "ngrok_credential" field.
+func (m *TunnelMutation) ClearNgrokCredential() {
+	m.ngrok_credential = nil
+	m.clearedFields[tunnel.FieldNgrokCredential] = struct{}{}
+}
+
+// NgrokCredentialCleared returns if the "ngrok_credential" field was cleared in this mutation.
+func (m *TunnelMutation) NgrokCredentialCleared() bool {
+	_, ok := m.clearedFields[tunnel.FieldNgrokCredential]
+	return ok
+}
+
+// ResetNgrokCredential resets all changes to the "ngrok_credential" field.
+func (m *TunnelMutation) ResetNgrokCredential() {
+	m.ngrok_credential = nil
+	delete(m.clearedFields, tunnel.FieldNgrokCredential)
+}
+
+// SetNgrokDomain sets the "ngrok_domain" field.
+func (m *TunnelMutation) SetNgrokDomain(s string) {
+	m.ngrok_domain = &s
+}
+
+// NgrokDomain returns the value of the "ngrok_domain" field in the mutation.
+func (m *TunnelMutation) NgrokDomain() (r string, exists bool) {
+	v := m.ngrok_domain
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNgrokDomain returns the old "ngrok_domain" field's value of the Tunnel entity.
+// If the Tunnel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TunnelMutation) OldNgrokDomain(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNgrokDomain is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNgrokDomain requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNgrokDomain: %w", err)
+	}
+	return oldValue.NgrokDomain, nil
+}
+
+// ClearNgrokDomain clears the value of the "ngrok_domain" field.
+func (m *TunnelMutation) ClearNgrokDomain() {
+	m.ngrok_domain = nil
+	m.clearedFields[tunnel.FieldNgrokDomain] = struct{}{}
+}
+
+// NgrokDomainCleared returns if the "ngrok_domain" field was cleared in this mutation.
+func (m *TunnelMutation) NgrokDomainCleared() bool {
+	_, ok := m.clearedFields[tunnel.FieldNgrokDomain]
+	return ok
+}
+
+// ResetNgrokDomain resets all changes to the "ngrok_domain" field.
+func (m *TunnelMutation) ResetNgrokDomain() {
+	m.ngrok_domain = nil
+	delete(m.clearedFields, tunnel.FieldNgrokDomain)
+}
+
+// SetNgrokWebhookProvider sets the "ngrok_webhook_provider" field.
+func (m *TunnelMutation) SetNgrokWebhookProvider(s string) {
+	m.ngrok_webhook_provider = &s
+}
+
+// NgrokWebhookProvider returns the value of the "ngrok_webhook_provider" field in the mutation.
+func (m *TunnelMutation) NgrokWebhookProvider() (r string, exists bool) {
+	v := m.ngrok_webhook_provider
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNgrokWebhookProvider returns the old "ngrok_webhook_provider" field's value of the Tunnel entity.
+// If the Tunnel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TunnelMutation) OldNgrokWebhookProvider(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNgrokWebhookProvider is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNgrokWebhookProvider requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNgrokWebhookProvider: %w", err)
+	}
+	return oldValue.NgrokWebhookProvider, nil
+}
+
+// ClearNgrokWebhookProvider clears the value of the "ngrok_webhook_provider" field.
+func (m *TunnelMutation) ClearNgrokWebhookProvider() {
+	m.ngrok_webhook_provider = nil
+	m.clearedFields[tunnel.FieldNgrokWebhookProvider] = struct{}{}
+}
+
+// NgrokWebhookProviderCleared returns if the "ngrok_webhook_provider" field was cleared in this mutation.
+func (m *TunnelMutation) NgrokWebhookProviderCleared() bool {
+	_, ok := m.clearedFields[tunnel.FieldNgrokWebhookProvider]
+	return ok
+}
+
+// ResetNgrokWebhookProvider resets all changes to the "ngrok_webhook_provider" field.
+func (m *TunnelMutation) ResetNgrokWebhookProvider() {
+	m.ngrok_webhook_provider = nil
+	delete(m.clearedFields, tunnel.FieldNgrokWebhookProvider)
+}
+
+// SetNgrokWebhookSecret sets the "ngrok_webhook_secret" field.
+func (m *TunnelMutation) SetNgrokWebhookSecret(s string) {
+	m.ngrok_webhook_secret = &s
+}
+
+// NgrokWebhookSecret returns the value of the "ngrok_webhook_secret" field in the mutation.
+func (m *TunnelMutation) NgrokWebhookSecret() (r string, exists bool) {
+	v := m.ngrok_webhook_secret
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNgrokWebhookSecret returns the old "ngrok_webhook_secret" field's value of the Tunnel entity.
+// If the Tunnel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TunnelMutation) OldNgrokWebhookSecret(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNgrokWebhookSecret is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNgrokWebhookSecret requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNgrokWebhookSecret: %w", err)
+	}
+	return oldValue.NgrokWebhookSecret, nil
+}
+
+// ClearNgrokWebhookSecret clears the value of the "ngrok_webhook_secret" field.
+func (m *TunnelMutation) ClearNgrokWebhookSecret() {
+	m.ngrok_webhook_secret = nil
+	m.clearedFields[tunnel.FieldNgrokWebhookSecret] = struct{}{}
+}
+
+// NgrokWebhookSecretCleared returns if the "ngrok_webhook_secret" field was cleared in this mutation.
+func (m *TunnelMutation) NgrokWebhookSecretCleared() bool {
+	_, ok := m.clearedFields[tunnel.FieldNgrokWebhookSecret]
+	return ok
+}
+
+// ResetNgrokWebhookSecret resets all changes to the "ngrok_webhook_secret" field.
+func (m *TunnelMutation) ResetNgrokWebhookSecret() {
+	m.ngrok_webhook_secret = nil
+	delete(m.clearedFields, tunnel.FieldNgrokWebhookSecret)
+}
+
+// SetNgrokInternal sets the "ngrok_internal" field.
+func (m *TunnelMutation) SetNgrokInternal(b bool) {
+	m.ngrok_internal = &b
+}
+
+// NgrokInternal returns the value of the "ngrok_internal" field in the mutation.
+func (m *TunnelMutation) NgrokInternal() (r bool, exists bool) {
+	v := m.ngrok_internal
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNgrokInternal returns the old "ngrok_internal" field's value of the Tunnel entity.
+// If the Tunnel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TunnelMutation) OldNgrokInternal(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNgrokInternal is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNgrokInternal requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNgrokInternal: %w", err)
+	}
+	return oldValue.NgrokInternal, nil
+}
+
+// ResetNgrokInternal resets all changes to the "ngrok_internal" field.
+func (m *TunnelMutation) ResetNgrokInternal() {
+	m.ngrok_internal = nil
+}
+
+// SetNgrokRateLimit sets the "ngrok_rate_limit" field.
+func (m *TunnelMutation) SetNgrokRateLimit(s string) {
+	m.ngrok_rate_limit = &s
+}
+
+// NgrokRateLimit returns the value of the "ngrok_rate_limit" field in the mutation.
+func (m *TunnelMutation) NgrokRateLimit() (r string, exists bool) {
+	v := m.ngrok_rate_limit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNgrokRateLimit returns the old "ngrok_rate_limit" field's value of the Tunnel entity.
+// If the Tunnel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TunnelMutation) OldNgrokRateLimit(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNgrokRateLimit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNgrokRateLimit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNgrokRateLimit: %w", err)
+	}
+	return oldValue.NgrokRateLimit, nil
+}
+
+// ClearNgrokRateLimit clears the value of the "ngrok_rate_limit" field.
+func (m *TunnelMutation) ClearNgrokRateLimit() {
+	m.ngrok_rate_limit = nil
+	m.clearedFields[tunnel.FieldNgrokRateLimit] = struct{}{}
+}
+
+// NgrokRateLimitCleared returns if the "ngrok_rate_limit" field was cleared in this mutation.
+func (m *TunnelMutation) NgrokRateLimitCleared() bool {
+	_, ok := m.clearedFields[tunnel.FieldNgrokRateLimit]
+	return ok
+}
+
+// ResetNgrokRateLimit resets all changes to the "ngrok_rate_limit" field.
+func (m *TunnelMutation) ResetNgrokRateLimit() {
+	m.ngrok_rate_limit = nil
+	delete(m.clearedFields, tunnel.FieldNgrokRateLimit)
+}
+
+// SetCloudflareConnectTimeout sets the "cloudflare_connect_timeout" field.
+func (m *TunnelMutation) SetCloudflareConnectTimeout(s string) {
+	m.cloudflare_connect_timeout = &s
+}
+
+// CloudflareConnectTimeout returns the value of the "cloudflare_connect_timeout" field in the mutation.
+func (m *TunnelMutation) CloudflareConnectTimeout() (r string, exists bool) {
+	v := m.cloudflare_connect_timeout
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCloudflareConnectTimeout returns the old "cloudflare_connect_timeout" field's value of the Tunnel entity.
+// If the Tunnel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TunnelMutation) OldCloudflareConnectTimeout(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCloudflareConnectTimeout is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCloudflareConnectTimeout requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCloudflareConnectTimeout: %w", err)
+	}
+	return oldValue.CloudflareConnectTimeout, nil
+}
+
+// ClearCloudflareConnectTimeout clears the value of the "cloudflare_connect_timeout" field.
+func (m *TunnelMutation) ClearCloudflareConnectTimeout() {
+	m.cloudflare_connect_timeout = nil
+	m.clearedFields[tunnel.FieldCloudflareConnectTimeout] = struct{}{}
+}
+
+// CloudflareConnectTimeoutCleared returns if the "cloudflare_connect_timeout" field was cleared in this mutation.
+func (m *TunnelMutation) CloudflareConnectTimeoutCleared() bool {
+	_, ok := m.clearedFields[tunnel.FieldCloudflareConnectTimeout]
+	return ok
+}
+
+// ResetCloudflareConnectTimeout resets all changes to the "cloudflare_connect_timeout" field.
+func (m *TunnelMutation) ResetCloudflareConnectTimeout() {
+	m.cloudflare_connect_timeout = nil
+	delete(m.clearedFields, tunnel.FieldCloudflareConnectTimeout)
+}
+
+// SetCloudflareNoTLSVerify sets the "cloudflare_no_tls_verify" field.
+func (m *TunnelMutation) SetCloudflareNoTLSVerify(b bool) {
+	m.cloudflare_no_tls_verify = &b
+}
+
+// CloudflareNoTLSVerify returns the value of the "cloudflare_no_tls_verify" field in the mutation.
+func (m *TunnelMutation) CloudflareNoTLSVerify() (r bool, exists bool) {
+	v := m.cloudflare_no_tls_verify
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCloudflareNoTLSVerify returns the old "cloudflare_no_tls_verify" field's value of the Tunnel entity.
+// If the Tunnel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TunnelMutation) OldCloudflareNoTLSVerify(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCloudflareNoTLSVerify is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCloudflareNoTLSVerify requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCloudflareNoTLSVerify: %w", err)
+	}
+	return oldValue.CloudflareNoTLSVerify, nil
+}
+
+// ResetCloudflareNoTLSVerify resets all changes to the "cloudflare_no_tls_verify" field.
+func (m *TunnelMutation) ResetCloudflareNoTLSVerify() {
+	m.cloudflare_no_tls_verify = nil
+}
+
+// SetCloudflareHTTPHostHeader sets the "cloudflare_http_host_header" field.
+func (m *TunnelMutation) SetCloudflareHTTPHostHeader(s string) {
+	m.cloudflare_http_host_header = &s
+}
+
+// CloudflareHTTPHostHeader returns the value of the "cloudflare_http_host_header" field in the mutation.
+func (m *TunnelMutation) CloudflareHTTPHostHeader() (r string, exists bool) {
+	v := m.cloudflare_http_host_header
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCloudflareHTTPHostHeader returns the old "cloudflare_http_host_header" field's value of the Tunnel entity.
+// If the Tunnel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TunnelMutation) OldCloudflareHTTPHostHeader(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCloudflareHTTPHostHeader is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCloudflareHTTPHostHeader requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCloudflareHTTPHostHeader: %w", err)
+	}
+	return oldValue.CloudflareHTTPHostHeader, nil
+}
+
+// ClearCloudflareHTTPHostHeader clears the value of the "cloudflare_http_host_header" field.
+func (m *TunnelMutation) ClearCloudflareHTTPHostHeader() {
+	m.cloudflare_http_host_header = nil
+	m.clearedFields[tunnel.FieldCloudflareHTTPHostHeader] = struct{}{}
+}
+
+// CloudflareHTTPHostHeaderCleared returns if the "cloudflare_http_host_header" field was cleared in this mutation.
+func (m *TunnelMutation) CloudflareHTTPHostHeaderCleared() bool {
+	_, ok := m.clearedFields[tunnel.FieldCloudflareHTTPHostHeader]
+	return ok
+}
+
+// ResetCloudflareHTTPHostHeader resets all changes to the "cloudflare_http_host_header" field.
+func (m *TunnelMutation) ResetCloudflareHTTPHostHeader() {
+	m.cloudflare_http_host_header = nil
+	delete(m.clearedFields, tunnel.FieldCloudflareHTTPHostHeader)
+}
+
+// SetCloudflareRegion sets the "cloudflare_region" field.
+func (m *TunnelMutation) SetCloudflareRegion(s string) {
+	m.cloudflare_region = &s
+}
+
+// CloudflareRegion returns the value of the "cloudflare_region" field in the mutation.
+func (m *TunnelMutation) CloudflareRegion() (r string, exists bool) {
+	v := m.cloudflare_region
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCloudflareRegion returns the old "cloudflare_region" field's value of the Tunnel entity.
+// If the Tunnel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TunnelMutation) OldCloudflareRegion(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCloudflareRegion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCloudflareRegion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCloudflareRegion: %w", err)
+	}
+	return oldValue.CloudflareRegion, nil
+}
+
+// ClearCloudflareRegion clears the value of the "cloudflare_region" field.
+func (m *TunnelMutation) ClearCloudflareRegion() {
+	m.cloudflare_region = nil
+	m.clearedFields[tunnel.FieldCloudflareRegion] = struct{}{}
+}
+
+// CloudflareRegionCleared returns if the "cloudflare_region" field was cleared in this mutation.
+func (m *TunnelMutation) CloudflareRegionCleared() bool {
+	_, ok := m.clearedFields[tunnel.FieldCloudflareRegion]
+	return ok
+}
+
+// ResetCloudflareRegion resets all changes to the "cloudflare_region" field.
+func (m *TunnelMutation) ResetCloudflareRegion() {
+	m.cloudflare_region = nil
+	delete(m.clearedFields, tunnel.FieldCloudflareRegion)
+}
+
+// SetCloudflareEnv sets the "cloudflare_env" field.
+func (m *TunnelMutation) SetCloudflareEnv(value map[string]string) {
+	m.cloudflare_env = &value
+}
+
+// CloudflareEnv returns the value of the "cloudflare_env" field in the mutation.
+func (m *TunnelMutation) CloudflareEnv() (r map[string]string, exists bool) {
+	v := m.cloudflare_env
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCloudflareEnv returns the old "cloudflare_env" field's value of the Tunnel entity.
+// If the Tunnel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TunnelMutation) OldCloudflareEnv(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCloudflareEnv is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCloudflareEnv requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCloudflareEnv: %w", err)
+	}
+	return oldValue.CloudflareEnv, nil
+}
+
+// ClearCloudflareEnv clears the value of the "cloudflare_env" field.
+func (m *TunnelMutation) ClearCloudflareEnv() {
+	m.cloudflare_env = nil
+	m.clearedFields[tunnel.FieldCloudflareEnv] = struct{}{}
+}
+
+// CloudflareEnvCleared returns if the "cloudflare_env" field was cleared in this mutation.
+func (m *TunnelMutation) CloudflareEnvCleared() bool {
+	_, ok := m.clearedFields[tunnel.FieldCloudflareEnv]
+	return ok
+}
+
+// ResetCloudflareEnv resets all changes to the "cloudflare_env" field.
+func (m *TunnelMutation) ResetCloudflareEnv() {
+	m.cloudflare_env = nil
+	delete(m.clearedFields, tunnel.FieldCloudflareEnv)
+}
+
+// SetCloudflareErrorPage sets the "cloudflare_error_page" field.
+func (m *TunnelMutation) SetCloudflareErrorPage(s string) {
+	m.cloudflare_error_page = &s
+}
+
+// CloudflareErrorPage returns the value of the "cloudflare_error_page" field in the mutation.
+func (m *TunnelMutation) CloudflareErrorPage() (r string, exists bool) {
+	v := m.cloudflare_error_page
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCloudflareErrorPage returns the old "cloudflare_error_page" field's value of the Tunnel entity.
+// If the Tunnel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TunnelMutation) OldCloudflareErrorPage(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCloudflareErrorPage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCloudflareErrorPage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCloudflareErrorPage: %w", err)
+	}
+	return oldValue.CloudflareErrorPage, nil
+}
+
+// ClearCloudflareErrorPage clears the value of the "cloudflare_error_page" field.
+func (m *TunnelMutation) ClearCloudflareErrorPage() {
+	m.cloudflare_error_page = nil
+	m.clearedFields[tunnel.FieldCloudflareErrorPage] = struct{}{}
+}
+
+// CloudflareErrorPageCleared returns if the "cloudflare_error_page" field was cleared in this mutation.
+func (m *TunnelMutation) CloudflareErrorPageCleared() bool {
+	_, ok := m.clearedFields[tunnel.FieldCloudflareErrorPage]
+	return ok
+}
+
+// ResetCloudflareErrorPage resets all changes to the "cloudflare_error_page" field.
+func (m *TunnelMutation) ResetCloudflareErrorPage() {
+	m.cloudflare_error_page = nil
+	delete(m.clearedFields, tunnel.FieldCloudflareErrorPage)
+}
+
+// SetErrorGrace sets the "error_grace" field.
+func (m *TunnelMutation) SetErrorGrace(s string) {
+	m.error_grace = &s
+}
+
+// ErrorGrace returns the value of the "error_grace" field in the mutation.
+func (m *TunnelMutation) ErrorGrace() (r string, exists bool) {
+	v := m.error_grace
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldErrorGrace returns the old "error_grace" field's value of the Tunnel entity.
+// If the Tunnel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TunnelMutation) OldErrorGrace(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldErrorGrace is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldErrorGrace requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldErrorGrace: %w", err)
+	}
+	return oldValue.ErrorGrace, nil
+}
+
+// ClearErrorGrace clears the value of the "error_grace" field.
+func (m *TunnelMutation) ClearErrorGrace() {
+	m.error_grace = nil
+	m.clearedFields[tunnel.FieldErrorGrace] = struct{}{}
+}
+
+// ErrorGraceCleared returns if the "error_grace" field was cleared in this mutation.
+func (m *TunnelMutation) ErrorGraceCleared() bool {
+	_, ok := m.clearedFields[tunnel.FieldErrorGrace]
+	return ok
+}
+
+// ResetErrorGrace resets all changes to the "error_grace" field.
+func (m *TunnelMutation) ResetErrorGrace() {
+	m.error_grace = nil
+	delete(m.clearedFields, tunnel.FieldErrorGrace)
+}
+
+// SetArchived sets the "archived" field.
+func (m *TunnelMutation) SetArchived(b bool) {
+	m.archived = &b
+}
+
+// Archived returns the value of the "archived" field in the mutation.
+func (m *TunnelMutation) Archived() (r bool, exists bool) {
+	v := m.archived
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArchived returns the old "archived" field's value of the Tunnel entity.
+// If the Tunnel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TunnelMutation) OldArchived(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArchived is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArchived requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArchived: %w", err)
+	}
+	return oldValue.Archived, nil
+}
+
+// ResetArchived resets all changes to the "archived" field.
+func (m *TunnelMutation) ResetArchived() {
+	m.archived = nil
+}
+
+// SetPaused sets the "paused" field.
+func (m *TunnelMutation) SetPaused(b bool) {
+	m.paused = &b
+}
+
+// Paused returns the value of the "paused" field in the mutation.
+func (m *TunnelMutation) Paused() (r bool, exists bool) {
+	v := m.paused
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPaused returns the old "paused" field's value of the Tunnel entity.
+// If the Tunnel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TunnelMutation) OldPaused(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPaused is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPaused requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPaused: %w", err)
+	}
+	return oldValue.Paused, nil
+}
+
+// ResetPaused resets all changes to the "paused" field.
+func (m *TunnelMutation) ResetPaused() {
+	m.paused = nil
+}
+
+// SetProbeEnabled sets the "probe_enabled" field.
+func (m *TunnelMutation) SetProbeEnabled(b bool) {
+	m.probe_enabled = &b
+}
+
+// ProbeEnabled returns the value of the "probe_enabled" field in the mutation.
+func (m *TunnelMutation) ProbeEnabled() (r bool, exists bool) {
+	v := m.probe_enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProbeEnabled returns the old "probe_enabled" field's value of the Tunnel entity.
+// If the Tunnel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TunnelMutation) OldProbeEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProbeEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProbeEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProbeEnabled: %w", err)
+	}
+	return oldValue.ProbeEnabled, nil
+}
+
+// ResetProbeEnabled resets all changes to the "probe_enabled" field.
+func (m *TunnelMutation) ResetProbeEnabled() {
+	m.probe_enabled = nil
+}
+
+// SetProbeInterval sets the "probe_interval" field.
+func (m *TunnelMutation) SetProbeInterval(s string) {
+	m.probe_interval = &s
+}
+
+// ProbeInterval returns the value of the "probe_interval" field in the mutation.
+func (m *TunnelMutation) ProbeInterval() (r string, exists bool) {
+	v := m.probe_interval
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProbeInterval returns the old "probe_interval" field's value of the Tunnel entity.
+// If the Tunnel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TunnelMutation) OldProbeInterval(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProbeInterval is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProbeInterval requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProbeInterval: %w", err)
+	}
+	return oldValue.ProbeInterval, nil
+}
+
+// ClearProbeInterval clears the value of the "probe_interval" field.
+func (m *TunnelMutation) ClearProbeInterval() {
+	m.probe_interval = nil
+	m.clearedFields[tunnel.FieldProbeInterval] = struct{}{}
+}
+
+// ProbeIntervalCleared returns if the "probe_interval" field was cleared in this mutation.
+func (m *TunnelMutation) ProbeIntervalCleared() bool {
+	_, ok := m.clearedFields[tunnel.FieldProbeInterval]
+	return ok
+}
+
+// ResetProbeInterval resets all changes to the "probe_interval" field.
+func (m *TunnelMutation) ResetProbeInterval() {
+	m.probe_interval = nil
+	delete(m.clearedFields, tunnel.FieldProbeInterval)
+}
+
+// SetProbeTimeout sets the "probe_timeout" field.
+func (m *TunnelMutation) SetProbeTimeout(s string) {
+	m.probe_timeout = &s
+}
+
+// ProbeTimeout returns the value of the "probe_timeout" field in the mutation.
+func (m *TunnelMutation) ProbeTimeout() (r string, exists bool) {
+	v := m.probe_timeout
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProbeTimeout returns the old "probe_timeout" field's value of the Tunnel entity.
+// If the Tunnel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TunnelMutation) OldProbeTimeout(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProbeTimeout is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProbeTimeout requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProbeTimeout: %w", err)
+	}
+	return oldValue.ProbeTimeout, nil
+}
+
+// ClearProbeTimeout clears the value of the "probe_timeout" field.
+func (m *TunnelMutation) ClearProbeTimeout() {
+	m.probe_timeout = nil
+	m.clearedFields[tunnel.FieldProbeTimeout] = struct{}{}
+}
+
+// ProbeTimeoutCleared returns if the "probe_timeout" field was cleared in this mutation.
+func (m *TunnelMutation) ProbeTimeoutCleared() bool {
+	_, ok := m.clearedFields[tunnel.FieldProbeTimeout]
+	return ok
+}
+
+// ResetProbeTimeout resets all changes to the "probe_timeout" field.
+func (m *TunnelMutation) ResetProbeTimeout() {
+	m.probe_timeout = nil
+	delete(m.clearedFields, tunnel.FieldProbeTimeout)
+}
+
+// SetProbeRetries sets the "probe_retries" field.
+func (m *TunnelMutation) SetProbeRetries(i int) {
+	m.probe_retries = &i
+	m.addprobe_retries = nil
+}
+
+// ProbeRetries returns the value of the "probe_retries" field in the mutation.
+func (m *TunnelMutation) ProbeRetries() (r int, exists bool) {
+	v := m.probe_retries
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProbeRetries returns the old "probe_retries" field's value of the Tunnel entity.
+// If the Tunnel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TunnelMutation) OldProbeRetries(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProbeRetries is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProbeRetries requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProbeRetries: %w", err)
+	}
+	return oldValue.ProbeRetries, nil
+}
+
+// AddProbeRetries adds i to the "probe_retries" field.
+func (m *TunnelMutation) AddProbeRetries(i int) {
+	if m.addprobe_retries != nil {
+		*m.addprobe_retries += i
+	} else {
+		m.addprobe_retries = &i
+	}
+}
+
+// AddedProbeRetries returns the value that was added to the "probe_retries" field in this mutation.
+func (m *TunnelMutation) AddedProbeRetries() (r int, exists bool) {
+	v := m.addprobe_retries
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetProbeRetries resets all changes to the "probe_retries" field.
+func (m *TunnelMutation) ResetProbeRetries() {
+	m.probe_retries = nil
+	m.addprobe_retries = nil
+}
+
+// SetProbePath sets the "probe_path" field.
+func (m *TunnelMutation) SetProbePath(s string) {
+	m.probe_path = &s
+}
+
+// ProbePath returns the value of the "probe_path" field in the mutation.
+func (m *TunnelMutation) ProbePath() (r string, exists bool) {
+	v := m.probe_path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProbePath returns the old "probe_path" field's value of the Tunnel entity.
+// If the Tunnel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TunnelMutation) OldProbePath(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProbePath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProbePath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProbePath: %w", err)
+	}
+	return oldValue.ProbePath, nil
+}
+
+// ClearProbePath clears the value of the "probe_path" field.
+func (m *TunnelMutation) ClearProbePath() {
+	m.probe_path = nil
+	m.clearedFields[tunnel.FieldProbePath] = struct{}{}
+}
+
+// ProbePathCleared returns if the "probe_path" field was cleared in this mutation.
+func (m *TunnelMutation) ProbePathCleared() bool {
+	_, ok := m.clearedFields[tunnel.FieldProbePath]
+	return ok
+}
+
+// ResetProbePath resets all changes to the "probe_path" field.
+func (m *TunnelMutation) ResetProbePath() {
+	m.probe_path = nil
+	delete(m.clearedFields, tunnel.FieldProbePath)
+}
+
+// SetIdleTimeout sets the "idle_timeout" field.
+func (m *TunnelMutation) SetIdleTimeout(s string) {
+	m.idle_timeout = &s
+}
+
+// IdleTimeout returns the value of the "idle_timeout" field in the mutation.
+func (m *TunnelMutation) IdleTimeout() (r string, exists bool) {
+	v := m.idle_timeout
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIdleTimeout returns the old "idle_timeout" field's value of the Tunnel entity.
+// If the Tunnel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TunnelMutation) OldIdleTimeout(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIdleTimeout is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIdleTimeout requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIdleTimeout: %w", err)
+	}
+	return oldValue.IdleTimeout, nil
+}
+
+// ClearIdleTimeout clears the value of the "idle_timeout" field.
+func (m *TunnelMutation) ClearIdleTimeout() {
+	m.idle_timeout = nil
+	m.clearedFields[tunnel.FieldIdleTimeout] = struct{}{}
+}
+
+// IdleTimeoutCleared returns if the "idle_timeout" field was cleared in this mutation.
+func (m *TunnelMutation) IdleTimeoutCleared() bool {
+	_, ok := m.clearedFields[tunnel.FieldIdleTimeout]
+	return ok
+}
+
+// ResetIdleTimeout resets all changes to the "idle_timeout" field.
+func (m *TunnelMutation) ResetIdleTimeout() {
+	m.idle_timeout = nil
+	delete(m.clearedFields, tunnel.FieldIdleTimeout)
+}
+
+// SetPreStartHook sets the "pre_start_hook" field.
+func (m *TunnelMutation) SetPreStartHook(s string) {
+	m.pre_start_hook = &s
+}
+
+// PreStartHook returns the value of the "pre_start_hook" field in the mutation.
+func (m *TunnelMutation) PreStartHook() (r string, exists bool) {
+	v := m.pre_start_hook
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPreStartHook returns the old "pre_start_hook" field's value of the Tunnel entity.
+// If the Tunnel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TunnelMutation) OldPreStartHook(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPreStartHook is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPreStartHook requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPreStartHook: %w", err)
+	}
+	return oldValue.PreStartHook, nil
+}
+
+// ClearPreStartHook clears the value of the "pre_start_hook" field.
+func (m *TunnelMutation) ClearPreStartHook() {
+	m.pre_start_hook = nil
+	m.clearedFields[tunnel.FieldPreStartHook] = struct{}{}
+}
+
+// PreStartHookCleared returns if the "pre_start_hook" field was cleared in this mutation.
+func (m *TunnelMutation) PreStartHookCleared() bool {
+	_, ok := m.clearedFields[tunnel.FieldPreStartHook]
+	return ok
+}
+
+// ResetPreStartHook resets all changes to the "pre_start_hook" field.
+func (m *TunnelMutation) ResetPreStartHook() {
+	m.pre_start_hook = nil
+	delete(m.clearedFields, tunnel.FieldPreStartHook)
+}
+
+// SetPostStopHook sets the "post_stop_hook" field.
+func (m *TunnelMutation) SetPostStopHook(s string) {
+	m.post_stop_hook = &s
+}
+
+// PostStopHook returns the value of the "post_stop_hook" field in the mutation.
+func (m *TunnelMutation) PostStopHook() (r string, exists bool) {
+	v := m.post_stop_hook
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPostStopHook returns the old "post_stop_hook" field's value of the Tunnel entity.
+// If the Tunnel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TunnelMutation) OldPostStopHook(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPostStopHook is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPostStopHook requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPostStopHook: %w", err)
+	}
+	return oldValue.PostStopHook, nil
+}
+
+// ClearPostStopHook clears the value of the "post_stop_hook" field.
+func (m *TunnelMutation) ClearPostStopHook() {
+	m.post_stop_hook = nil
+	m.clearedFields[tunnel.FieldPostStopHook] = struct{}{}
+}
+
+// PostStopHookCleared returns if the "post_stop_hook" field was cleared in this mutation.
+func (m *TunnelMutation) PostStopHookCleared() bool {
+	_, ok := m.clearedFields[tunnel.FieldPostStopHook]
+	return ok
+}
+
+// ResetPostStopHook resets all changes to the "post_stop_hook" field.
+func (m *TunnelMutation) ResetPostStopHook() {
+	m.post_stop_hook = nil
+	delete(m.clearedFields, tunnel.FieldPostStopHook)
+}
+
+// Where appends a list predicates to the TunnelMutation builder.
+func (m *TunnelMutation) Where(ps ...predicate.Tunnel) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TunnelMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TunnelMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Tunnel, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TunnelMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TunnelMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Tunnel).
+func (m *TunnelMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TunnelMutation) Fields() []string {
+	fields := make([]string, 0, 33)
+	if m.name != nil {
+		fields = append(fields, tunnel.FieldName)
+	}
+	if m._type != nil {
+		fields = append(fields, tunnel.FieldType)
+	}
+	if m.target != nil {
+		fields = append(fields, tunnel.FieldTarget)
+	}
+	if m.group != nil {
+		fields = append(fields, tunnel.FieldGroup)
+	}
+	if m.enabled != nil {
+		fields = append(fields, tunnel.FieldEnabled)
+	}
+	if m.mcp_enabled != nil {
+		fields = append(fields, tunnel.FieldMcpEnabled)
+	}
+	if m.favorite != nil {
+		fields = append(fields, tunnel.FieldFavorite)
+	}
+	if m.created_at != nil {
+		fields = append(fields, tunnel.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, tunnel.FieldUpdatedAt)
+	}
+	if m.ngrok_authtoken != nil {
+		fields = append(fields, tunnel.FieldNgrokAuthtoken)
+	}
+	if m.ngrok_credential != nil {
+		fields = append(fields, tunnel.FieldNgrokCredential)
+	}
+	if m.ngrok_domain != nil {
+		fields = append(fields, tunnel.FieldNgrokDomain)
+	}
+	if m.ngrok_webhook_provider != nil {
+		fields = append(fields, tunnel.FieldNgrokWebhookProvider)
+	}
+	if m.ngrok_webhook_secret != nil {
+		fields = append(fields, tunnel.FieldNgrokWebhookSecret)
+	}
+	if m.ngrok_internal != nil {
+		fields = append(fields, tunnel.FieldNgrokInternal)
+	}
+	if m.ngrok_rate_limit != nil {
+		fields = append(fields, tunnel.FieldNgrokRateLimit)
+	}
+	if m.cloudflare_connect_timeout != nil {
+		fields = append(fields, tunnel.FieldCloudflareConnectTimeout)
+	}
+	if m.cloudflare_no_tls_verify != nil {
+		fields = append(fields, tunnel.FieldCloudflareNoTLSVerify)
+	}
+	if m.cloudflare_http_host_header != nil {
+		fields = append(fields, tunnel.FieldCloudflareHTTPHostHeader)
+	}
+	if m.cloudflare_region != nil {
+		fields = append(fields, tunnel.FieldCloudflareRegion)
+	}
+	if m.cloudflare_env != nil {
+		fields = append(fields, tunnel.FieldCloudflareEnv)
+	}
+	if m.cloudflare_error_page != nil {
+		fields = append(fields, tunnel.FieldCloudflareErrorPage)
+	}
+	if m.error_grace != nil {
+		fields = append(fields, tunnel.FieldErrorGrace)
+	}
+	if m.archived != nil {
+		fields = append(fields, tunnel.FieldArchived)
+	}
+	if m.paused != nil {
+		fields = append(fields, tunnel.FieldPaused)
+	}
+	if m.probe_enabled != nil {
+		fields = append(fields, tunnel.FieldProbeEnabled)
+	}
+	if m.probe_interval != nil {
+		fields = append(fields, tunnel.FieldProbeInterval)
+	}
+	if m.probe_timeout != nil {
+		fields = append(fields, tunnel.FieldProbeTimeout)
+	}
+	if m.probe_retries != nil {
+		fields = append(fields, tunnel.FieldProbeRetries)
+	}
+	if m.probe_path != nil {
+		fields = append(fields, tunnel.FieldProbePath)
+	}
+	if m.idle_timeout != nil {
+		fields = append(fields, tunnel.FieldIdleTimeout)
+	}
+	if m.pre_start_hook != nil {
+		fields = append(fields, tunnel.FieldPreStartHook)
+	}
+	if m.post_stop_hook != nil {
+		fields = append(fields, tunnel.FieldPostStopHook)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TunnelMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case tunnel.FieldName:
+		return m.Name()
+	case tunnel.FieldType:
+		return m.GetType()
+	case tunnel.FieldTarget:
+		return m.Target()
+	case tunnel.FieldGroup:
+		return m.Group()
+	case tunnel.FieldEnabled:
+		return m.Enabled()
+	case tunnel.FieldMcpEnabled:
+		return m.McpEnabled()
+	case tunnel.FieldFavorite:
+		return m.Favorite()
+	case tunnel.FieldCreatedAt:
+		return m.CreatedAt()
+	case tunnel.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case tunnel.FieldNgrokAuthtoken:
+		return m.NgrokAuthtoken()
+	case tunnel.FieldNgrokCredential:
+		return m.NgrokCredential()
+	case tunnel.FieldNgrokDomain:
+		return m.NgrokDomain()
+	case tunnel.FieldNgrokWebhookProvider:
+		return m.NgrokWebhookProvider()
+	case tunnel.FieldNgrokWebhookSecret:
+		return m.NgrokWebhookSecret()
+	case tunnel.FieldNgrokInternal:
+		return m.NgrokInternal()
+	case tunnel.FieldNgrokRateLimit:
+		return m.NgrokRateLimit()
+	case tunnel.FieldCloudflareConnectTimeout:
+		return m.CloudflareConnectTimeout()
+	case tunnel.FieldCloudflareNoTLSVerify:
+		return m.CloudflareNoTLSVerify()
+	case tunnel.FieldCloudflareHTTPHostHeader:
+		return m.CloudflareHTTPHostHeader()
+	case tunnel.FieldCloudflareRegion:
+		return m.CloudflareRegion()
+	case tunnel.FieldCloudflareEnv:
+		return m.CloudflareEnv()
+	case tunnel.FieldCloudflareErrorPage:
+		return m.CloudflareErrorPage()
+	case tunnel.FieldErrorGrace:
+		return m.ErrorGrace()
+	case tunnel.FieldArchived:
+		return m.Archived()
+	case tunnel.FieldPaused:
+		return m.Paused()
+	case tunnel.FieldProbeEnabled:
+		return m.ProbeEnabled()
+	case tunnel.FieldProbeInterval:
+		return m.ProbeInterval()
+	case tunnel.FieldProbeTimeout:
+		return m.ProbeTimeout()
+	case tunnel.FieldProbeRetries:
+		return m.ProbeRetries()
+	case tunnel.FieldProbePath:
+		return m.ProbePath()
+	case tunnel.FieldIdleTimeout:
+		return m.IdleTimeout()
+	case tunnel.FieldPreStartHook:
+		return m.PreStartHook()
+	case tunnel.FieldPostStopHook:
+		return m.PostStopHook()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TunnelMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case tunnel.FieldName:
+		return m.OldName(ctx)
+	case tunnel.FieldType:
+		return m.OldType(ctx)
+	case tunnel.FieldTarget:
+		return m.OldTarget(ctx)
+	case tunnel.FieldGroup:
+		return m.OldGroup(ctx)
+	case tunnel.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case tunnel.FieldMcpEnabled:
+		return m.OldMcpEnabled(ctx)
+	case tunnel.FieldFavorite:
+		return m.OldFavorite(ctx)
+	case tunnel.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case tunnel.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case tunnel.FieldNgrokAuthtoken:
+		return m.OldNgrokAuthtoken(ctx)
+	case tunnel.FieldNgrokCredential:
+		return m.OldNgrokCredential(ctx)
+	case tunnel.FieldNgrokDomain:
+		return m.OldNgrokDomain(ctx)
+	case tunnel.FieldNgrokWebhookProvider:
+		return m.OldNgrokWebhookProvider(ctx)
+	case tunnel.FieldNgrokWebhookSecret:
+		return m.OldNgrokWebhookSecret(ctx)
+	case tunnel.FieldNgrokInternal:
+		return m.OldNgrokInternal(ctx)
+	case tunnel.FieldNgrokRateLimit:
+		return m.OldNgrokRateLimit(ctx)
+	case tunnel.FieldCloudflareConnectTimeout:
+		return m.OldCloudflareConnectTimeout(ctx)
+	case tunnel.FieldCloudflareNoTLSVerify:
+		return m.OldCloudflareNoTLSVerify(ctx)
+	case tunnel.FieldCloudflareHTTPHostHeader:
+		return m.OldCloudflareHTTPHostHeader(ctx)
+	case tunnel.FieldCloudflareRegion:
+		return m.OldCloudflareRegion(ctx)
+	case tunnel.FieldCloudflareEnv:
+		return m.OldCloudflareEnv(ctx)
+	case tunnel.FieldCloudflareErrorPage:
+		return m.OldCloudflareErrorPage(ctx)
+	case tunnel.FieldErrorGrace:
+		return m.OldErrorGrace(ctx)
+	case tunnel.FieldArchived:
+		return m.OldArchived(ctx)
+	case tunnel.FieldPaused:
+		return m.OldPaused(ctx)
+	case tunnel.FieldProbeEnabled:
+		return m.OldProbeEnabled(ctx)
+	case tunnel.FieldProbeInterval:
+		return m.OldProbeInterval(ctx)
+	case tunnel.FieldProbeTimeout:
+		return m.OldProbeTimeout(ctx)
+	case tunnel.FieldProbeRetries:
+		return m.OldProbeRetries(ctx)
+	case tunnel.FieldProbePath:
+		return m.OldProbePath(ctx)
+	case tunnel.FieldIdleTimeout:
+		return m.OldIdleTimeout(ctx)
+	case tunnel.FieldPreStartHook:
+		return m.OldPreStartHook(ctx)
+	case tunnel.FieldPostStopHook:
+		return m.OldPostStopHook(ctx)
+	}
+	return nil, fmt.Errorf("unknown Tunnel field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TunnelMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case tunnel.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case tunnel.FieldType:
+		v, ok := value.(tunnel.Type)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case tunnel.FieldTarget:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTarget(v)
+		return nil
+	case tunnel.FieldGroup:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGroup(v)
+		return nil
+	case tunnel.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	case tunnel.FieldMcpEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMcpEnabled(v)
+		return nil
+	case tunnel.FieldFavorite:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFavorite(v)
+		return nil
+	case tunnel.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case tunnel.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case tunnel.FieldNgrokAuthtoken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNgrokAuthtoken(v)
+		return nil
+	case tunnel.FieldNgrokCredential:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNgrokCredential(v)
+		return nil
+	case tunnel.FieldNgrokDomain:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNgrokDomain(v)
+		return nil
+	case tunnel.FieldNgrokWebhookProvider:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNgrokWebhookProvider(v)
+		return nil
+	case tunnel.FieldNgrokWebhookSecret:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNgrokWebhookSecret(v)
+		return nil
+	case tunnel.FieldNgrokInternal:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNgrokInternal(v)
+		return nil
+	case tunnel.FieldNgrokRateLimit:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNgrokRateLimit(v)
+		return nil
+	case tunnel.FieldCloudflareConnectTimeout:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCloudflareConnectTimeout(v)
+		return nil
+	case tunnel.FieldCloudflareNoTLSVerify:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCloudflareNoTLSVerify(v)
+		return nil
+	case tunnel.FieldCloudflareHTTPHostHeader:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCloudflareHTTPHostHeader(v)
+		return nil
+	case tunnel.FieldCloudflareRegion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCloudflareRegion(v)
+		return nil
+	case tunnel.FieldCloudflareEnv:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCloudflareEnv(v)
+		return nil
+	case tunnel.FieldCloudflareErrorPage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCloudflareErrorPage(v)
+		return nil
+	case tunnel.FieldErrorGrace:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrorGrace(v)
+		return nil
+	case tunnel.FieldArchived:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArchived(v)
+		return nil
+	case tunnel.FieldPaused:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPaused(v)
+		return nil
+	case tunnel.FieldProbeEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProbeEnabled(v)
+		return nil
+	case tunnel.FieldProbeInterval:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProbeInterval(v)
+		return nil
+	case tunnel.FieldProbeTimeout:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProbeTimeout(v)
+		return nil
+	case tunnel.FieldProbeRetries:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProbeRetries(v)
+		return nil
+	case tunnel.FieldProbePath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProbePath(v)
+		return nil
+	case tunnel.FieldIdleTimeout:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIdleTimeout(v)
+		return nil
+	case tunnel.FieldPreStartHook:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPreStartHook(v)
+		return nil
+	case tunnel.FieldPostStopHook:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPostStopHook(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Tunnel field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TunnelMutation) AddedFields() []string {
+	var fields []string
+	if m.addprobe_retries != nil {
+		fields = append(fields, tunnel.FieldProbeRetries)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TunnelMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case tunnel.FieldProbeRetries:
+		return m.AddedProbeRetries()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TunnelMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case tunnel.FieldProbeRetries:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddProbeRetries(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Tunnel numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TunnelMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(tunnel.FieldGroup) {
+		fields = append(fields, tunnel.FieldGroup)
+	}
+	if m.FieldCleared(tunnel.FieldNgrokAuthtoken) {
+		fields = append(fields, tunnel.FieldNgrokAuthtoken)
+	}
+	if m.FieldCleared(tunnel.FieldNgrokCredential) {
+		fields = append(fields, tunnel.FieldNgrokCredential)
+	}
+	if m.FieldCleared(tunnel.FieldNgrokDomain) {
+		fields = append(fields, tunnel.FieldNgrokDomain)
+	}
+	if m.FieldCleared(tunnel.FieldNgrokWebhookProvider) {
+		fields = append(fields, tunnel.FieldNgrokWebhookProvider)
+	}
+	if m.FieldCleared(tunnel.FieldNgrokWebhookSecret) {
+		fields = append(fields, tunnel.FieldNgrokWebhookSecret)
+	}
+	if m.FieldCleared(tunnel.FieldNgrokRateLimit) {
+		fields = append(fields, tunnel.FieldNgrokRateLimit)
+	}
+	if m.FieldCleared(tunnel.FieldCloudflareConnectTimeout) {
+		fields = append(fields, tunnel.FieldCloudflareConnectTimeout)
+	}
+	if m.FieldCleared(tunnel.FieldCloudflareHTTPHostHeader) {
+		fields = append(fields, tunnel.FieldCloudflareHTTPHostHeader)
+	}
+	if m.FieldCleared(tunnel.FieldCloudflareRegion) {
+		fields = append(fields, tunnel.FieldCloudflareRegion)
+	}
+	if m.FieldCleared(tunnel.FieldCloudflareEnv) {
+		fields = append(fields, tunnel.FieldCloudflareEnv)
+	}
+	if m.FieldCleared(tunnel.FieldCloudflareErrorPage) {
+		fields = append(fields, tunnel.FieldCloudflareErrorPage)
+	}
+	if m.FieldCleared(tunnel.FieldErrorGrace) {
+		fields = append(fields, tunnel.FieldErrorGrace)
+	}
+	if m.FieldCleared(tunnel.FieldProbeInterval) {
+		fields = append(fields, tunnel.FieldProbeInterval)
+	}
+	if m.FieldCleared(tunnel.FieldProbeTimeout) {
+		fields = append(fields, tunnel.FieldProbeTimeout)
+	}
+	if m.FieldCleared(tunnel.FieldProbePath) {
+		fields = append(fields, tunnel.FieldProbePath)
+	}
+	if m.FieldCleared(tunnel.FieldIdleTimeout) {
+		fields = append(fields, tunnel.FieldIdleTimeout)
+	}
+	if m.FieldCleared(tunnel.FieldPreStartHook) {
+		fields = append(fields, tunnel.FieldPreStartHook)
+	}
+	if m.FieldCleared(tunnel.FieldPostStopHook) {
+		fields = append(fields, tunnel.FieldPostStopHook)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TunnelMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TunnelMutation) ClearField(name string) error {
+	switch name {
+	case tunnel.FieldGroup:
+		m.ClearGroup()
+		return nil
+	case tunnel.FieldNgrokAuthtoken:
+		m.ClearNgrokAuthtoken()
+		return nil
+	case tunnel.FieldNgrokCredential:
+		m.ClearNgrokCredential()
+		return nil
+	case tunnel.FieldNgrokDomain:
+		m.ClearNgrokDomain()
+		return nil
+	case tunnel.FieldNgrokWebhookProvider:
+		m.ClearNgrokWebhookProvider()
+		return nil
+	case tunnel.FieldNgrokWebhookSecret:
+		m.ClearNgrokWebhookSecret()
+		return nil
+	case tunnel.FieldNgrokRateLimit:
+		m.ClearNgrokRateLimit()
+		return nil
+	case tunnel.FieldCloudflareConnectTimeout:
+		m.ClearCloudflareConnectTimeout()
+		return nil
+	case tunnel.FieldCloudflareHTTPHostHeader:
+		m.ClearCloudflareHTTPHostHeader()
+		return nil
+	case tunnel.FieldCloudflareRegion:
+		m.ClearCloudflareRegion()
+		return nil
+	case tunnel.FieldCloudflareEnv:
+		m.ClearCloudflareEnv()
+		return nil
+	case tunnel.FieldCloudflareErrorPage:
+		m.ClearCloudflareErrorPage()
+		return nil
+	case tunnel.FieldErrorGrace:
+		m.ClearErrorGrace()
+		return nil
+	case tunnel.FieldProbeInterval:
+		m.ClearProbeInterval()
+		return nil
+	case tunnel.FieldProbeTimeout:
+		m.ClearProbeTimeout()
+		return nil
+	case tunnel.FieldProbePath:
+		m.ClearProbePath()
+		return nil
+	case tunnel.FieldIdleTimeout:
+		m.ClearIdleTimeout()
+		return nil
+	case tunnel.FieldPreStartHook:
+		m.ClearPreStartHook()
+		return nil
+	case tunnel.FieldPostStopHook:
+		m.ClearPostStopHook()
+		return nil
+	}
+	return fmt.Errorf("unknown Tunnel nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TunnelMutation) ResetField(name string) error {
+	switch name {
+	case tunnel.FieldName:
+		m.ResetName()
+		return nil
+	case tunnel.FieldType:
+		m.ResetType()
+		return nil
+	case tunnel.FieldTarget:
+		m.ResetTarget()
+		return nil
+	case tunnel.FieldGroup:
+		m.ResetGroup()
+		return nil
+	case tunnel.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	case tunnel.FieldMcpEnabled:
+		m.ResetMcpEnabled()
+		return nil
+	case tunnel.FieldFavorite:
+		m.ResetFavorite()
+		return nil
+	case tunnel.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case tunnel.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case tunnel.FieldNgrokAuthtoken:
+		m.ResetNgrokAuthtoken()
+		return nil
+	case tunnel.FieldNgrokCredential:
+		m.ResetNgrokCredential()
+		return nil
+	case tunnel.FieldNgrokDomain:
+		m.ResetNgrokDomain()
+		return nil
+	case tunnel.FieldNgrokWebhookProvider:
+		m.ResetNgrokWebhookProvider()
+		return nil
+	case tunnel.FieldNgrokWebhookSecret:
+		m.ResetNgrokWebhookSecret()
+		return nil
+	case tunnel.FieldNgrokInternal:
+		m.ResetNgrokInternal()
+		return nil
+	case tunnel.FieldNgrokRateLimit:
+		m.ResetNgrokRateLimit()
+		return nil
+	case tunnel.FieldCloudflareConnectTimeout:
+		m.ResetCloudflareConnectTimeout()
+		return nil
+	case tunnel.FieldCloudflareNoTLSVerify:
+		m.ResetCloudflareNoTLSVerify()
+		return nil
+	case tunnel.FieldCloudflareHTTPHostHeader:
+		m.ResetCloudflareHTTPHostHeader()
+		return nil
+	case tunnel.FieldCloudflareRegion:
+		m.ResetCloudflareRegion()
+		return nil
+	case tunnel.FieldCloudflareEnv:
+		m.ResetCloudflareEnv()
+		return nil
+	case tunnel.FieldCloudflareErrorPage:
+		m.ResetCloudflareErrorPage()
+		return nil
+	case tunnel.FieldErrorGrace:
+		m.ResetErrorGrace()
+		return nil
+	case tunnel.FieldArchived:
+		m.ResetArchived()
+		return nil
+	case tunnel.FieldPaused:
+		m.ResetPaused()
+		return nil
+	case tunnel.FieldProbeEnabled:
+		m.ResetProbeEnabled()
+		return nil
+	case tunnel.FieldProbeInterval:
+		m.ResetProbeInterval()
+		return nil
+	case tunnel.FieldProbeTimeout:
+		m.ResetProbeTimeout()
+		return nil
+	case tunnel.FieldProbeRetries:
+		m.ResetProbeRetries()
+		return nil
+	case tunnel.FieldProbePath:
+		m.ResetProbePath()
+		return nil
+	case tunnel.FieldIdleTimeout:
+		m.ResetIdleTimeout()
+		return nil
+	case tunnel.FieldPreStartHook:
+		m.ResetPreStartHook()
+		return nil
+	case tunnel.FieldPostStopHook:
+		m.ResetPostStopHook()
+		return nil
+	}
+	return fmt.Errorf("unknown Tunnel field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TunnelMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TunnelMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TunnelMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TunnelMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TunnelMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TunnelMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TunnelMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Tunnel unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TunnelMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Tunnel edge %s", name)
+}
+
+// URLHistoryMutation represents an operation that mutates the URLHistory nodes in the graph.
+type URLHistoryMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	tunnel_id     *string
+	url           *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*URLHistory, error)
+	predicates    []predicate.URLHistory
+}
+
+var _ ent.Mutation = (*URLHistoryMutation)(nil)
+
+// urlhistoryOption allows management of the mutation configuration using functional options.
+type urlhistoryOption func(*URLHistoryMutation)
+
+// newURLHistoryMutation creates new mutation for the URLHistory entity.
+func newURLHistoryMutation(c config, op Op, opts ...urlhistoryOption) *URLHistoryMutation {
+	m := &URLHistoryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeURLHistory,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withURLHistoryID sets the ID field of the mutation.
+func withURLHistoryID(id int) urlhistoryOption {
+	return func(m *URLHistoryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *URLHistory
+		)
+		m.oldValue = func(ctx context.Context) (*URLHistory, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().URLHistory.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withURLHistory sets the old URLHistory of the mutation.
+func withURLHistory(node *URLHistory) urlhistoryOption {
+	return func(m *URLHistoryMutation) {
+		m.oldValue = func(context.Context) (*URLHistory, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m URLHistoryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m URLHistoryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *URLHistoryMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *URLHistoryMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().URLHistory.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTunnelID sets the "tunnel_id" field.
+func (m *URLHistoryMutation) SetTunnelID(s string) {
+	m.tunnel_id = &s
+}
+
+// TunnelID returns the value of the "tunnel_id" field in the mutation.
+func (m *URLHistoryMutation) TunnelID() (r string, exists bool) {
+	v := m.tunnel_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTunnelID returns the old "tunnel_id" field's value of the URLHistory entity.
+// If the URLHistory object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TunnelMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *URLHistoryMutation) OldTunnelID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldTunnelID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldTunnelID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldTunnelID: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.TunnelID, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *TunnelMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetTunnelID resets all changes to the "tunnel_id" field.
+func (m *URLHistoryMutation) ResetTunnelID() {
+	m.tunnel_id = nil
 }
 
-// SetNgrokAuthtoken sets the "ngrok_authtoken" field.
-func (m *TunnelMutation) SetNgrokAuthtoken(s string) {
-	m.ngrok_authtoken = &s
+// SetURL sets the "url" field.
+func (m *URLHistoryMutation) SetURL(s string) {
+	m.url = &s
 }
 
-// NgrokAuthtoken returns the value of the "ngrok_authtoken" field in the mutation.
-func (m *TunnelMutation) NgrokAuthtoken() (r string, exists bool) {
-	v := m.ngrok_authtoken
+// URL returns the value of the "url" field in the mutation.
+func (m *URLHistoryMutation) URL() (r string, exists bool) {
+	v := m.url
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNgrokAuthtoken returns the old "ngrok_authtoken" field's value of the Tunnel entity.
-// If the Tunnel object wasn't provided to the builder, the object is fetched from the database.
+// OldURL returns the old "url" field's value of the URLHistory entity.
+// If the URLHistory object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TunnelMutation) OldNgrokAuthtoken(ctx context.Context) (v *string, err error) {
+func (m *URLHistoryMutation) OldURL(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNgrokAuthtoken is only allowed on UpdateOne operations")
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNgrokAuthtoken requires an ID field in the mutation")
+		return v, errors.New("OldURL requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNgrokAuthtoken: %w", err)
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
 	}
-	return oldValue.NgrokAuthtoken, nil
-}
-
-// ClearNgrokAuthtoken clears the value of the "ngrok_authtoken" field.
-func (m *TunnelMutation) ClearNgrokAuthtoken() {
-	m.ngrok_authtoken = nil
-	m.clearedFields[tunnel.FieldNgrokAuthtoken] = struct{}{}
-}
-
-// NgrokAuthtokenCleared returns if the "ngrok_authtoken" field was cleared in this mutation.
-func (m *TunnelMutation) NgrokAuthtokenCleared() bool {
-	_, ok := m.clearedFields[tunnel.FieldNgrokAuthtoken]
-	return ok
+	return oldValue.URL, nil
 }
 
-// ResetNgrokAuthtoken resets all changes to the "ngrok_authtoken" field.
-func (m *TunnelMutation) ResetNgrokAuthtoken() {
-	m.ngrok_authtoken = nil
-	delete(m.clearedFields, tunnel.FieldNgrokAuthtoken)
+// ResetURL resets all changes to the "url" field.
+func (m *URLHistoryMutation) ResetURL() {
+	m.url = nil
 }
 
-// SetNgrokDomain sets the "ngrok_domain" field.
-func (m *TunnelMutation) SetNgrokDomain(s string) {
-	m.ngrok_domain = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *URLHistoryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// NgrokDomain returns the value of the "ngrok_domain" field in the mutation.
-func (m *TunnelMutation) NgrokDomain() (r string, exists bool) {
-	v := m.ngrok_domain
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *URLHistoryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNgrokDomain returns the old "ngrok_domain" field's value of the Tunnel entity.
-// If the Tunnel object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the URLHistory entity.
+// If the URLHistory object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TunnelMutation) OldNgrokDomain(ctx context.Context) (v *string, err error) {
+func (m *URLHistoryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNgrokDomain is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNgrokDomain requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNgrokDomain: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.NgrokDomain, nil
-}
-
-// ClearNgrokDomain clears the value of the "ngrok_domain" field.
-func (m *TunnelMutation) ClearNgrokDomain() {
-	m.ngrok_domain = nil
-	m.clearedFields[tunnel.FieldNgrokDomain] = struct{}{}
-}
-
-// NgrokDomainCleared returns if the "ngrok_domain" field was cleared in this mutation.
-func (m *TunnelMutation) NgrokDomainCleared() bool {
-	_, ok := m.clearedFields[tunnel.FieldNgrokDomain]
-	return ok
+	return oldValue.CreatedAt, nil
 }
 
-// ResetNgrokDomain resets all changes to the "ngrok_domain" field.
-func (m *TunnelMutation) ResetNgrokDomain() {
-	m.ngrok_domain = nil
-	delete(m.clearedFields, tunnel.FieldNgrokDomain)
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *URLHistoryMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// Where appends a list predicates to the TunnelMutation builder.
-func (m *TunnelMutation) Where(ps ...predicate.Tunnel) {
+// Where appends a list predicates to the URLHistoryMutation builder.
+func (m *URLHistoryMutation) Where(ps ...predicate.URLHistory) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TunnelMutation builder. Using this method,
+// WhereP appends storage-level predicates to the URLHistoryMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TunnelMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Tunnel, len(ps))
+func (m *URLHistoryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.URLHistory, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -901,51 +4184,33 @@ func (m *TunnelMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TunnelMutation) Op() Op {
+func (m *URLHistoryMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TunnelMutation) SetOp(op Op) {
+func (m *URLHistoryMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Tunnel).
-func (m *TunnelMutation) Type() string {
+// Type returns the node type of this mutation (URLHistory).
+func (m *URLHistoryMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TunnelMutation) Fields() []string {
-	fields := make([]string, 0, 9)
-	if m.name != nil {
-		fields = append(fields, tunnel.FieldName)
-	}
-	if m._type != nil {
-		fields = append(fields, tunnel.FieldType)
-	}
-	if m.target != nil {
-		fields = append(fields, tunnel.FieldTarget)
-	}
-	if m.enabled != nil {
-		fields = append(fields, tunnel.FieldEnabled)
+func (m *URLHistoryMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.tunnel_id != nil {
+		fields = append(fields, urlhistory.FieldTunnelID)
 	}
-	if m.mcp_enabled != nil {
-		fields = append(fields, tunnel.FieldMcpEnabled)
+	if m.url != nil {
+		fields = append(fields, urlhistory.FieldURL)
 	}
 	if m.created_at != nil {
-		fields = append(fields, tunnel.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, tunnel.FieldUpdatedAt)
-	}
-	if m.ngrok_authtoken != nil {
-		fields = append(fields, tunnel.FieldNgrokAuthtoken)
-	}
-	if m.ngrok_domain != nil {
-		fields = append(fields, tunnel.FieldNgrokDomain)
+		fields = append(fields, urlhistory.FieldCreatedAt)
 	}
 	return fields
 }
@@ -953,26 +4218,14 @@ func (m *TunnelMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TunnelMutation) Field(name string) (ent.Value, bool) {
+func (m *URLHistoryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case tunnel.FieldName:
-		return m.Name()
-	case tunnel.FieldType:
-		return m.GetType()
-	case tunnel.FieldTarget:
-		return m.Target()
-	case tunnel.FieldEnabled:
-		return m.Enabled()
-	case tunnel.FieldMcpEnabled:
-		return m.McpEnabled()
-	case tunnel.FieldCreatedAt:
+	case urlhistory.FieldTunnelID:
+		return m.TunnelID()
+	case urlhistory.FieldURL:
+		return m.URL()
+	case urlhistory.FieldCreatedAt:
 		return m.CreatedAt()
-	case tunnel.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case tunnel.FieldNgrokAuthtoken:
-		return m.NgrokAuthtoken()
-	case tunnel.FieldNgrokDomain:
-		return m.NgrokDomain()
 	}
 	return nil, false
 }
@@ -980,237 +4233,150 @@ func (m *TunnelMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TunnelMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *URLHistoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case tunnel.FieldName:
-		return m.OldName(ctx)
-	case tunnel.FieldType:
-		return m.OldType(ctx)
-	case tunnel.FieldTarget:
-		return m.OldTarget(ctx)
-	case tunnel.FieldEnabled:
-		return m.OldEnabled(ctx)
-	case tunnel.FieldMcpEnabled:
-		return m.OldMcpEnabled(ctx)
-	case tunnel.FieldCreatedAt:
+	case urlhistory.FieldTunnelID:
+		return m.OldTunnelID(ctx)
+	case urlhistory.FieldURL:
+		return m.OldURL(ctx)
+	case urlhistory.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case tunnel.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case tunnel.FieldNgrokAuthtoken:
-		return m.OldNgrokAuthtoken(ctx)
-	case tunnel.FieldNgrokDomain:
-		return m.OldNgrokDomain(ctx)
 	}
-	return nil, fmt.Errorf("unknown Tunnel field %s", name)
+	return nil, fmt.Errorf("unknown URLHistory field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TunnelMutation) SetField(name string, value ent.Value) error {
+func (m *URLHistoryMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case tunnel.FieldName:
+	case urlhistory.FieldTunnelID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
-		return nil
-	case tunnel.FieldType:
-		v, ok := value.(tunnel.Type)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetType(v)
+		m.SetTunnelID(v)
 		return nil
-	case tunnel.FieldTarget:
+	case urlhistory.FieldURL:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTarget(v)
-		return nil
-	case tunnel.FieldEnabled:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEnabled(v)
-		return nil
-	case tunnel.FieldMcpEnabled:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMcpEnabled(v)
+		m.SetURL(v)
 		return nil
-	case tunnel.FieldCreatedAt:
+	case urlhistory.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case tunnel.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case tunnel.FieldNgrokAuthtoken:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetNgrokAuthtoken(v)
-		return nil
-	case tunnel.FieldNgrokDomain:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetNgrokDomain(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Tunnel field %s", name)
+	return fmt.Errorf("unknown URLHistory field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TunnelMutation) AddedFields() []string {
+func (m *URLHistoryMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TunnelMutation) AddedField(name string) (ent.Value, bool) {
+func (m *URLHistoryMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TunnelMutation) AddField(name string, value ent.Value) error {
+func (m *URLHistoryMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Tunnel numeric field %s", name)
+	return fmt.Errorf("unknown URLHistory numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TunnelMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(tunnel.FieldNgrokAuthtoken) {
-		fields = append(fields, tunnel.FieldNgrokAuthtoken)
-	}
-	if m.FieldCleared(tunnel.FieldNgrokDomain) {
-		fields = append(fields, tunnel.FieldNgrokDomain)
-	}
-	return fields
+func (m *URLHistoryMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TunnelMutation) FieldCleared(name string) bool {
+func (m *URLHistoryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TunnelMutation) ClearField(name string) error {
-	switch name {
-	case tunnel.FieldNgrokAuthtoken:
-		m.ClearNgrokAuthtoken()
-		return nil
-	case tunnel.FieldNgrokDomain:
-		m.ClearNgrokDomain()
-		return nil
-	}
-	return fmt.Errorf("unknown Tunnel nullable field %s", name)
+func (m *URLHistoryMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown URLHistory nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TunnelMutation) ResetField(name string) error {
+func (m *URLHistoryMutation) ResetField(name string) error {
 	switch name {
-	case tunnel.FieldName:
-		m.ResetName()
+	case urlhistory.FieldTunnelID:
+		m.ResetTunnelID()
 		return nil
-	case tunnel.FieldType:
-		m.ResetType()
-		return nil
-	case tunnel.FieldTarget:
-		m.ResetTarget()
-		return nil
-	case tunnel.FieldEnabled:
-		m.ResetEnabled()
+	case urlhistory.FieldURL:
+		m.ResetURL()
 		return nil
-	case tunnel.FieldMcpEnabled:
-		m.ResetMcpEnabled()
-		return nil
-	case tunnel.FieldCreatedAt:
+	case urlhistory.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case tunnel.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case tunnel.FieldNgrokAuthtoken:
-		m.ResetNgrokAuthtoken()
-		return nil
-	case tunnel.FieldNgrokDomain:
-		m.ResetNgrokDomain()
-		return nil
 	}
-	return fmt.Errorf("unknown Tunnel field %s", name)
+	return fmt.Errorf("unknown URLHistory field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TunnelMutation) AddedEdges() []string {
+func (m *URLHistoryMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TunnelMutation) AddedIDs(name string) []ent.Value {
+func (m *URLHistoryMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TunnelMutation) RemovedEdges() []string {
+func (m *URLHistoryMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TunnelMutation) RemovedIDs(name string) []ent.Value {
+func (m *URLHistoryMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TunnelMutation) ClearedEdges() []string {
+func (m *URLHistoryMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TunnelMutation) EdgeCleared(name string) bool {
+func (m *URLHistoryMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TunnelMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Tunnel unique edge %s", name)
+func (m *URLHistoryMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown URLHistory unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TunnelMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Tunnel edge %s", name)
+func (m *URLHistoryMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown URLHistory edge %s", name)
 }
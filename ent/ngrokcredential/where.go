@@ -0,0 +1,300 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ngrokcredential
+
+import (
+	"pont/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.FieldEQ(FieldName, v))
+}
+
+// Authtoken applies equality check predicate on the "authtoken" field. It's identical to AuthtokenEQ.
+func Authtoken(v string) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.FieldEQ(FieldAuthtoken, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.FieldContainsFold(FieldName, v))
+}
+
+// AuthtokenEQ applies the EQ predicate on the "authtoken" field.
+func AuthtokenEQ(v string) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.FieldEQ(FieldAuthtoken, v))
+}
+
+// AuthtokenNEQ applies the NEQ predicate on the "authtoken" field.
+func AuthtokenNEQ(v string) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.FieldNEQ(FieldAuthtoken, v))
+}
+
+// AuthtokenIn applies the In predicate on the "authtoken" field.
+func AuthtokenIn(vs ...string) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.FieldIn(FieldAuthtoken, vs...))
+}
+
+// AuthtokenNotIn applies the NotIn predicate on the "authtoken" field.
+func AuthtokenNotIn(vs ...string) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.FieldNotIn(FieldAuthtoken, vs...))
+}
+
+// AuthtokenGT applies the GT predicate on the "authtoken" field.
+func AuthtokenGT(v string) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.FieldGT(FieldAuthtoken, v))
+}
+
+// AuthtokenGTE applies the GTE predicate on the "authtoken" field.
+func AuthtokenGTE(v string) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.FieldGTE(FieldAuthtoken, v))
+}
+
+// AuthtokenLT applies the LT predicate on the "authtoken" field.
+func AuthtokenLT(v string) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.FieldLT(FieldAuthtoken, v))
+}
+
+// AuthtokenLTE applies the LTE predicate on the "authtoken" field.
+func AuthtokenLTE(v string) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.FieldLTE(FieldAuthtoken, v))
+}
+
+// AuthtokenContains applies the Contains predicate on the "authtoken" field.
+func AuthtokenContains(v string) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.FieldContains(FieldAuthtoken, v))
+}
+
+// AuthtokenHasPrefix applies the HasPrefix predicate on the "authtoken" field.
+func AuthtokenHasPrefix(v string) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.FieldHasPrefix(FieldAuthtoken, v))
+}
+
+// AuthtokenHasSuffix applies the HasSuffix predicate on the "authtoken" field.
+func AuthtokenHasSuffix(v string) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.FieldHasSuffix(FieldAuthtoken, v))
+}
+
+// AuthtokenEqualFold applies the EqualFold predicate on the "authtoken" field.
+func AuthtokenEqualFold(v string) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.FieldEqualFold(FieldAuthtoken, v))
+}
+
+// AuthtokenContainsFold applies the ContainsFold predicate on the "authtoken" field.
+func AuthtokenContainsFold(v string) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.FieldContainsFold(FieldAuthtoken, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.NgrokCredential) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.NgrokCredential) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.NgrokCredential) predicate.NgrokCredential {
+	return predicate.NgrokCredential(sql.NotPredicates(p))
+}
@@ -0,0 +1,626 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"pont/ent/ngrokcredential"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// NgrokCredentialCreate is the builder for creating a NgrokCredential entity.
+type NgrokCredentialCreate struct {
+	config
+	mutation *NgrokCredentialMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetName sets the "name" field.
+func (_c *NgrokCredentialCreate) SetName(v string) *NgrokCredentialCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetAuthtoken sets the "authtoken" field.
+func (_c *NgrokCredentialCreate) SetAuthtoken(v string) *NgrokCredentialCreate {
+	_c.mutation.SetAuthtoken(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *NgrokCredentialCreate) SetCreatedAt(v time.Time) *NgrokCredentialCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *NgrokCredentialCreate) SetNillableCreatedAt(v *time.Time) *NgrokCredentialCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *NgrokCredentialCreate) SetUpdatedAt(v time.Time) *NgrokCredentialCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *NgrokCredentialCreate) SetNillableUpdatedAt(v *time.Time) *NgrokCredentialCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the NgrokCredentialMutation object of the builder.
+func (_c *NgrokCredentialCreate) Mutation() *NgrokCredentialMutation {
+	return _c.mutation
+}
+
+// Save creates the NgrokCredential in the database.
+func (_c *NgrokCredentialCreate) Save(ctx context.Context) (*NgrokCredential, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *NgrokCredentialCreate) SaveX(ctx context.Context) *NgrokCredential {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *NgrokCredentialCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *NgrokCredentialCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *NgrokCredentialCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := ngrokcredential.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := ngrokcredential.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *NgrokCredentialCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "NgrokCredential.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := ngrokcredential.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "NgrokCredential.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Authtoken(); !ok {
+		return &ValidationError{Name: "authtoken", err: errors.New(`ent: missing required field "NgrokCredential.authtoken"`)}
+	}
+	if v, ok := _c.mutation.Authtoken(); ok {
+		if err := ngrokcredential.AuthtokenValidator(v); err != nil {
+			return &ValidationError{Name: "authtoken", err: fmt.Errorf(`ent: validator failed for field "NgrokCredential.authtoken": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "NgrokCredential.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "NgrokCredential.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *NgrokCredentialCreate) sqlSave(ctx context.Context) (*NgrokCredential, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *NgrokCredentialCreate) createSpec() (*NgrokCredential, *sqlgraph.CreateSpec) {
+	var (
+		_node = &NgrokCredential{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(ngrokcredential.Table, sqlgraph.NewFieldSpec(ngrokcredential.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(ngrokcredential.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Authtoken(); ok {
+		_spec.SetField(ngrokcredential.FieldAuthtoken, field.TypeString, value)
+		_node.Authtoken = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(ngrokcredential.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(ngrokcredential.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.NgrokCredential.Create().
+//		SetName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NgrokCredentialUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *NgrokCredentialCreate) OnConflict(opts ...sql.ConflictOption) *NgrokCredentialUpsertOne {
+	_c.conflict = opts
+	return &NgrokCredentialUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.NgrokCredential.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *NgrokCredentialCreate) OnConflictColumns(columns ...string) *NgrokCredentialUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &NgrokCredentialUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// NgrokCredentialUpsertOne is the builder for "upsert"-ing
+	//  one NgrokCredential node.
+	NgrokCredentialUpsertOne struct {
+		create *NgrokCredentialCreate
+	}
+
+	// NgrokCredentialUpsert is the "OnConflict" setter.
+	NgrokCredentialUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetName sets the "name" field.
+func (u *NgrokCredentialUpsert) SetName(v string) *NgrokCredentialUpsert {
+	u.Set(ngrokcredential.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *NgrokCredentialUpsert) UpdateName() *NgrokCredentialUpsert {
+	u.SetExcluded(ngrokcredential.FieldName)
+	return u
+}
+
+// SetAuthtoken sets the "authtoken" field.
+func (u *NgrokCredentialUpsert) SetAuthtoken(v string) *NgrokCredentialUpsert {
+	u.Set(ngrokcredential.FieldAuthtoken, v)
+	return u
+}
+
+// UpdateAuthtoken sets the "authtoken" field to the value that was provided on create.
+func (u *NgrokCredentialUpsert) UpdateAuthtoken() *NgrokCredentialUpsert {
+	u.SetExcluded(ngrokcredential.FieldAuthtoken)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *NgrokCredentialUpsert) SetUpdatedAt(v time.Time) *NgrokCredentialUpsert {
+	u.Set(ngrokcredential.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *NgrokCredentialUpsert) UpdateUpdatedAt() *NgrokCredentialUpsert {
+	u.SetExcluded(ngrokcredential.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.NgrokCredential.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *NgrokCredentialUpsertOne) UpdateNewValues() *NgrokCredentialUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(ngrokcredential.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.NgrokCredential.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *NgrokCredentialUpsertOne) Ignore() *NgrokCredentialUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NgrokCredentialUpsertOne) DoNothing() *NgrokCredentialUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NgrokCredentialCreate.OnConflict
+// documentation for more info.
+func (u *NgrokCredentialUpsertOne) Update(set func(*NgrokCredentialUpsert)) *NgrokCredentialUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NgrokCredentialUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *NgrokCredentialUpsertOne) SetName(v string) *NgrokCredentialUpsertOne {
+	return u.Update(func(s *NgrokCredentialUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *NgrokCredentialUpsertOne) UpdateName() *NgrokCredentialUpsertOne {
+	return u.Update(func(s *NgrokCredentialUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetAuthtoken sets the "authtoken" field.
+func (u *NgrokCredentialUpsertOne) SetAuthtoken(v string) *NgrokCredentialUpsertOne {
+	return u.Update(func(s *NgrokCredentialUpsert) {
+		s.SetAuthtoken(v)
+	})
+}
+
+// UpdateAuthtoken sets the "authtoken" field to the value that was provided on create.
+func (u *NgrokCredentialUpsertOne) UpdateAuthtoken() *NgrokCredentialUpsertOne {
+	return u.Update(func(s *NgrokCredentialUpsert) {
+		s.UpdateAuthtoken()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *NgrokCredentialUpsertOne) SetUpdatedAt(v time.Time) *NgrokCredentialUpsertOne {
+	return u.Update(func(s *NgrokCredentialUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *NgrokCredentialUpsertOne) UpdateUpdatedAt() *NgrokCredentialUpsertOne {
+	return u.Update(func(s *NgrokCredentialUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *NgrokCredentialUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NgrokCredentialCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NgrokCredentialUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *NgrokCredentialUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *NgrokCredentialUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// NgrokCredentialCreateBulk is the builder for creating many NgrokCredential entities in bulk.
+type NgrokCredentialCreateBulk struct {
+	config
+	err      error
+	builders []*NgrokCredentialCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the NgrokCredential entities in the database.
+func (_c *NgrokCredentialCreateBulk) Save(ctx context.Context) ([]*NgrokCredential, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*NgrokCredential, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NgrokCredentialMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *NgrokCredentialCreateBulk) SaveX(ctx context.Context) []*NgrokCredential {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *NgrokCredentialCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *NgrokCredentialCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.NgrokCredential.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NgrokCredentialUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *NgrokCredentialCreateBulk) OnConflict(opts ...sql.ConflictOption) *NgrokCredentialUpsertBulk {
+	_c.conflict = opts
+	return &NgrokCredentialUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.NgrokCredential.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *NgrokCredentialCreateBulk) OnConflictColumns(columns ...string) *NgrokCredentialUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &NgrokCredentialUpsertBulk{
+		create: _c,
+	}
+}
+
+// NgrokCredentialUpsertBulk is the builder for "upsert"-ing
+// a bulk of NgrokCredential nodes.
+type NgrokCredentialUpsertBulk struct {
+	create *NgrokCredentialCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.NgrokCredential.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *NgrokCredentialUpsertBulk) UpdateNewValues() *NgrokCredentialUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(ngrokcredential.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.NgrokCredential.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *NgrokCredentialUpsertBulk) Ignore() *NgrokCredentialUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NgrokCredentialUpsertBulk) DoNothing() *NgrokCredentialUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NgrokCredentialCreateBulk.OnConflict
+// documentation for more info.
+func (u *NgrokCredentialUpsertBulk) Update(set func(*NgrokCredentialUpsert)) *NgrokCredentialUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NgrokCredentialUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *NgrokCredentialUpsertBulk) SetName(v string) *NgrokCredentialUpsertBulk {
+	return u.Update(func(s *NgrokCredentialUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *NgrokCredentialUpsertBulk) UpdateName() *NgrokCredentialUpsertBulk {
+	return u.Update(func(s *NgrokCredentialUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetAuthtoken sets the "authtoken" field.
+func (u *NgrokCredentialUpsertBulk) SetAuthtoken(v string) *NgrokCredentialUpsertBulk {
+	return u.Update(func(s *NgrokCredentialUpsert) {
+		s.SetAuthtoken(v)
+	})
+}
+
+// UpdateAuthtoken sets the "authtoken" field to the value that was provided on create.
+func (u *NgrokCredentialUpsertBulk) UpdateAuthtoken() *NgrokCredentialUpsertBulk {
+	return u.Update(func(s *NgrokCredentialUpsert) {
+		s.UpdateAuthtoken()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *NgrokCredentialUpsertBulk) SetUpdatedAt(v time.Time) *NgrokCredentialUpsertBulk {
+	return u.Update(func(s *NgrokCredentialUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *NgrokCredentialUpsertBulk) UpdateUpdatedAt() *NgrokCredentialUpsertBulk {
+	return u.Update(func(s *NgrokCredentialUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *NgrokCredentialUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the NgrokCredentialCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NgrokCredentialCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NgrokCredentialUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
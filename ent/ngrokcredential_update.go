@@ -0,0 +1,316 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"pont/ent/ngrokcredential"
+	"pont/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// NgrokCredentialUpdate is the builder for updating NgrokCredential entities.
+type NgrokCredentialUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NgrokCredentialMutation
+}
+
+// Where appends a list predicates to the NgrokCredentialUpdate builder.
+func (_u *NgrokCredentialUpdate) Where(ps ...predicate.NgrokCredential) *NgrokCredentialUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *NgrokCredentialUpdate) SetName(v string) *NgrokCredentialUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *NgrokCredentialUpdate) SetNillableName(v *string) *NgrokCredentialUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetAuthtoken sets the "authtoken" field.
+func (_u *NgrokCredentialUpdate) SetAuthtoken(v string) *NgrokCredentialUpdate {
+	_u.mutation.SetAuthtoken(v)
+	return _u
+}
+
+// SetNillableAuthtoken sets the "authtoken" field if the given value is not nil.
+func (_u *NgrokCredentialUpdate) SetNillableAuthtoken(v *string) *NgrokCredentialUpdate {
+	if v != nil {
+		_u.SetAuthtoken(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *NgrokCredentialUpdate) SetUpdatedAt(v time.Time) *NgrokCredentialUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the NgrokCredentialMutation object of the builder.
+func (_u *NgrokCredentialUpdate) Mutation() *NgrokCredentialMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *NgrokCredentialUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *NgrokCredentialUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *NgrokCredentialUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *NgrokCredentialUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *NgrokCredentialUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := ngrokcredential.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *NgrokCredentialUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := ngrokcredential.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "NgrokCredential.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Authtoken(); ok {
+		if err := ngrokcredential.AuthtokenValidator(v); err != nil {
+			return &ValidationError{Name: "authtoken", err: fmt.Errorf(`ent: validator failed for field "NgrokCredential.authtoken": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *NgrokCredentialUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ngrokcredential.Table, ngrokcredential.Columns, sqlgraph.NewFieldSpec(ngrokcredential.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(ngrokcredential.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Authtoken(); ok {
+		_spec.SetField(ngrokcredential.FieldAuthtoken, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(ngrokcredential.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ngrokcredential.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// NgrokCredentialUpdateOne is the builder for updating a single NgrokCredential entity.
+type NgrokCredentialUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NgrokCredentialMutation
+}
+
+// SetName sets the "name" field.
+func (_u *NgrokCredentialUpdateOne) SetName(v string) *NgrokCredentialUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *NgrokCredentialUpdateOne) SetNillableName(v *string) *NgrokCredentialUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetAuthtoken sets the "authtoken" field.
+func (_u *NgrokCredentialUpdateOne) SetAuthtoken(v string) *NgrokCredentialUpdateOne {
+	_u.mutation.SetAuthtoken(v)
+	return _u
+}
+
+// SetNillableAuthtoken sets the "authtoken" field if the given value is not nil.
+func (_u *NgrokCredentialUpdateOne) SetNillableAuthtoken(v *string) *NgrokCredentialUpdateOne {
+	if v != nil {
+		_u.SetAuthtoken(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *NgrokCredentialUpdateOne) SetUpdatedAt(v time.Time) *NgrokCredentialUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the NgrokCredentialMutation object of the builder.
+func (_u *NgrokCredentialUpdateOne) Mutation() *NgrokCredentialMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the NgrokCredentialUpdate builder.
+func (_u *NgrokCredentialUpdateOne) Where(ps ...predicate.NgrokCredential) *NgrokCredentialUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *NgrokCredentialUpdateOne) Select(field string, fields ...string) *NgrokCredentialUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated NgrokCredential entity.
+func (_u *NgrokCredentialUpdateOne) Save(ctx context.Context) (*NgrokCredential, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *NgrokCredentialUpdateOne) SaveX(ctx context.Context) *NgrokCredential {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *NgrokCredentialUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *NgrokCredentialUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *NgrokCredentialUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := ngrokcredential.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *NgrokCredentialUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := ngrokcredential.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "NgrokCredential.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Authtoken(); ok {
+		if err := ngrokcredential.AuthtokenValidator(v); err != nil {
+			return &ValidationError{Name: "authtoken", err: fmt.Errorf(`ent: validator failed for field "NgrokCredential.authtoken": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *NgrokCredentialUpdateOne) sqlSave(ctx context.Context) (_node *NgrokCredential, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ngrokcredential.Table, ngrokcredential.Columns, sqlgraph.NewFieldSpec(ngrokcredential.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "NgrokCredential.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ngrokcredential.FieldID)
+		for _, f := range fields {
+			if !ngrokcredential.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != ngrokcredential.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(ngrokcredential.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Authtoken(); ok {
+		_spec.SetField(ngrokcredential.FieldAuthtoken, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(ngrokcredential.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &NgrokCredential{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ngrokcredential.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -6,8 +6,17 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// AuditLog is the predicate function for auditlog builders.
+type AuditLog func(*sql.Selector)
+
+// NgrokCredential is the predicate function for ngrokcredential builders.
+type NgrokCredential func(*sql.Selector)
+
 // Setting is the predicate function for setting builders.
 type Setting func(*sql.Selector)
 
 // Tunnel is the predicate function for tunnel builders.
 type Tunnel func(*sql.Selector)
+
+// URLHistory is the predicate function for urlhistory builders.
+type URLHistory func(*sql.Selector)
@@ -3,8 +3,11 @@
 package ent
 
 import (
+	"pont/ent/auditlog"
+	"pont/ent/ngrokcredential"
 	"pont/ent/schema"
 	"pont/ent/tunnel"
+	"pont/ent/urlhistory"
 	"time"
 
 	"github.com/google/uuid"
@@ -14,28 +17,96 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	auditlogFields := schema.AuditLog{}.Fields()
+	_ = auditlogFields
+	// auditlogDescCreatedAt is the schema descriptor for created_at field.
+	auditlogDescCreatedAt := auditlogFields[0].Descriptor()
+	// auditlog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditlog.DefaultCreatedAt = auditlogDescCreatedAt.Default.(func() time.Time)
+	ngrokcredentialFields := schema.NgrokCredential{}.Fields()
+	_ = ngrokcredentialFields
+	// ngrokcredentialDescName is the schema descriptor for name field.
+	ngrokcredentialDescName := ngrokcredentialFields[0].Descriptor()
+	// ngrokcredential.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	ngrokcredential.NameValidator = ngrokcredentialDescName.Validators[0].(func(string) error)
+	// ngrokcredentialDescAuthtoken is the schema descriptor for authtoken field.
+	ngrokcredentialDescAuthtoken := ngrokcredentialFields[1].Descriptor()
+	// ngrokcredential.AuthtokenValidator is a validator for the "authtoken" field. It is called by the builders before save.
+	ngrokcredential.AuthtokenValidator = ngrokcredentialDescAuthtoken.Validators[0].(func(string) error)
+	// ngrokcredentialDescCreatedAt is the schema descriptor for created_at field.
+	ngrokcredentialDescCreatedAt := ngrokcredentialFields[2].Descriptor()
+	// ngrokcredential.DefaultCreatedAt holds the default value on creation for the created_at field.
+	ngrokcredential.DefaultCreatedAt = ngrokcredentialDescCreatedAt.Default.(func() time.Time)
+	// ngrokcredentialDescUpdatedAt is the schema descriptor for updated_at field.
+	ngrokcredentialDescUpdatedAt := ngrokcredentialFields[3].Descriptor()
+	// ngrokcredential.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	ngrokcredential.DefaultUpdatedAt = ngrokcredentialDescUpdatedAt.Default.(func() time.Time)
+	// ngrokcredential.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	ngrokcredential.UpdateDefaultUpdatedAt = ngrokcredentialDescUpdatedAt.UpdateDefault.(func() time.Time)
 	tunnelFields := schema.Tunnel{}.Fields()
 	_ = tunnelFields
+	// tunnelDescName is the schema descriptor for name field.
+	tunnelDescName := tunnelFields[1].Descriptor()
+	// tunnel.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	tunnel.NameValidator = tunnelDescName.Validators[0].(func(string) error)
+	// tunnelDescTarget is the schema descriptor for target field.
+	tunnelDescTarget := tunnelFields[3].Descriptor()
+	// tunnel.TargetValidator is a validator for the "target" field. It is called by the builders before save.
+	tunnel.TargetValidator = tunnelDescTarget.Validators[0].(func(string) error)
 	// tunnelDescEnabled is the schema descriptor for enabled field.
-	tunnelDescEnabled := tunnelFields[4].Descriptor()
+	tunnelDescEnabled := tunnelFields[5].Descriptor()
 	// tunnel.DefaultEnabled holds the default value on creation for the enabled field.
 	tunnel.DefaultEnabled = tunnelDescEnabled.Default.(bool)
 	// tunnelDescMcpEnabled is the schema descriptor for mcp_enabled field.
-	tunnelDescMcpEnabled := tunnelFields[5].Descriptor()
+	tunnelDescMcpEnabled := tunnelFields[6].Descriptor()
 	// tunnel.DefaultMcpEnabled holds the default value on creation for the mcp_enabled field.
 	tunnel.DefaultMcpEnabled = tunnelDescMcpEnabled.Default.(bool)
+	// tunnelDescFavorite is the schema descriptor for favorite field.
+	tunnelDescFavorite := tunnelFields[7].Descriptor()
+	// tunnel.DefaultFavorite holds the default value on creation for the favorite field.
+	tunnel.DefaultFavorite = tunnelDescFavorite.Default.(bool)
 	// tunnelDescCreatedAt is the schema descriptor for created_at field.
-	tunnelDescCreatedAt := tunnelFields[6].Descriptor()
+	tunnelDescCreatedAt := tunnelFields[8].Descriptor()
 	// tunnel.DefaultCreatedAt holds the default value on creation for the created_at field.
 	tunnel.DefaultCreatedAt = tunnelDescCreatedAt.Default.(func() time.Time)
 	// tunnelDescUpdatedAt is the schema descriptor for updated_at field.
-	tunnelDescUpdatedAt := tunnelFields[7].Descriptor()
+	tunnelDescUpdatedAt := tunnelFields[9].Descriptor()
 	// tunnel.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	tunnel.DefaultUpdatedAt = tunnelDescUpdatedAt.Default.(func() time.Time)
 	// tunnel.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	tunnel.UpdateDefaultUpdatedAt = tunnelDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// tunnelDescNgrokInternal is the schema descriptor for ngrok_internal field.
+	tunnelDescNgrokInternal := tunnelFields[15].Descriptor()
+	// tunnel.DefaultNgrokInternal holds the default value on creation for the ngrok_internal field.
+	tunnel.DefaultNgrokInternal = tunnelDescNgrokInternal.Default.(bool)
+	// tunnelDescCloudflareNoTLSVerify is the schema descriptor for cloudflare_no_tls_verify field.
+	tunnelDescCloudflareNoTLSVerify := tunnelFields[18].Descriptor()
+	// tunnel.DefaultCloudflareNoTLSVerify holds the default value on creation for the cloudflare_no_tls_verify field.
+	tunnel.DefaultCloudflareNoTLSVerify = tunnelDescCloudflareNoTLSVerify.Default.(bool)
+	// tunnelDescArchived is the schema descriptor for archived field.
+	tunnelDescArchived := tunnelFields[24].Descriptor()
+	// tunnel.DefaultArchived holds the default value on creation for the archived field.
+	tunnel.DefaultArchived = tunnelDescArchived.Default.(bool)
+	// tunnelDescPaused is the schema descriptor for paused field.
+	tunnelDescPaused := tunnelFields[25].Descriptor()
+	// tunnel.DefaultPaused holds the default value on creation for the paused field.
+	tunnel.DefaultPaused = tunnelDescPaused.Default.(bool)
+	// tunnelDescProbeEnabled is the schema descriptor for probe_enabled field.
+	tunnelDescProbeEnabled := tunnelFields[26].Descriptor()
+	// tunnel.DefaultProbeEnabled holds the default value on creation for the probe_enabled field.
+	tunnel.DefaultProbeEnabled = tunnelDescProbeEnabled.Default.(bool)
+	// tunnelDescProbeRetries is the schema descriptor for probe_retries field.
+	tunnelDescProbeRetries := tunnelFields[29].Descriptor()
+	// tunnel.DefaultProbeRetries holds the default value on creation for the probe_retries field.
+	tunnel.DefaultProbeRetries = tunnelDescProbeRetries.Default.(int)
 	// tunnelDescID is the schema descriptor for id field.
 	tunnelDescID := tunnelFields[0].Descriptor()
 	// tunnel.DefaultID holds the default value on creation for the id field.
 	tunnel.DefaultID = tunnelDescID.Default.(func() uuid.UUID)
+	urlhistoryFields := schema.URLHistory{}.Fields()
+	_ = urlhistoryFields
+	// urlhistoryDescCreatedAt is the schema descriptor for created_at field.
+	urlhistoryDescCreatedAt := urlhistoryFields[2].Descriptor()
+	// urlhistory.DefaultCreatedAt holds the default value on creation for the created_at field.
+	urlhistory.DefaultCreatedAt = urlhistoryDescCreatedAt.Default.(func() time.Time)
 }
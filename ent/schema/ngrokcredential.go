@@ -0,0 +1,28 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// NgrokCredential holds the schema definition for the NgrokCredential entity.
+type NgrokCredential struct {
+	ent.Schema
+}
+
+// Fields of the NgrokCredential.
+func (NgrokCredential) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").Unique().NotEmpty().Comment("Referenced by tunnels through ngrok_credential"),
+		field.String("authtoken").NotEmpty().Sensitive(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the NgrokCredential.
+func (NgrokCredential) Edges() []ent.Edge {
+	return nil
+}
@@ -17,15 +17,39 @@ type Tunnel struct {
 func (Tunnel) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.UUID{}).Default(uuid.New).StorageKey("id"),
-		field.String("name"),
+		field.String("name").MaxLen(255),
 		field.Enum("type").Values("cloudflare", "ngrok"),
-		field.String("target"),
+		field.String("target").MaxLen(2048),
+		field.String("group").Optional().Nillable().Comment("Tunnels in the same group can be started and stopped together"),
 		field.Bool("enabled").Default(true),
 		field.Bool("mcp_enabled").Default(false).Comment("Allow this tunnel to be managed via MCP"),
+		field.Bool("favorite").Default(false).Comment("Favorites are listed first to MCP clients"),
 		field.Time("created_at").Default(time.Now).Immutable(),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 		field.String("ngrok_authtoken").Optional().Nillable(),
+		field.String("ngrok_credential").Optional().Nillable().Comment("Name of the NgrokCredential whose authtoken the tunnel uses"),
 		field.String("ngrok_domain").Optional().Nillable(),
+		field.String("ngrok_webhook_provider").Optional().Nillable(),
+		field.String("ngrok_webhook_secret").Optional().Nillable(),
+		field.Bool("ngrok_internal").Default(false).Comment("Create an internal endpoint reachable only through ngrok"),
+		field.String("ngrok_rate_limit").Optional().Nillable().Comment("Requests per window and client IP, e.g. 100/1m"),
+		field.String("cloudflare_connect_timeout").Optional().Nillable().Comment("Origin connect timeout as a Go duration, e.g. 45s"),
+		field.Bool("cloudflare_no_tls_verify").Default(false).Comment("Accept self-signed certificates from an HTTPS origin"),
+		field.String("cloudflare_http_host_header").Optional().Nillable(),
+		field.String("cloudflare_region").Optional().Nillable().Comment("cloudflared edge region, empty for the global region"),
+		field.JSON("cloudflare_env", map[string]string{}).Optional().Comment("cloudflared environment variables, applied as the matching flags"),
+		field.Text("cloudflare_error_page").Optional().Nillable().Comment("HTML served by Pont when the target is unreachable"),
+		field.String("error_grace").Optional().Nillable().Comment("How long an error must persist before it is reported, as a Go duration"),
+		field.Bool("archived").Default(false).Comment("Archived tunnels are hidden from listings and never started"),
+		field.Bool("paused").Default(false).Comment("Paused tunnels stay paused across restarts and are skipped by auto start"),
+		field.Bool("probe_enabled").Default(false).Comment("Periodically check that the public URL answers"),
+		field.String("probe_interval").Optional().Nillable().Comment("Time between reachability probes as a Go duration, e.g. 1m"),
+		field.String("probe_timeout").Optional().Nillable().Comment("Timeout of one probe request as a Go duration"),
+		field.Int("probe_retries").Default(0).Comment("Extra attempts before a probe reports the tunnel unreachable"),
+		field.String("probe_path").Optional().Nillable().Comment("Path requested by the probe, e.g. /healthz"),
+		field.String("idle_timeout").Optional().Nillable().Comment("Stop the tunnel after this long without traffic, as a Go duration"),
+		field.String("pre_start_hook").Optional().Nillable().Comment("Shell command run before the tunnel starts, requires ALLOW_HOOKS"),
+		field.String("post_stop_hook").Optional().Nillable().Comment("Shell command run after the tunnel stops, requires ALLOW_HOOKS"),
 	}
 }
 
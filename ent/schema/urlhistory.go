@@ -0,0 +1,35 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// URLHistory holds the schema definition for the URLHistory entity.
+type URLHistory struct {
+	ent.Schema
+}
+
+// Fields of the URLHistory.
+func (URLHistory) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("tunnel_id"),
+		field.String("url"),
+		field.Time("created_at").Default(time.Now).Immutable().Comment("When the tunnel got this public URL"),
+	}
+}
+
+// Edges of the URLHistory.
+func (URLHistory) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the URLHistory.
+func (URLHistory) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tunnel_id", "created_at"),
+	}
+}
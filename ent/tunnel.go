@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"pont/ent/tunnel"
 	"strings"
@@ -24,18 +25,66 @@ type Tunnel struct {
 	Type tunnel.Type `json:"type,omitempty"`
 	// Target holds the value of the "target" field.
 	Target string `json:"target,omitempty"`
+	// Tunnels in the same group can be started and stopped together
+	Group *string `json:"group,omitempty"`
 	// Enabled holds the value of the "enabled" field.
 	Enabled bool `json:"enabled,omitempty"`
 	// Allow this tunnel to be managed via MCP
 	McpEnabled bool `json:"mcp_enabled,omitempty"`
+	// Favorites are listed first to MCP clients
+	Favorite bool `json:"favorite,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// NgrokAuthtoken holds the value of the "ngrok_authtoken" field.
 	NgrokAuthtoken *string `json:"ngrok_authtoken,omitempty"`
+	// Name of the NgrokCredential whose authtoken the tunnel uses
+	NgrokCredential *string `json:"ngrok_credential,omitempty"`
 	// NgrokDomain holds the value of the "ngrok_domain" field.
-	NgrokDomain  *string `json:"ngrok_domain,omitempty"`
+	NgrokDomain *string `json:"ngrok_domain,omitempty"`
+	// NgrokWebhookProvider holds the value of the "ngrok_webhook_provider" field.
+	NgrokWebhookProvider *string `json:"ngrok_webhook_provider,omitempty"`
+	// NgrokWebhookSecret holds the value of the "ngrok_webhook_secret" field.
+	NgrokWebhookSecret *string `json:"ngrok_webhook_secret,omitempty"`
+	// Create an internal endpoint reachable only through ngrok
+	NgrokInternal bool `json:"ngrok_internal,omitempty"`
+	// Requests per window and client IP, e.g. 100/1m
+	NgrokRateLimit *string `json:"ngrok_rate_limit,omitempty"`
+	// Origin connect timeout as a Go duration, e.g. 45s
+	CloudflareConnectTimeout *string `json:"cloudflare_connect_timeout,omitempty"`
+	// Accept self-signed certificates from an HTTPS origin
+	CloudflareNoTLSVerify bool `json:"cloudflare_no_tls_verify,omitempty"`
+	// CloudflareHTTPHostHeader holds the value of the "cloudflare_http_host_header" field.
+	CloudflareHTTPHostHeader *string `json:"cloudflare_http_host_header,omitempty"`
+	// cloudflared edge region, empty for the global region
+	CloudflareRegion *string `json:"cloudflare_region,omitempty"`
+	// cloudflared environment variables, applied as the matching flags
+	CloudflareEnv map[string]string `json:"cloudflare_env,omitempty"`
+	// HTML served by Pont when the target is unreachable
+	CloudflareErrorPage *string `json:"cloudflare_error_page,omitempty"`
+	// How long an error must persist before it is reported, as a Go duration
+	ErrorGrace *string `json:"error_grace,omitempty"`
+	// Archived tunnels are hidden from listings and never started
+	Archived bool `json:"archived,omitempty"`
+	// Paused tunnels stay paused across restarts and are skipped by auto start
+	Paused bool `json:"paused,omitempty"`
+	// Periodically check that the public URL answers
+	ProbeEnabled bool `json:"probe_enabled,omitempty"`
+	// Time between reachability probes as a Go duration, e.g. 1m
+	ProbeInterval *string `json:"probe_interval,omitempty"`
+	// Timeout of one probe request as a Go duration
+	ProbeTimeout *string `json:"probe_timeout,omitempty"`
+	// Extra attempts before a probe reports the tunnel unreachable
+	ProbeRetries int `json:"probe_retries,omitempty"`
+	// Path requested by the probe, e.g. /healthz
+	ProbePath *string `json:"probe_path,omitempty"`
+	// Stop the tunnel after this long without traffic, as a Go duration
+	IdleTimeout *string `json:"idle_timeout,omitempty"`
+	// Shell command run before the tunnel starts, requires ALLOW_HOOKS
+	PreStartHook *string `json:"pre_start_hook,omitempty"`
+	// Shell command run after the tunnel stops, requires ALLOW_HOOKS
+	PostStopHook *string `json:"post_stop_hook,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -44,9 +93,13 @@ func (*Tunnel) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case tunnel.FieldEnabled, tunnel.FieldMcpEnabled:
+		case tunnel.FieldCloudflareEnv:
+			values[i] = new([]byte)
+		case tunnel.FieldEnabled, tunnel.FieldMcpEnabled, tunnel.FieldFavorite, tunnel.FieldNgrokInternal, tunnel.FieldCloudflareNoTLSVerify, tunnel.FieldArchived, tunnel.FieldPaused, tunnel.FieldProbeEnabled:
 			values[i] = new(sql.NullBool)
-		case tunnel.FieldName, tunnel.FieldType, tunnel.FieldTarget, tunnel.FieldNgrokAuthtoken, tunnel.FieldNgrokDomain:
+		case tunnel.FieldProbeRetries:
+			values[i] = new(sql.NullInt64)
+		case tunnel.FieldName, tunnel.FieldType, tunnel.FieldTarget, tunnel.FieldGroup, tunnel.FieldNgrokAuthtoken, tunnel.FieldNgrokCredential, tunnel.FieldNgrokDomain, tunnel.FieldNgrokWebhookProvider, tunnel.FieldNgrokWebhookSecret, tunnel.FieldNgrokRateLimit, tunnel.FieldCloudflareConnectTimeout, tunnel.FieldCloudflareHTTPHostHeader, tunnel.FieldCloudflareRegion, tunnel.FieldCloudflareErrorPage, tunnel.FieldErrorGrace, tunnel.FieldProbeInterval, tunnel.FieldProbeTimeout, tunnel.FieldProbePath, tunnel.FieldIdleTimeout, tunnel.FieldPreStartHook, tunnel.FieldPostStopHook:
 			values[i] = new(sql.NullString)
 		case tunnel.FieldCreatedAt, tunnel.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -91,6 +144,13 @@ func (_m *Tunnel) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Target = value.String
 			}
+		case tunnel.FieldGroup:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field group", values[i])
+			} else if value.Valid {
+				_m.Group = new(string)
+				*_m.Group = value.String
+			}
 		case tunnel.FieldEnabled:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field enabled", values[i])
@@ -103,6 +163,12 @@ func (_m *Tunnel) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.McpEnabled = value.Bool
 			}
+		case tunnel.FieldFavorite:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field favorite", values[i])
+			} else if value.Valid {
+				_m.Favorite = value.Bool
+			}
 		case tunnel.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -122,6 +188,13 @@ func (_m *Tunnel) assignValues(columns []string, values []any) error {
 				_m.NgrokAuthtoken = new(string)
 				*_m.NgrokAuthtoken = value.String
 			}
+		case tunnel.FieldNgrokCredential:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ngrok_credential", values[i])
+			} else if value.Valid {
+				_m.NgrokCredential = new(string)
+				*_m.NgrokCredential = value.String
+			}
 		case tunnel.FieldNgrokDomain:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field ngrok_domain", values[i])
@@ -129,6 +202,148 @@ func (_m *Tunnel) assignValues(columns []string, values []any) error {
 				_m.NgrokDomain = new(string)
 				*_m.NgrokDomain = value.String
 			}
+		case tunnel.FieldNgrokWebhookProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ngrok_webhook_provider", values[i])
+			} else if value.Valid {
+				_m.NgrokWebhookProvider = new(string)
+				*_m.NgrokWebhookProvider = value.String
+			}
+		case tunnel.FieldNgrokWebhookSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ngrok_webhook_secret", values[i])
+			} else if value.Valid {
+				_m.NgrokWebhookSecret = new(string)
+				*_m.NgrokWebhookSecret = value.String
+			}
+		case tunnel.FieldNgrokInternal:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field ngrok_internal", values[i])
+			} else if value.Valid {
+				_m.NgrokInternal = value.Bool
+			}
+		case tunnel.FieldNgrokRateLimit:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ngrok_rate_limit", values[i])
+			} else if value.Valid {
+				_m.NgrokRateLimit = new(string)
+				*_m.NgrokRateLimit = value.String
+			}
+		case tunnel.FieldCloudflareConnectTimeout:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cloudflare_connect_timeout", values[i])
+			} else if value.Valid {
+				_m.CloudflareConnectTimeout = new(string)
+				*_m.CloudflareConnectTimeout = value.String
+			}
+		case tunnel.FieldCloudflareNoTLSVerify:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field cloudflare_no_tls_verify", values[i])
+			} else if value.Valid {
+				_m.CloudflareNoTLSVerify = value.Bool
+			}
+		case tunnel.FieldCloudflareHTTPHostHeader:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cloudflare_http_host_header", values[i])
+			} else if value.Valid {
+				_m.CloudflareHTTPHostHeader = new(string)
+				*_m.CloudflareHTTPHostHeader = value.String
+			}
+		case tunnel.FieldCloudflareRegion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cloudflare_region", values[i])
+			} else if value.Valid {
+				_m.CloudflareRegion = new(string)
+				*_m.CloudflareRegion = value.String
+			}
+		case tunnel.FieldCloudflareEnv:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field cloudflare_env", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.CloudflareEnv); err != nil {
+					return fmt.Errorf("unmarshal field cloudflare_env: %w", err)
+				}
+			}
+		case tunnel.FieldCloudflareErrorPage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cloudflare_error_page", values[i])
+			} else if value.Valid {
+				_m.CloudflareErrorPage = new(string)
+				*_m.CloudflareErrorPage = value.String
+			}
+		case tunnel.FieldErrorGrace:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error_grace", values[i])
+			} else if value.Valid {
+				_m.ErrorGrace = new(string)
+				*_m.ErrorGrace = value.String
+			}
+		case tunnel.FieldArchived:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field archived", values[i])
+			} else if value.Valid {
+				_m.Archived = value.Bool
+			}
+		case tunnel.FieldPaused:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field paused", values[i])
+			} else if value.Valid {
+				_m.Paused = value.Bool
+			}
+		case tunnel.FieldProbeEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field probe_enabled", values[i])
+			} else if value.Valid {
+				_m.ProbeEnabled = value.Bool
+			}
+		case tunnel.FieldProbeInterval:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field probe_interval", values[i])
+			} else if value.Valid {
+				_m.ProbeInterval = new(string)
+				*_m.ProbeInterval = value.String
+			}
+		case tunnel.FieldProbeTimeout:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field probe_timeout", values[i])
+			} else if value.Valid {
+				_m.ProbeTimeout = new(string)
+				*_m.ProbeTimeout = value.String
+			}
+		case tunnel.FieldProbeRetries:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field probe_retries", values[i])
+			} else if value.Valid {
+				_m.ProbeRetries = int(value.Int64)
+			}
+		case tunnel.FieldProbePath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field probe_path", values[i])
+			} else if value.Valid {
+				_m.ProbePath = new(string)
+				*_m.ProbePath = value.String
+			}
+		case tunnel.FieldIdleTimeout:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field idle_timeout", values[i])
+			} else if value.Valid {
+				_m.IdleTimeout = new(string)
+				*_m.IdleTimeout = value.String
+			}
+		case tunnel.FieldPreStartHook:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pre_start_hook", values[i])
+			} else if value.Valid {
+				_m.PreStartHook = new(string)
+				*_m.PreStartHook = value.String
+			}
+		case tunnel.FieldPostStopHook:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field post_stop_hook", values[i])
+			} else if value.Valid {
+				_m.PostStopHook = new(string)
+				*_m.PostStopHook = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -174,12 +389,20 @@ func (_m *Tunnel) String() string {
 	builder.WriteString("target=")
 	builder.WriteString(_m.Target)
 	builder.WriteString(", ")
+	if v := _m.Group; v != nil {
+		builder.WriteString("group=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("enabled=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Enabled))
 	builder.WriteString(", ")
 	builder.WriteString("mcp_enabled=")
 	builder.WriteString(fmt.Sprintf("%v", _m.McpEnabled))
 	builder.WriteString(", ")
+	builder.WriteString("favorite=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Favorite))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -191,10 +414,106 @@ func (_m *Tunnel) String() string {
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
+	if v := _m.NgrokCredential; v != nil {
+		builder.WriteString("ngrok_credential=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	if v := _m.NgrokDomain; v != nil {
 		builder.WriteString("ngrok_domain=")
 		builder.WriteString(*v)
 	}
+	builder.WriteString(", ")
+	if v := _m.NgrokWebhookProvider; v != nil {
+		builder.WriteString("ngrok_webhook_provider=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.NgrokWebhookSecret; v != nil {
+		builder.WriteString("ngrok_webhook_secret=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("ngrok_internal=")
+	builder.WriteString(fmt.Sprintf("%v", _m.NgrokInternal))
+	builder.WriteString(", ")
+	if v := _m.NgrokRateLimit; v != nil {
+		builder.WriteString("ngrok_rate_limit=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.CloudflareConnectTimeout; v != nil {
+		builder.WriteString("cloudflare_connect_timeout=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("cloudflare_no_tls_verify=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CloudflareNoTLSVerify))
+	builder.WriteString(", ")
+	if v := _m.CloudflareHTTPHostHeader; v != nil {
+		builder.WriteString("cloudflare_http_host_header=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.CloudflareRegion; v != nil {
+		builder.WriteString("cloudflare_region=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("cloudflare_env=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CloudflareEnv))
+	builder.WriteString(", ")
+	if v := _m.CloudflareErrorPage; v != nil {
+		builder.WriteString("cloudflare_error_page=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.ErrorGrace; v != nil {
+		builder.WriteString("error_grace=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("archived=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Archived))
+	builder.WriteString(", ")
+	builder.WriteString("paused=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Paused))
+	builder.WriteString(", ")
+	builder.WriteString("probe_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ProbeEnabled))
+	builder.WriteString(", ")
+	if v := _m.ProbeInterval; v != nil {
+		builder.WriteString("probe_interval=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.ProbeTimeout; v != nil {
+		builder.WriteString("probe_timeout=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("probe_retries=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ProbeRetries))
+	builder.WriteString(", ")
+	if v := _m.ProbePath; v != nil {
+		builder.WriteString("probe_path=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.IdleTimeout; v != nil {
+		builder.WriteString("idle_timeout=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.PreStartHook; v != nil {
+		builder.WriteString("pre_start_hook=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.PostStopHook; v != nil {
+		builder.WriteString("post_stop_hook=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
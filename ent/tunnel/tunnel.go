@@ -21,18 +21,66 @@ const (
 	FieldType = "type"
 	// FieldTarget holds the string denoting the target field in the database.
 	FieldTarget = "target"
+	// FieldGroup holds the string denoting the group field in the database.
+	FieldGroup = "group"
 	// FieldEnabled holds the string denoting the enabled field in the database.
 	FieldEnabled = "enabled"
 	// FieldMcpEnabled holds the string denoting the mcp_enabled field in the database.
 	FieldMcpEnabled = "mcp_enabled"
+	// FieldFavorite holds the string denoting the favorite field in the database.
+	FieldFavorite = "favorite"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
 	// FieldNgrokAuthtoken holds the string denoting the ngrok_authtoken field in the database.
 	FieldNgrokAuthtoken = "ngrok_authtoken"
+	// FieldNgrokCredential holds the string denoting the ngrok_credential field in the database.
+	FieldNgrokCredential = "ngrok_credential"
 	// FieldNgrokDomain holds the string denoting the ngrok_domain field in the database.
 	FieldNgrokDomain = "ngrok_domain"
+	// FieldNgrokWebhookProvider holds the string denoting the ngrok_webhook_provider field in the database.
+	FieldNgrokWebhookProvider = "ngrok_webhook_provider"
+	// FieldNgrokWebhookSecret holds the string denoting the ngrok_webhook_secret field in the database.
+	FieldNgrokWebhookSecret = "ngrok_webhook_secret"
+	// FieldNgrokInternal holds the string denoting the ngrok_internal field in the database.
+	FieldNgrokInternal = "ngrok_internal"
+	// FieldNgrokRateLimit holds the string denoting the ngrok_rate_limit field in the database.
+	FieldNgrokRateLimit = "ngrok_rate_limit"
+	// FieldCloudflareConnectTimeout holds the string denoting the cloudflare_connect_timeout field in the database.
+	FieldCloudflareConnectTimeout = "cloudflare_connect_timeout"
+	// FieldCloudflareNoTLSVerify holds the string denoting the cloudflare_no_tls_verify field in the database.
+	FieldCloudflareNoTLSVerify = "cloudflare_no_tls_verify"
+	// FieldCloudflareHTTPHostHeader holds the string denoting the cloudflare_http_host_header field in the database.
+	FieldCloudflareHTTPHostHeader = "cloudflare_http_host_header"
+	// FieldCloudflareRegion holds the string denoting the cloudflare_region field in the database.
+	FieldCloudflareRegion = "cloudflare_region"
+	// FieldCloudflareEnv holds the string denoting the cloudflare_env field in the database.
+	FieldCloudflareEnv = "cloudflare_env"
+	// FieldCloudflareErrorPage holds the string denoting the cloudflare_error_page field in the database.
+	FieldCloudflareErrorPage = "cloudflare_error_page"
+	// FieldErrorGrace holds the string denoting the error_grace field in the database.
+	FieldErrorGrace = "error_grace"
+	// FieldArchived holds the string denoting the archived field in the database.
+	FieldArchived = "archived"
+	// FieldPaused holds the string denoting the paused field in the database.
+	FieldPaused = "paused"
+	// FieldProbeEnabled holds the string denoting the probe_enabled field in the database.
+	FieldProbeEnabled = "probe_enabled"
+	// FieldProbeInterval holds the string denoting the probe_interval field in the database.
+	FieldProbeInterval = "probe_interval"
+	// FieldProbeTimeout holds the string denoting the probe_timeout field in the database.
+	FieldProbeTimeout = "probe_timeout"
+	// FieldProbeRetries holds the string denoting the probe_retries field in the database.
+	FieldProbeRetries = "probe_retries"
+	// FieldProbePath holds the string denoting the probe_path field in the database.
+	FieldProbePath = "probe_path"
+	// FieldIdleTimeout holds the string denoting the idle_timeout field in the database.
+	FieldIdleTimeout = "idle_timeout"
+	// FieldPreStartHook holds the string denoting the pre_start_hook field in the database.
+	FieldPreStartHook = "pre_start_hook"
+	// FieldPostStopHook holds the string denoting the post_stop_hook field in the database.
+	FieldPostStopHook = "post_stop_hook"
 	// Table holds the table name of the tunnel in the database.
 	Table = "tunnels"
 )
@@ -43,12 +91,36 @@ var Columns = []string{
 	FieldName,
 	FieldType,
 	FieldTarget,
+	FieldGroup,
 	FieldEnabled,
 	FieldMcpEnabled,
+	FieldFavorite,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 	FieldNgrokAuthtoken,
+	FieldNgrokCredential,
 	FieldNgrokDomain,
+	FieldNgrokWebhookProvider,
+	FieldNgrokWebhookSecret,
+	FieldNgrokInternal,
+	FieldNgrokRateLimit,
+	FieldCloudflareConnectTimeout,
+	FieldCloudflareNoTLSVerify,
+	FieldCloudflareHTTPHostHeader,
+	FieldCloudflareRegion,
+	FieldCloudflareEnv,
+	FieldCloudflareErrorPage,
+	FieldErrorGrace,
+	FieldArchived,
+	FieldPaused,
+	FieldProbeEnabled,
+	FieldProbeInterval,
+	FieldProbeTimeout,
+	FieldProbeRetries,
+	FieldProbePath,
+	FieldIdleTimeout,
+	FieldPreStartHook,
+	FieldPostStopHook,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -62,16 +134,34 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// TargetValidator is a validator for the "target" field. It is called by the builders before save.
+	TargetValidator func(string) error
 	// DefaultEnabled holds the default value on creation for the "enabled" field.
 	DefaultEnabled bool
 	// DefaultMcpEnabled holds the default value on creation for the "mcp_enabled" field.
 	DefaultMcpEnabled bool
+	// DefaultFavorite holds the default value on creation for the "favorite" field.
+	DefaultFavorite bool
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
 	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultNgrokInternal holds the default value on creation for the "ngrok_internal" field.
+	DefaultNgrokInternal bool
+	// DefaultCloudflareNoTLSVerify holds the default value on creation for the "cloudflare_no_tls_verify" field.
+	DefaultCloudflareNoTLSVerify bool
+	// DefaultArchived holds the default value on creation for the "archived" field.
+	DefaultArchived bool
+	// DefaultPaused holds the default value on creation for the "paused" field.
+	DefaultPaused bool
+	// DefaultProbeEnabled holds the default value on creation for the "probe_enabled" field.
+	DefaultProbeEnabled bool
+	// DefaultProbeRetries holds the default value on creation for the "probe_retries" field.
+	DefaultProbeRetries int
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -122,6 +212,11 @@ func ByTarget(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTarget, opts...).ToFunc()
 }
 
+// ByGroup orders the results by the group field.
+func ByGroup(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGroup, opts...).ToFunc()
+}
+
 // ByEnabled orders the results by the enabled field.
 func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
@@ -132,6 +227,11 @@ func ByMcpEnabled(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldMcpEnabled, opts...).ToFunc()
 }
 
+// ByFavorite orders the results by the favorite field.
+func ByFavorite(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFavorite, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -147,7 +247,112 @@ func ByNgrokAuthtoken(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldNgrokAuthtoken, opts...).ToFunc()
 }
 
+// ByNgrokCredential orders the results by the ngrok_credential field.
+func ByNgrokCredential(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNgrokCredential, opts...).ToFunc()
+}
+
 // ByNgrokDomain orders the results by the ngrok_domain field.
 func ByNgrokDomain(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldNgrokDomain, opts...).ToFunc()
 }
+
+// ByNgrokWebhookProvider orders the results by the ngrok_webhook_provider field.
+func ByNgrokWebhookProvider(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNgrokWebhookProvider, opts...).ToFunc()
+}
+
+// ByNgrokWebhookSecret orders the results by the ngrok_webhook_secret field.
+func ByNgrokWebhookSecret(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNgrokWebhookSecret, opts...).ToFunc()
+}
+
+// ByNgrokInternal orders the results by the ngrok_internal field.
+func ByNgrokInternal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNgrokInternal, opts...).ToFunc()
+}
+
+// ByNgrokRateLimit orders the results by the ngrok_rate_limit field.
+func ByNgrokRateLimit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNgrokRateLimit, opts...).ToFunc()
+}
+
+// ByCloudflareConnectTimeout orders the results by the cloudflare_connect_timeout field.
+func ByCloudflareConnectTimeout(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCloudflareConnectTimeout, opts...).ToFunc()
+}
+
+// ByCloudflareNoTLSVerify orders the results by the cloudflare_no_tls_verify field.
+func ByCloudflareNoTLSVerify(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCloudflareNoTLSVerify, opts...).ToFunc()
+}
+
+// ByCloudflareHTTPHostHeader orders the results by the cloudflare_http_host_header field.
+func ByCloudflareHTTPHostHeader(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCloudflareHTTPHostHeader, opts...).ToFunc()
+}
+
+// ByCloudflareRegion orders the results by the cloudflare_region field.
+func ByCloudflareRegion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCloudflareRegion, opts...).ToFunc()
+}
+
+// ByCloudflareErrorPage orders the results by the cloudflare_error_page field.
+func ByCloudflareErrorPage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCloudflareErrorPage, opts...).ToFunc()
+}
+
+// ByErrorGrace orders the results by the error_grace field.
+func ByErrorGrace(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldErrorGrace, opts...).ToFunc()
+}
+
+// ByArchived orders the results by the archived field.
+func ByArchived(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldArchived, opts...).ToFunc()
+}
+
+// ByPaused orders the results by the paused field.
+func ByPaused(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPaused, opts...).ToFunc()
+}
+
+// ByProbeEnabled orders the results by the probe_enabled field.
+func ByProbeEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProbeEnabled, opts...).ToFunc()
+}
+
+// ByProbeInterval orders the results by the probe_interval field.
+func ByProbeInterval(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProbeInterval, opts...).ToFunc()
+}
+
+// ByProbeTimeout orders the results by the probe_timeout field.
+func ByProbeTimeout(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProbeTimeout, opts...).ToFunc()
+}
+
+// ByProbeRetries orders the results by the probe_retries field.
+func ByProbeRetries(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProbeRetries, opts...).ToFunc()
+}
+
+// ByProbePath orders the results by the probe_path field.
+func ByProbePath(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProbePath, opts...).ToFunc()
+}
+
+// ByIdleTimeout orders the results by the idle_timeout field.
+func ByIdleTimeout(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIdleTimeout, opts...).ToFunc()
+}
+
+// ByPreStartHook orders the results by the pre_start_hook field.
+func ByPreStartHook(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreStartHook, opts...).ToFunc()
+}
+
+// ByPostStopHook orders the results by the post_stop_hook field.
+func ByPostStopHook(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPostStopHook, opts...).ToFunc()
+}
@@ -65,6 +65,11 @@ func Target(v string) predicate.Tunnel {
 	return predicate.Tunnel(sql.FieldEQ(FieldTarget, v))
 }
 
+// Group applies equality check predicate on the "group" field. It's identical to GroupEQ.
+func Group(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEQ(FieldGroup, v))
+}
+
 // Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
 func Enabled(v bool) predicate.Tunnel {
 	return predicate.Tunnel(sql.FieldEQ(FieldEnabled, v))
@@ -75,6 +80,11 @@ func McpEnabled(v bool) predicate.Tunnel {
 	return predicate.Tunnel(sql.FieldEQ(FieldMcpEnabled, v))
 }
 
+// Favorite applies equality check predicate on the "favorite" field. It's identical to FavoriteEQ.
+func Favorite(v bool) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEQ(FieldFavorite, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Tunnel {
 	return predicate.Tunnel(sql.FieldEQ(FieldCreatedAt, v))
@@ -90,11 +100,116 @@ func NgrokAuthtoken(v string) predicate.Tunnel {
 	return predicate.Tunnel(sql.FieldEQ(FieldNgrokAuthtoken, v))
 }
 
+// NgrokCredential applies equality check predicate on the "ngrok_credential" field. It's identical to NgrokCredentialEQ.
+func NgrokCredential(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEQ(FieldNgrokCredential, v))
+}
+
 // NgrokDomain applies equality check predicate on the "ngrok_domain" field. It's identical to NgrokDomainEQ.
 func NgrokDomain(v string) predicate.Tunnel {
 	return predicate.Tunnel(sql.FieldEQ(FieldNgrokDomain, v))
 }
 
+// NgrokWebhookProvider applies equality check predicate on the "ngrok_webhook_provider" field. It's identical to NgrokWebhookProviderEQ.
+func NgrokWebhookProvider(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEQ(FieldNgrokWebhookProvider, v))
+}
+
+// NgrokWebhookSecret applies equality check predicate on the "ngrok_webhook_secret" field. It's identical to NgrokWebhookSecretEQ.
+func NgrokWebhookSecret(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEQ(FieldNgrokWebhookSecret, v))
+}
+
+// NgrokInternal applies equality check predicate on the "ngrok_internal" field. It's identical to NgrokInternalEQ.
+func NgrokInternal(v bool) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEQ(FieldNgrokInternal, v))
+}
+
+// NgrokRateLimit applies equality check predicate on the "ngrok_rate_limit" field. It's identical to NgrokRateLimitEQ.
+func NgrokRateLimit(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEQ(FieldNgrokRateLimit, v))
+}
+
+// CloudflareConnectTimeout applies equality check predicate on the "cloudflare_connect_timeout" field. It's identical to CloudflareConnectTimeoutEQ.
+func CloudflareConnectTimeout(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEQ(FieldCloudflareConnectTimeout, v))
+}
+
+// CloudflareNoTLSVerify applies equality check predicate on the "cloudflare_no_tls_verify" field. It's identical to CloudflareNoTLSVerifyEQ.
+func CloudflareNoTLSVerify(v bool) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEQ(FieldCloudflareNoTLSVerify, v))
+}
+
+// CloudflareHTTPHostHeader applies equality check predicate on the "cloudflare_http_host_header" field. It's identical to CloudflareHTTPHostHeaderEQ.
+func CloudflareHTTPHostHeader(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEQ(FieldCloudflareHTTPHostHeader, v))
+}
+
+// CloudflareRegion applies equality check predicate on the "cloudflare_region" field. It's identical to CloudflareRegionEQ.
+func CloudflareRegion(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEQ(FieldCloudflareRegion, v))
+}
+
+// CloudflareErrorPage applies equality check predicate on the "cloudflare_error_page" field. It's identical to CloudflareErrorPageEQ.
+func CloudflareErrorPage(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEQ(FieldCloudflareErrorPage, v))
+}
+
+// ErrorGrace applies equality check predicate on the "error_grace" field. It's identical to ErrorGraceEQ.
+func ErrorGrace(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEQ(FieldErrorGrace, v))
+}
+
+// Archived applies equality check predicate on the "archived" field. It's identical to ArchivedEQ.
+func Archived(v bool) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEQ(FieldArchived, v))
+}
+
+// Paused applies equality check predicate on the "paused" field. It's identical to PausedEQ.
+func Paused(v bool) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEQ(FieldPaused, v))
+}
+
+// ProbeEnabled applies equality check predicate on the "probe_enabled" field. It's identical to ProbeEnabledEQ.
+func ProbeEnabled(v bool) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEQ(FieldProbeEnabled, v))
+}
+
+// ProbeInterval applies equality check predicate on the "probe_interval" field. It's identical to ProbeIntervalEQ.
+func ProbeInterval(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEQ(FieldProbeInterval, v))
+}
+
+// ProbeTimeout applies equality check predicate on the "probe_timeout" field. It's identical to ProbeTimeoutEQ.
+func ProbeTimeout(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEQ(FieldProbeTimeout, v))
+}
+
+// ProbeRetries applies equality check predicate on the "probe_retries" field. It's identical to ProbeRetriesEQ.
+func ProbeRetries(v int) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEQ(FieldProbeRetries, v))
+}
+
+// ProbePath applies equality check predicate on the "probe_path" field. It's identical to ProbePathEQ.
+func ProbePath(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEQ(FieldProbePath, v))
+}
+
+// IdleTimeout applies equality check predicate on the "idle_timeout" field. It's identical to IdleTimeoutEQ.
+func IdleTimeout(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEQ(FieldIdleTimeout, v))
+}
+
+// PreStartHook applies equality check predicate on the "pre_start_hook" field. It's identical to PreStartHookEQ.
+func PreStartHook(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEQ(FieldPreStartHook, v))
+}
+
+// PostStopHook applies equality check predicate on the "post_stop_hook" field. It's identical to PostStopHookEQ.
+func PostStopHook(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEQ(FieldPostStopHook, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Tunnel {
 	return predicate.Tunnel(sql.FieldEQ(FieldName, v))
@@ -245,6 +360,81 @@ func TargetContainsFold(v string) predicate.Tunnel {
 	return predicate.Tunnel(sql.FieldContainsFold(FieldTarget, v))
 }
 
+// GroupEQ applies the EQ predicate on the "group" field.
+func GroupEQ(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEQ(FieldGroup, v))
+}
+
+// GroupNEQ applies the NEQ predicate on the "group" field.
+func GroupNEQ(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNEQ(FieldGroup, v))
+}
+
+// GroupIn applies the In predicate on the "group" field.
+func GroupIn(vs ...string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldIn(FieldGroup, vs...))
+}
+
+// GroupNotIn applies the NotIn predicate on the "group" field.
+func GroupNotIn(vs ...string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNotIn(FieldGroup, vs...))
+}
+
+// GroupGT applies the GT predicate on the "group" field.
+func GroupGT(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldGT(FieldGroup, v))
+}
+
+// GroupGTE applies the GTE predicate on the "group" field.
+func GroupGTE(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldGTE(FieldGroup, v))
+}
+
+// GroupLT applies the LT predicate on the "group" field.
+func GroupLT(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldLT(FieldGroup, v))
+}
+
+// GroupLTE applies the LTE predicate on the "group" field.
+func GroupLTE(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldLTE(FieldGroup, v))
+}
+
+// GroupContains applies the Contains predicate on the "group" field.
+func GroupContains(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldContains(FieldGroup, v))
+}
+
+// GroupHasPrefix applies the HasPrefix predicate on the "group" field.
+func GroupHasPrefix(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldHasPrefix(FieldGroup, v))
+}
+
+// GroupHasSuffix applies the HasSuffix predicate on the "group" field.
+func GroupHasSuffix(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldHasSuffix(FieldGroup, v))
+}
+
+// GroupIsNil applies the IsNil predicate on the "group" field.
+func GroupIsNil() predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldIsNull(FieldGroup))
+}
+
+// GroupNotNil applies the NotNil predicate on the "group" field.
+func GroupNotNil() predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNotNull(FieldGroup))
+}
+
+// GroupEqualFold applies the EqualFold predicate on the "group" field.
+func GroupEqualFold(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEqualFold(FieldGroup, v))
+}
+
+// GroupContainsFold applies the ContainsFold predicate on the "group" field.
+func GroupContainsFold(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldContainsFold(FieldGroup, v))
+}
+
 // EnabledEQ applies the EQ predicate on the "enabled" field.
 func EnabledEQ(v bool) predicate.Tunnel {
 	return predicate.Tunnel(sql.FieldEQ(FieldEnabled, v))
@@ -265,6 +455,16 @@ func McpEnabledNEQ(v bool) predicate.Tunnel {
 	return predicate.Tunnel(sql.FieldNEQ(FieldMcpEnabled, v))
 }
 
+// FavoriteEQ applies the EQ predicate on the "favorite" field.
+func FavoriteEQ(v bool) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEQ(FieldFavorite, v))
+}
+
+// FavoriteNEQ applies the NEQ predicate on the "favorite" field.
+func FavoriteNEQ(v bool) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNEQ(FieldFavorite, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Tunnel {
 	return predicate.Tunnel(sql.FieldEQ(FieldCreatedAt, v))
@@ -420,6 +620,81 @@ func NgrokAuthtokenContainsFold(v string) predicate.Tunnel {
 	return predicate.Tunnel(sql.FieldContainsFold(FieldNgrokAuthtoken, v))
 }
 
+// NgrokCredentialEQ applies the EQ predicate on the "ngrok_credential" field.
+func NgrokCredentialEQ(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEQ(FieldNgrokCredential, v))
+}
+
+// NgrokCredentialNEQ applies the NEQ predicate on the "ngrok_credential" field.
+func NgrokCredentialNEQ(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNEQ(FieldNgrokCredential, v))
+}
+
+// NgrokCredentialIn applies the In predicate on the "ngrok_credential" field.
+func NgrokCredentialIn(vs ...string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldIn(FieldNgrokCredential, vs...))
+}
+
+// NgrokCredentialNotIn applies the NotIn predicate on the "ngrok_credential" field.
+func NgrokCredentialNotIn(vs ...string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNotIn(FieldNgrokCredential, vs...))
+}
+
+// NgrokCredentialGT applies the GT predicate on the "ngrok_credential" field.
+func NgrokCredentialGT(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldGT(FieldNgrokCredential, v))
+}
+
+// NgrokCredentialGTE applies the GTE predicate on the "ngrok_credential" field.
+func NgrokCredentialGTE(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldGTE(FieldNgrokCredential, v))
+}
+
+// NgrokCredentialLT applies the LT predicate on the "ngrok_credential" field.
+func NgrokCredentialLT(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldLT(FieldNgrokCredential, v))
+}
+
+// NgrokCredentialLTE applies the LTE predicate on the "ngrok_credential" field.
+func NgrokCredentialLTE(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldLTE(FieldNgrokCredential, v))
+}
+
+// NgrokCredentialContains applies the Contains predicate on the "ngrok_credential" field.
+func NgrokCredentialContains(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldContains(FieldNgrokCredential, v))
+}
+
+// NgrokCredentialHasPrefix applies the HasPrefix predicate on the "ngrok_credential" field.
+func NgrokCredentialHasPrefix(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldHasPrefix(FieldNgrokCredential, v))
+}
+
+// NgrokCredentialHasSuffix applies the HasSuffix predicate on the "ngrok_credential" field.
+func NgrokCredentialHasSuffix(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldHasSuffix(FieldNgrokCredential, v))
+}
+
+// NgrokCredentialIsNil applies the IsNil predicate on the "ngrok_credential" field.
+func NgrokCredentialIsNil() predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldIsNull(FieldNgrokCredential))
+}
+
+// NgrokCredentialNotNil applies the NotNil predicate on the "ngrok_credential" field.
+func NgrokCredentialNotNil() predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNotNull(FieldNgrokCredential))
+}
+
+// NgrokCredentialEqualFold applies the EqualFold predicate on the "ngrok_credential" field.
+func NgrokCredentialEqualFold(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEqualFold(FieldNgrokCredential, v))
+}
+
+// NgrokCredentialContainsFold applies the ContainsFold predicate on the "ngrok_credential" field.
+func NgrokCredentialContainsFold(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldContainsFold(FieldNgrokCredential, v))
+}
+
 // NgrokDomainEQ applies the EQ predicate on the "ngrok_domain" field.
 func NgrokDomainEQ(v string) predicate.Tunnel {
 	return predicate.Tunnel(sql.FieldEQ(FieldNgrokDomain, v))
@@ -495,6 +770,1156 @@ func NgrokDomainContainsFold(v string) predicate.Tunnel {
 	return predicate.Tunnel(sql.FieldContainsFold(FieldNgrokDomain, v))
 }
 
+// NgrokWebhookProviderEQ applies the EQ predicate on the "ngrok_webhook_provider" field.
+func NgrokWebhookProviderEQ(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEQ(FieldNgrokWebhookProvider, v))
+}
+
+// NgrokWebhookProviderNEQ applies the NEQ predicate on the "ngrok_webhook_provider" field.
+func NgrokWebhookProviderNEQ(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNEQ(FieldNgrokWebhookProvider, v))
+}
+
+// NgrokWebhookProviderIn applies the In predicate on the "ngrok_webhook_provider" field.
+func NgrokWebhookProviderIn(vs ...string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldIn(FieldNgrokWebhookProvider, vs...))
+}
+
+// NgrokWebhookProviderNotIn applies the NotIn predicate on the "ngrok_webhook_provider" field.
+func NgrokWebhookProviderNotIn(vs ...string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNotIn(FieldNgrokWebhookProvider, vs...))
+}
+
+// NgrokWebhookProviderGT applies the GT predicate on the "ngrok_webhook_provider" field.
+func NgrokWebhookProviderGT(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldGT(FieldNgrokWebhookProvider, v))
+}
+
+// NgrokWebhookProviderGTE applies the GTE predicate on the "ngrok_webhook_provider" field.
+func NgrokWebhookProviderGTE(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldGTE(FieldNgrokWebhookProvider, v))
+}
+
+// NgrokWebhookProviderLT applies the LT predicate on the "ngrok_webhook_provider" field.
+func NgrokWebhookProviderLT(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldLT(FieldNgrokWebhookProvider, v))
+}
+
+// NgrokWebhookProviderLTE applies the LTE predicate on the "ngrok_webhook_provider" field.
+func NgrokWebhookProviderLTE(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldLTE(FieldNgrokWebhookProvider, v))
+}
+
+// NgrokWebhookProviderContains applies the Contains predicate on the "ngrok_webhook_provider" field.
+func NgrokWebhookProviderContains(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldContains(FieldNgrokWebhookProvider, v))
+}
+
+// NgrokWebhookProviderHasPrefix applies the HasPrefix predicate on the "ngrok_webhook_provider" field.
+func NgrokWebhookProviderHasPrefix(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldHasPrefix(FieldNgrokWebhookProvider, v))
+}
+
+// NgrokWebhookProviderHasSuffix applies the HasSuffix predicate on the "ngrok_webhook_provider" field.
+func NgrokWebhookProviderHasSuffix(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldHasSuffix(FieldNgrokWebhookProvider, v))
+}
+
+// NgrokWebhookProviderIsNil applies the IsNil predicate on the "ngrok_webhook_provider" field.
+func NgrokWebhookProviderIsNil() predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldIsNull(FieldNgrokWebhookProvider))
+}
+
+// NgrokWebhookProviderNotNil applies the NotNil predicate on the "ngrok_webhook_provider" field.
+func NgrokWebhookProviderNotNil() predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNotNull(FieldNgrokWebhookProvider))
+}
+
+// NgrokWebhookProviderEqualFold applies the EqualFold predicate on the "ngrok_webhook_provider" field.
+func NgrokWebhookProviderEqualFold(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEqualFold(FieldNgrokWebhookProvider, v))
+}
+
+// NgrokWebhookProviderContainsFold applies the ContainsFold predicate on the "ngrok_webhook_provider" field.
+func NgrokWebhookProviderContainsFold(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldContainsFold(FieldNgrokWebhookProvider, v))
+}
+
+// NgrokWebhookSecretEQ applies the EQ predicate on the "ngrok_webhook_secret" field.
+func NgrokWebhookSecretEQ(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEQ(FieldNgrokWebhookSecret, v))
+}
+
+// NgrokWebhookSecretNEQ applies the NEQ predicate on the "ngrok_webhook_secret" field.
+func NgrokWebhookSecretNEQ(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNEQ(FieldNgrokWebhookSecret, v))
+}
+
+// NgrokWebhookSecretIn applies the In predicate on the "ngrok_webhook_secret" field.
+func NgrokWebhookSecretIn(vs ...string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldIn(FieldNgrokWebhookSecret, vs...))
+}
+
+// NgrokWebhookSecretNotIn applies the NotIn predicate on the "ngrok_webhook_secret" field.
+func NgrokWebhookSecretNotIn(vs ...string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNotIn(FieldNgrokWebhookSecret, vs...))
+}
+
+// NgrokWebhookSecretGT applies the GT predicate on the "ngrok_webhook_secret" field.
+func NgrokWebhookSecretGT(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldGT(FieldNgrokWebhookSecret, v))
+}
+
+// NgrokWebhookSecretGTE applies the GTE predicate on the "ngrok_webhook_secret" field.
+func NgrokWebhookSecretGTE(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldGTE(FieldNgrokWebhookSecret, v))
+}
+
+// NgrokWebhookSecretLT applies the LT predicate on the "ngrok_webhook_secret" field.
+func NgrokWebhookSecretLT(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldLT(FieldNgrokWebhookSecret, v))
+}
+
+// NgrokWebhookSecretLTE applies the LTE predicate on the "ngrok_webhook_secret" field.
+func NgrokWebhookSecretLTE(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldLTE(FieldNgrokWebhookSecret, v))
+}
+
+// NgrokWebhookSecretContains applies the Contains predicate on the "ngrok_webhook_secret" field.
+func NgrokWebhookSecretContains(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldContains(FieldNgrokWebhookSecret, v))
+}
+
+// NgrokWebhookSecretHasPrefix applies the HasPrefix predicate on the "ngrok_webhook_secret" field.
+func NgrokWebhookSecretHasPrefix(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldHasPrefix(FieldNgrokWebhookSecret, v))
+}
+
+// NgrokWebhookSecretHasSuffix applies the HasSuffix predicate on the "ngrok_webhook_secret" field.
+func NgrokWebhookSecretHasSuffix(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldHasSuffix(FieldNgrokWebhookSecret, v))
+}
+
+// NgrokWebhookSecretIsNil applies the IsNil predicate on the "ngrok_webhook_secret" field.
+func NgrokWebhookSecretIsNil() predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldIsNull(FieldNgrokWebhookSecret))
+}
+
+// NgrokWebhookSecretNotNil applies the NotNil predicate on the "ngrok_webhook_secret" field.
+func NgrokWebhookSecretNotNil() predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNotNull(FieldNgrokWebhookSecret))
+}
+
+// NgrokWebhookSecretEqualFold applies the EqualFold predicate on the "ngrok_webhook_secret" field.
+func NgrokWebhookSecretEqualFold(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEqualFold(FieldNgrokWebhookSecret, v))
+}
+
+// NgrokWebhookSecretContainsFold applies the ContainsFold predicate on the "ngrok_webhook_secret" field.
+func NgrokWebhookSecretContainsFold(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldContainsFold(FieldNgrokWebhookSecret, v))
+}
+
+// NgrokInternalEQ applies the EQ predicate on the "ngrok_internal" field.
+func NgrokInternalEQ(v bool) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEQ(FieldNgrokInternal, v))
+}
+
+// NgrokInternalNEQ applies the NEQ predicate on the "ngrok_internal" field.
+func NgrokInternalNEQ(v bool) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNEQ(FieldNgrokInternal, v))
+}
+
+// NgrokRateLimitEQ applies the EQ predicate on the "ngrok_rate_limit" field.
+func NgrokRateLimitEQ(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEQ(FieldNgrokRateLimit, v))
+}
+
+// NgrokRateLimitNEQ applies the NEQ predicate on the "ngrok_rate_limit" field.
+func NgrokRateLimitNEQ(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNEQ(FieldNgrokRateLimit, v))
+}
+
+// NgrokRateLimitIn applies the In predicate on the "ngrok_rate_limit" field.
+func NgrokRateLimitIn(vs ...string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldIn(FieldNgrokRateLimit, vs...))
+}
+
+// NgrokRateLimitNotIn applies the NotIn predicate on the "ngrok_rate_limit" field.
+func NgrokRateLimitNotIn(vs ...string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNotIn(FieldNgrokRateLimit, vs...))
+}
+
+// NgrokRateLimitGT applies the GT predicate on the "ngrok_rate_limit" field.
+func NgrokRateLimitGT(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldGT(FieldNgrokRateLimit, v))
+}
+
+// NgrokRateLimitGTE applies the GTE predicate on the "ngrok_rate_limit" field.
+func NgrokRateLimitGTE(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldGTE(FieldNgrokRateLimit, v))
+}
+
+// NgrokRateLimitLT applies the LT predicate on the "ngrok_rate_limit" field.
+func NgrokRateLimitLT(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldLT(FieldNgrokRateLimit, v))
+}
+
+// NgrokRateLimitLTE applies the LTE predicate on the "ngrok_rate_limit" field.
+func NgrokRateLimitLTE(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldLTE(FieldNgrokRateLimit, v))
+}
+
+// NgrokRateLimitContains applies the Contains predicate on the "ngrok_rate_limit" field.
+func NgrokRateLimitContains(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldContains(FieldNgrokRateLimit, v))
+}
+
+// NgrokRateLimitHasPrefix applies the HasPrefix predicate on the "ngrok_rate_limit" field.
+func NgrokRateLimitHasPrefix(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldHasPrefix(FieldNgrokRateLimit, v))
+}
+
+// NgrokRateLimitHasSuffix applies the HasSuffix predicate on the "ngrok_rate_limit" field.
+func NgrokRateLimitHasSuffix(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldHasSuffix(FieldNgrokRateLimit, v))
+}
+
+// NgrokRateLimitIsNil applies the IsNil predicate on the "ngrok_rate_limit" field.
+func NgrokRateLimitIsNil() predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldIsNull(FieldNgrokRateLimit))
+}
+
+// NgrokRateLimitNotNil applies the NotNil predicate on the "ngrok_rate_limit" field.
+func NgrokRateLimitNotNil() predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNotNull(FieldNgrokRateLimit))
+}
+
+// NgrokRateLimitEqualFold applies the EqualFold predicate on the "ngrok_rate_limit" field.
+func NgrokRateLimitEqualFold(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEqualFold(FieldNgrokRateLimit, v))
+}
+
+// NgrokRateLimitContainsFold applies the ContainsFold predicate on the "ngrok_rate_limit" field.
+func NgrokRateLimitContainsFold(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldContainsFold(FieldNgrokRateLimit, v))
+}
+
+// CloudflareConnectTimeoutEQ applies the EQ predicate on the "cloudflare_connect_timeout" field.
+func CloudflareConnectTimeoutEQ(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEQ(FieldCloudflareConnectTimeout, v))
+}
+
+// CloudflareConnectTimeoutNEQ applies the NEQ predicate on the "cloudflare_connect_timeout" field.
+func CloudflareConnectTimeoutNEQ(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNEQ(FieldCloudflareConnectTimeout, v))
+}
+
+// CloudflareConnectTimeoutIn applies the In predicate on the "cloudflare_connect_timeout" field.
+func CloudflareConnectTimeoutIn(vs ...string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldIn(FieldCloudflareConnectTimeout, vs...))
+}
+
+// CloudflareConnectTimeoutNotIn applies the NotIn predicate on the "cloudflare_connect_timeout" field.
+func CloudflareConnectTimeoutNotIn(vs ...string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNotIn(FieldCloudflareConnectTimeout, vs...))
+}
+
+// CloudflareConnectTimeoutGT applies the GT predicate on the "cloudflare_connect_timeout" field.
+func CloudflareConnectTimeoutGT(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldGT(FieldCloudflareConnectTimeout, v))
+}
+
+// CloudflareConnectTimeoutGTE applies the GTE predicate on the "cloudflare_connect_timeout" field.
+func CloudflareConnectTimeoutGTE(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldGTE(FieldCloudflareConnectTimeout, v))
+}
+
+// CloudflareConnectTimeoutLT applies the LT predicate on the "cloudflare_connect_timeout" field.
+func CloudflareConnectTimeoutLT(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldLT(FieldCloudflareConnectTimeout, v))
+}
+
+// CloudflareConnectTimeoutLTE applies the LTE predicate on the "cloudflare_connect_timeout" field.
+func CloudflareConnectTimeoutLTE(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldLTE(FieldCloudflareConnectTimeout, v))
+}
+
+// CloudflareConnectTimeoutContains applies the Contains predicate on the "cloudflare_connect_timeout" field.
+func CloudflareConnectTimeoutContains(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldContains(FieldCloudflareConnectTimeout, v))
+}
+
+// CloudflareConnectTimeoutHasPrefix applies the HasPrefix predicate on the "cloudflare_connect_timeout" field.
+func CloudflareConnectTimeoutHasPrefix(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldHasPrefix(FieldCloudflareConnectTimeout, v))
+}
+
+// CloudflareConnectTimeoutHasSuffix applies the HasSuffix predicate on the "cloudflare_connect_timeout" field.
+func CloudflareConnectTimeoutHasSuffix(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldHasSuffix(FieldCloudflareConnectTimeout, v))
+}
+
+// CloudflareConnectTimeoutIsNil applies the IsNil predicate on the "cloudflare_connect_timeout" field.
+func CloudflareConnectTimeoutIsNil() predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldIsNull(FieldCloudflareConnectTimeout))
+}
+
+// CloudflareConnectTimeoutNotNil applies the NotNil predicate on the "cloudflare_connect_timeout" field.
+func CloudflareConnectTimeoutNotNil() predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNotNull(FieldCloudflareConnectTimeout))
+}
+
+// CloudflareConnectTimeoutEqualFold applies the EqualFold predicate on the "cloudflare_connect_timeout" field.
+func CloudflareConnectTimeoutEqualFold(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEqualFold(FieldCloudflareConnectTimeout, v))
+}
+
+// CloudflareConnectTimeoutContainsFold applies the ContainsFold predicate on the "cloudflare_connect_timeout" field.
+func CloudflareConnectTimeoutContainsFold(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldContainsFold(FieldCloudflareConnectTimeout, v))
+}
+
+// CloudflareNoTLSVerifyEQ applies the EQ predicate on the "cloudflare_no_tls_verify" field.
+func CloudflareNoTLSVerifyEQ(v bool) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEQ(FieldCloudflareNoTLSVerify, v))
+}
+
+// CloudflareNoTLSVerifyNEQ applies the NEQ predicate on the "cloudflare_no_tls_verify" field.
+func CloudflareNoTLSVerifyNEQ(v bool) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNEQ(FieldCloudflareNoTLSVerify, v))
+}
+
+// CloudflareHTTPHostHeaderEQ applies the EQ predicate on the "cloudflare_http_host_header" field.
+func CloudflareHTTPHostHeaderEQ(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEQ(FieldCloudflareHTTPHostHeader, v))
+}
+
+// CloudflareHTTPHostHeaderNEQ applies the NEQ predicate on the "cloudflare_http_host_header" field.
+func CloudflareHTTPHostHeaderNEQ(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNEQ(FieldCloudflareHTTPHostHeader, v))
+}
+
+// CloudflareHTTPHostHeaderIn applies the In predicate on the "cloudflare_http_host_header" field.
+func CloudflareHTTPHostHeaderIn(vs ...string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldIn(FieldCloudflareHTTPHostHeader, vs...))
+}
+
+// CloudflareHTTPHostHeaderNotIn applies the NotIn predicate on the "cloudflare_http_host_header" field.
+func CloudflareHTTPHostHeaderNotIn(vs ...string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNotIn(FieldCloudflareHTTPHostHeader, vs...))
+}
+
+// CloudflareHTTPHostHeaderGT applies the GT predicate on the "cloudflare_http_host_header" field.
+func CloudflareHTTPHostHeaderGT(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldGT(FieldCloudflareHTTPHostHeader, v))
+}
+
+// CloudflareHTTPHostHeaderGTE applies the GTE predicate on the "cloudflare_http_host_header" field.
+func CloudflareHTTPHostHeaderGTE(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldGTE(FieldCloudflareHTTPHostHeader, v))
+}
+
+// CloudflareHTTPHostHeaderLT applies the LT predicate on the "cloudflare_http_host_header" field.
+func CloudflareHTTPHostHeaderLT(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldLT(FieldCloudflareHTTPHostHeader, v))
+}
+
+// CloudflareHTTPHostHeaderLTE applies the LTE predicate on the "cloudflare_http_host_header" field.
+func CloudflareHTTPHostHeaderLTE(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldLTE(FieldCloudflareHTTPHostHeader, v))
+}
+
+// CloudflareHTTPHostHeaderContains applies the Contains predicate on the "cloudflare_http_host_header" field.
+func CloudflareHTTPHostHeaderContains(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldContains(FieldCloudflareHTTPHostHeader, v))
+}
+
+// CloudflareHTTPHostHeaderHasPrefix applies the HasPrefix predicate on the "cloudflare_http_host_header" field.
+func CloudflareHTTPHostHeaderHasPrefix(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldHasPrefix(FieldCloudflareHTTPHostHeader, v))
+}
+
+// CloudflareHTTPHostHeaderHasSuffix applies the HasSuffix predicate on the "cloudflare_http_host_header" field.
+func CloudflareHTTPHostHeaderHasSuffix(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldHasSuffix(FieldCloudflareHTTPHostHeader, v))
+}
+
+// CloudflareHTTPHostHeaderIsNil applies the IsNil predicate on the "cloudflare_http_host_header" field.
+func CloudflareHTTPHostHeaderIsNil() predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldIsNull(FieldCloudflareHTTPHostHeader))
+}
+
+// CloudflareHTTPHostHeaderNotNil applies the NotNil predicate on the "cloudflare_http_host_header" field.
+func CloudflareHTTPHostHeaderNotNil() predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNotNull(FieldCloudflareHTTPHostHeader))
+}
+
+// CloudflareHTTPHostHeaderEqualFold applies the EqualFold predicate on the "cloudflare_http_host_header" field.
+func CloudflareHTTPHostHeaderEqualFold(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEqualFold(FieldCloudflareHTTPHostHeader, v))
+}
+
+// CloudflareHTTPHostHeaderContainsFold applies the ContainsFold predicate on the "cloudflare_http_host_header" field.
+func CloudflareHTTPHostHeaderContainsFold(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldContainsFold(FieldCloudflareHTTPHostHeader, v))
+}
+
+// CloudflareRegionEQ applies the EQ predicate on the "cloudflare_region" field.
+func CloudflareRegionEQ(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEQ(FieldCloudflareRegion, v))
+}
+
+// CloudflareRegionNEQ applies the NEQ predicate on the "cloudflare_region" field.
+func CloudflareRegionNEQ(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNEQ(FieldCloudflareRegion, v))
+}
+
+// CloudflareRegionIn applies the In predicate on the "cloudflare_region" field.
+func CloudflareRegionIn(vs ...string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldIn(FieldCloudflareRegion, vs...))
+}
+
+// CloudflareRegionNotIn applies the NotIn predicate on the "cloudflare_region" field.
+func CloudflareRegionNotIn(vs ...string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNotIn(FieldCloudflareRegion, vs...))
+}
+
+// CloudflareRegionGT applies the GT predicate on the "cloudflare_region" field.
+func CloudflareRegionGT(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldGT(FieldCloudflareRegion, v))
+}
+
+// CloudflareRegionGTE applies the GTE predicate on the "cloudflare_region" field.
+func CloudflareRegionGTE(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldGTE(FieldCloudflareRegion, v))
+}
+
+// CloudflareRegionLT applies the LT predicate on the "cloudflare_region" field.
+func CloudflareRegionLT(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldLT(FieldCloudflareRegion, v))
+}
+
+// CloudflareRegionLTE applies the LTE predicate on the "cloudflare_region" field.
+func CloudflareRegionLTE(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldLTE(FieldCloudflareRegion, v))
+}
+
+// CloudflareRegionContains applies the Contains predicate on the "cloudflare_region" field.
+func CloudflareRegionContains(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldContains(FieldCloudflareRegion, v))
+}
+
+// CloudflareRegionHasPrefix applies the HasPrefix predicate on the "cloudflare_region" field.
+func CloudflareRegionHasPrefix(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldHasPrefix(FieldCloudflareRegion, v))
+}
+
+// CloudflareRegionHasSuffix applies the HasSuffix predicate on the "cloudflare_region" field.
+func CloudflareRegionHasSuffix(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldHasSuffix(FieldCloudflareRegion, v))
+}
+
+// CloudflareRegionIsNil applies the IsNil predicate on the "cloudflare_region" field.
+func CloudflareRegionIsNil() predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldIsNull(FieldCloudflareRegion))
+}
+
+// CloudflareRegionNotNil applies the NotNil predicate on the "cloudflare_region" field.
+func CloudflareRegionNotNil() predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNotNull(FieldCloudflareRegion))
+}
+
+// CloudflareRegionEqualFold applies the EqualFold predicate on the "cloudflare_region" field.
+func CloudflareRegionEqualFold(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEqualFold(FieldCloudflareRegion, v))
+}
+
+// CloudflareRegionContainsFold applies the ContainsFold predicate on the "cloudflare_region" field.
+func CloudflareRegionContainsFold(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldContainsFold(FieldCloudflareRegion, v))
+}
+
+// CloudflareEnvIsNil applies the IsNil predicate on the "cloudflare_env" field.
+func CloudflareEnvIsNil() predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldIsNull(FieldCloudflareEnv))
+}
+
+// CloudflareEnvNotNil applies the NotNil predicate on the "cloudflare_env" field.
+func CloudflareEnvNotNil() predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNotNull(FieldCloudflareEnv))
+}
+
+// CloudflareErrorPageEQ applies the EQ predicate on the "cloudflare_error_page" field.
+func CloudflareErrorPageEQ(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEQ(FieldCloudflareErrorPage, v))
+}
+
+// CloudflareErrorPageNEQ applies the NEQ predicate on the "cloudflare_error_page" field.
+func CloudflareErrorPageNEQ(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNEQ(FieldCloudflareErrorPage, v))
+}
+
+// CloudflareErrorPageIn applies the In predicate on the "cloudflare_error_page" field.
+func CloudflareErrorPageIn(vs ...string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldIn(FieldCloudflareErrorPage, vs...))
+}
+
+// CloudflareErrorPageNotIn applies the NotIn predicate on the "cloudflare_error_page" field.
+func CloudflareErrorPageNotIn(vs ...string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNotIn(FieldCloudflareErrorPage, vs...))
+}
+
+// CloudflareErrorPageGT applies the GT predicate on the "cloudflare_error_page" field.
+func CloudflareErrorPageGT(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldGT(FieldCloudflareErrorPage, v))
+}
+
+// CloudflareErrorPageGTE applies the GTE predicate on the "cloudflare_error_page" field.
+func CloudflareErrorPageGTE(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldGTE(FieldCloudflareErrorPage, v))
+}
+
+// CloudflareErrorPageLT applies the LT predicate on the "cloudflare_error_page" field.
+func CloudflareErrorPageLT(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldLT(FieldCloudflareErrorPage, v))
+}
+
+// CloudflareErrorPageLTE applies the LTE predicate on the "cloudflare_error_page" field.
+func CloudflareErrorPageLTE(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldLTE(FieldCloudflareErrorPage, v))
+}
+
+// CloudflareErrorPageContains applies the Contains predicate on the "cloudflare_error_page" field.
+func CloudflareErrorPageContains(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldContains(FieldCloudflareErrorPage, v))
+}
+
+// CloudflareErrorPageHasPrefix applies the HasPrefix predicate on the "cloudflare_error_page" field.
+func CloudflareErrorPageHasPrefix(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldHasPrefix(FieldCloudflareErrorPage, v))
+}
+
+// CloudflareErrorPageHasSuffix applies the HasSuffix predicate on the "cloudflare_error_page" field.
+func CloudflareErrorPageHasSuffix(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldHasSuffix(FieldCloudflareErrorPage, v))
+}
+
+// CloudflareErrorPageIsNil applies the IsNil predicate on the "cloudflare_error_page" field.
+func CloudflareErrorPageIsNil() predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldIsNull(FieldCloudflareErrorPage))
+}
+
+// CloudflareErrorPageNotNil applies the NotNil predicate on the "cloudflare_error_page" field.
+func CloudflareErrorPageNotNil() predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNotNull(FieldCloudflareErrorPage))
+}
+
+// CloudflareErrorPageEqualFold applies the EqualFold predicate on the "cloudflare_error_page" field.
+func CloudflareErrorPageEqualFold(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEqualFold(FieldCloudflareErrorPage, v))
+}
+
+// CloudflareErrorPageContainsFold applies the ContainsFold predicate on the "cloudflare_error_page" field.
+func CloudflareErrorPageContainsFold(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldContainsFold(FieldCloudflareErrorPage, v))
+}
+
+// ErrorGraceEQ applies the EQ predicate on the "error_grace" field.
+func ErrorGraceEQ(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEQ(FieldErrorGrace, v))
+}
+
+// ErrorGraceNEQ applies the NEQ predicate on the "error_grace" field.
+func ErrorGraceNEQ(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNEQ(FieldErrorGrace, v))
+}
+
+// ErrorGraceIn applies the In predicate on the "error_grace" field.
+func ErrorGraceIn(vs ...string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldIn(FieldErrorGrace, vs...))
+}
+
+// ErrorGraceNotIn applies the NotIn predicate on the "error_grace" field.
+func ErrorGraceNotIn(vs ...string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNotIn(FieldErrorGrace, vs...))
+}
+
+// ErrorGraceGT applies the GT predicate on the "error_grace" field.
+func ErrorGraceGT(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldGT(FieldErrorGrace, v))
+}
+
+// ErrorGraceGTE applies the GTE predicate on the "error_grace" field.
+func ErrorGraceGTE(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldGTE(FieldErrorGrace, v))
+}
+
+// ErrorGraceLT applies the LT predicate on the "error_grace" field.
+func ErrorGraceLT(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldLT(FieldErrorGrace, v))
+}
+
+// ErrorGraceLTE applies the LTE predicate on the "error_grace" field.
+func ErrorGraceLTE(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldLTE(FieldErrorGrace, v))
+}
+
+// ErrorGraceContains applies the Contains predicate on the "error_grace" field.
+func ErrorGraceContains(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldContains(FieldErrorGrace, v))
+}
+
+// ErrorGraceHasPrefix applies the HasPrefix predicate on the "error_grace" field.
+func ErrorGraceHasPrefix(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldHasPrefix(FieldErrorGrace, v))
+}
+
+// ErrorGraceHasSuffix applies the HasSuffix predicate on the "error_grace" field.
+func ErrorGraceHasSuffix(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldHasSuffix(FieldErrorGrace, v))
+}
+
+// ErrorGraceIsNil applies the IsNil predicate on the "error_grace" field.
+func ErrorGraceIsNil() predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldIsNull(FieldErrorGrace))
+}
+
+// ErrorGraceNotNil applies the NotNil predicate on the "error_grace" field.
+func ErrorGraceNotNil() predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNotNull(FieldErrorGrace))
+}
+
+// ErrorGraceEqualFold applies the EqualFold predicate on the "error_grace" field.
+func ErrorGraceEqualFold(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEqualFold(FieldErrorGrace, v))
+}
+
+// ErrorGraceContainsFold applies the ContainsFold predicate on the "error_grace" field.
+func ErrorGraceContainsFold(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldContainsFold(FieldErrorGrace, v))
+}
+
+// ArchivedEQ applies the EQ predicate on the "archived" field.
+func ArchivedEQ(v bool) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEQ(FieldArchived, v))
+}
+
+// ArchivedNEQ applies the NEQ predicate on the "archived" field.
+func ArchivedNEQ(v bool) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNEQ(FieldArchived, v))
+}
+
+// PausedEQ applies the EQ predicate on the "paused" field.
+func PausedEQ(v bool) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEQ(FieldPaused, v))
+}
+
+// PausedNEQ applies the NEQ predicate on the "paused" field.
+func PausedNEQ(v bool) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNEQ(FieldPaused, v))
+}
+
+// ProbeEnabledEQ applies the EQ predicate on the "probe_enabled" field.
+func ProbeEnabledEQ(v bool) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEQ(FieldProbeEnabled, v))
+}
+
+// ProbeEnabledNEQ applies the NEQ predicate on the "probe_enabled" field.
+func ProbeEnabledNEQ(v bool) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNEQ(FieldProbeEnabled, v))
+}
+
+// ProbeIntervalEQ applies the EQ predicate on the "probe_interval" field.
+func ProbeIntervalEQ(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEQ(FieldProbeInterval, v))
+}
+
+// ProbeIntervalNEQ applies the NEQ predicate on the "probe_interval" field.
+func ProbeIntervalNEQ(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNEQ(FieldProbeInterval, v))
+}
+
+// ProbeIntervalIn applies the In predicate on the "probe_interval" field.
+func ProbeIntervalIn(vs ...string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldIn(FieldProbeInterval, vs...))
+}
+
+// ProbeIntervalNotIn applies the NotIn predicate on the "probe_interval" field.
+func ProbeIntervalNotIn(vs ...string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNotIn(FieldProbeInterval, vs...))
+}
+
+// ProbeIntervalGT applies the GT predicate on the "probe_interval" field.
+func ProbeIntervalGT(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldGT(FieldProbeInterval, v))
+}
+
+// ProbeIntervalGTE applies the GTE predicate on the "probe_interval" field.
+func ProbeIntervalGTE(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldGTE(FieldProbeInterval, v))
+}
+
+// ProbeIntervalLT applies the LT predicate on the "probe_interval" field.
+func ProbeIntervalLT(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldLT(FieldProbeInterval, v))
+}
+
+// ProbeIntervalLTE applies the LTE predicate on the "probe_interval" field.
+func ProbeIntervalLTE(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldLTE(FieldProbeInterval, v))
+}
+
+// ProbeIntervalContains applies the Contains predicate on the "probe_interval" field.
+func ProbeIntervalContains(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldContains(FieldProbeInterval, v))
+}
+
+// ProbeIntervalHasPrefix applies the HasPrefix predicate on the "probe_interval" field.
+func ProbeIntervalHasPrefix(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldHasPrefix(FieldProbeInterval, v))
+}
+
+// ProbeIntervalHasSuffix applies the HasSuffix predicate on the "probe_interval" field.
+func ProbeIntervalHasSuffix(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldHasSuffix(FieldProbeInterval, v))
+}
+
+// ProbeIntervalIsNil applies the IsNil predicate on the "probe_interval" field.
+func ProbeIntervalIsNil() predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldIsNull(FieldProbeInterval))
+}
+
+// ProbeIntervalNotNil applies the NotNil predicate on the "probe_interval" field.
+func ProbeIntervalNotNil() predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNotNull(FieldProbeInterval))
+}
+
+// ProbeIntervalEqualFold applies the EqualFold predicate on the "probe_interval" field.
+func ProbeIntervalEqualFold(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEqualFold(FieldProbeInterval, v))
+}
+
+// ProbeIntervalContainsFold applies the ContainsFold predicate on the "probe_interval" field.
+func ProbeIntervalContainsFold(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldContainsFold(FieldProbeInterval, v))
+}
+
+// ProbeTimeoutEQ applies the EQ predicate on the "probe_timeout" field.
+func ProbeTimeoutEQ(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEQ(FieldProbeTimeout, v))
+}
+
+// ProbeTimeoutNEQ applies the NEQ predicate on the "probe_timeout" field.
+func ProbeTimeoutNEQ(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNEQ(FieldProbeTimeout, v))
+}
+
+// ProbeTimeoutIn applies the In predicate on the "probe_timeout" field.
+func ProbeTimeoutIn(vs ...string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldIn(FieldProbeTimeout, vs...))
+}
+
+// ProbeTimeoutNotIn applies the NotIn predicate on the "probe_timeout" field.
+func ProbeTimeoutNotIn(vs ...string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNotIn(FieldProbeTimeout, vs...))
+}
+
+// ProbeTimeoutGT applies the GT predicate on the "probe_timeout" field.
+func ProbeTimeoutGT(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldGT(FieldProbeTimeout, v))
+}
+
+// ProbeTimeoutGTE applies the GTE predicate on the "probe_timeout" field.
+func ProbeTimeoutGTE(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldGTE(FieldProbeTimeout, v))
+}
+
+// ProbeTimeoutLT applies the LT predicate on the "probe_timeout" field.
+func ProbeTimeoutLT(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldLT(FieldProbeTimeout, v))
+}
+
+// ProbeTimeoutLTE applies the LTE predicate on the "probe_timeout" field.
+func ProbeTimeoutLTE(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldLTE(FieldProbeTimeout, v))
+}
+
+// ProbeTimeoutContains applies the Contains predicate on the "probe_timeout" field.
+func ProbeTimeoutContains(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldContains(FieldProbeTimeout, v))
+}
+
+// ProbeTimeoutHasPrefix applies the HasPrefix predicate on the "probe_timeout" field.
+func ProbeTimeoutHasPrefix(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldHasPrefix(FieldProbeTimeout, v))
+}
+
+// ProbeTimeoutHasSuffix applies the HasSuffix predicate on the "probe_timeout" field.
+func ProbeTimeoutHasSuffix(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldHasSuffix(FieldProbeTimeout, v))
+}
+
+// ProbeTimeoutIsNil applies the IsNil predicate on the "probe_timeout" field.
+func ProbeTimeoutIsNil() predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldIsNull(FieldProbeTimeout))
+}
+
+// ProbeTimeoutNotNil applies the NotNil predicate on the "probe_timeout" field.
+func ProbeTimeoutNotNil() predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNotNull(FieldProbeTimeout))
+}
+
+// ProbeTimeoutEqualFold applies the EqualFold predicate on the "probe_timeout" field.
+func ProbeTimeoutEqualFold(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEqualFold(FieldProbeTimeout, v))
+}
+
+// ProbeTimeoutContainsFold applies the ContainsFold predicate on the "probe_timeout" field.
+func ProbeTimeoutContainsFold(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldContainsFold(FieldProbeTimeout, v))
+}
+
+// ProbeRetriesEQ applies the EQ predicate on the "probe_retries" field.
+func ProbeRetriesEQ(v int) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEQ(FieldProbeRetries, v))
+}
+
+// ProbeRetriesNEQ applies the NEQ predicate on the "probe_retries" field.
+func ProbeRetriesNEQ(v int) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNEQ(FieldProbeRetries, v))
+}
+
+// ProbeRetriesIn applies the In predicate on the "probe_retries" field.
+func ProbeRetriesIn(vs ...int) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldIn(FieldProbeRetries, vs...))
+}
+
+// ProbeRetriesNotIn applies the NotIn predicate on the "probe_retries" field.
+func ProbeRetriesNotIn(vs ...int) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNotIn(FieldProbeRetries, vs...))
+}
+
+// ProbeRetriesGT applies the GT predicate on the "probe_retries" field.
+func ProbeRetriesGT(v int) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldGT(FieldProbeRetries, v))
+}
+
+// ProbeRetriesGTE applies the GTE predicate on the "probe_retries" field.
+func ProbeRetriesGTE(v int) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldGTE(FieldProbeRetries, v))
+}
+
+// ProbeRetriesLT applies the LT predicate on the "probe_retries" field.
+func ProbeRetriesLT(v int) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldLT(FieldProbeRetries, v))
+}
+
+// ProbeRetriesLTE applies the LTE predicate on the "probe_retries" field.
+func ProbeRetriesLTE(v int) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldLTE(FieldProbeRetries, v))
+}
+
+// ProbePathEQ applies the EQ predicate on the "probe_path" field.
+func ProbePathEQ(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEQ(FieldProbePath, v))
+}
+
+// ProbePathNEQ applies the NEQ predicate on the "probe_path" field.
+func ProbePathNEQ(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNEQ(FieldProbePath, v))
+}
+
+// ProbePathIn applies the In predicate on the "probe_path" field.
+func ProbePathIn(vs ...string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldIn(FieldProbePath, vs...))
+}
+
+// ProbePathNotIn applies the NotIn predicate on the "probe_path" field.
+func ProbePathNotIn(vs ...string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNotIn(FieldProbePath, vs...))
+}
+
+// ProbePathGT applies the GT predicate on the "probe_path" field.
+func ProbePathGT(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldGT(FieldProbePath, v))
+}
+
+// ProbePathGTE applies the GTE predicate on the "probe_path" field.
+func ProbePathGTE(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldGTE(FieldProbePath, v))
+}
+
+// ProbePathLT applies the LT predicate on the "probe_path" field.
+func ProbePathLT(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldLT(FieldProbePath, v))
+}
+
+// ProbePathLTE applies the LTE predicate on the "probe_path" field.
+func ProbePathLTE(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldLTE(FieldProbePath, v))
+}
+
+// ProbePathContains applies the Contains predicate on the "probe_path" field.
+func ProbePathContains(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldContains(FieldProbePath, v))
+}
+
+// ProbePathHasPrefix applies the HasPrefix predicate on the "probe_path" field.
+func ProbePathHasPrefix(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldHasPrefix(FieldProbePath, v))
+}
+
+// ProbePathHasSuffix applies the HasSuffix predicate on the "probe_path" field.
+func ProbePathHasSuffix(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldHasSuffix(FieldProbePath, v))
+}
+
+// ProbePathIsNil applies the IsNil predicate on the "probe_path" field.
+func ProbePathIsNil() predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldIsNull(FieldProbePath))
+}
+
+// ProbePathNotNil applies the NotNil predicate on the "probe_path" field.
+func ProbePathNotNil() predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNotNull(FieldProbePath))
+}
+
+// ProbePathEqualFold applies the EqualFold predicate on the "probe_path" field.
+func ProbePathEqualFold(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEqualFold(FieldProbePath, v))
+}
+
+// ProbePathContainsFold applies the ContainsFold predicate on the "probe_path" field.
+func ProbePathContainsFold(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldContainsFold(FieldProbePath, v))
+}
+
+// IdleTimeoutEQ applies the EQ predicate on the "idle_timeout" field.
+func IdleTimeoutEQ(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEQ(FieldIdleTimeout, v))
+}
+
+// IdleTimeoutNEQ applies the NEQ predicate on the "idle_timeout" field.
+func IdleTimeoutNEQ(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNEQ(FieldIdleTimeout, v))
+}
+
+// IdleTimeoutIn applies the In predicate on the "idle_timeout" field.
+func IdleTimeoutIn(vs ...string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldIn(FieldIdleTimeout, vs...))
+}
+
+// IdleTimeoutNotIn applies the NotIn predicate on the "idle_timeout" field.
+func IdleTimeoutNotIn(vs ...string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNotIn(FieldIdleTimeout, vs...))
+}
+
+// IdleTimeoutGT applies the GT predicate on the "idle_timeout" field.
+func IdleTimeoutGT(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldGT(FieldIdleTimeout, v))
+}
+
+// IdleTimeoutGTE applies the GTE predicate on the "idle_timeout" field.
+func IdleTimeoutGTE(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldGTE(FieldIdleTimeout, v))
+}
+
+// IdleTimeoutLT applies the LT predicate on the "idle_timeout" field.
+func IdleTimeoutLT(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldLT(FieldIdleTimeout, v))
+}
+
+// IdleTimeoutLTE applies the LTE predicate on the "idle_timeout" field.
+func IdleTimeoutLTE(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldLTE(FieldIdleTimeout, v))
+}
+
+// IdleTimeoutContains applies the Contains predicate on the "idle_timeout" field.
+func IdleTimeoutContains(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldContains(FieldIdleTimeout, v))
+}
+
+// IdleTimeoutHasPrefix applies the HasPrefix predicate on the "idle_timeout" field.
+func IdleTimeoutHasPrefix(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldHasPrefix(FieldIdleTimeout, v))
+}
+
+// IdleTimeoutHasSuffix applies the HasSuffix predicate on the "idle_timeout" field.
+func IdleTimeoutHasSuffix(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldHasSuffix(FieldIdleTimeout, v))
+}
+
+// IdleTimeoutIsNil applies the IsNil predicate on the "idle_timeout" field.
+func IdleTimeoutIsNil() predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldIsNull(FieldIdleTimeout))
+}
+
+// IdleTimeoutNotNil applies the NotNil predicate on the "idle_timeout" field.
+func IdleTimeoutNotNil() predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNotNull(FieldIdleTimeout))
+}
+
+// IdleTimeoutEqualFold applies the EqualFold predicate on the "idle_timeout" field.
+func IdleTimeoutEqualFold(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEqualFold(FieldIdleTimeout, v))
+}
+
+// IdleTimeoutContainsFold applies the ContainsFold predicate on the "idle_timeout" field.
+func IdleTimeoutContainsFold(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldContainsFold(FieldIdleTimeout, v))
+}
+
+// PreStartHookEQ applies the EQ predicate on the "pre_start_hook" field.
+func PreStartHookEQ(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEQ(FieldPreStartHook, v))
+}
+
+// PreStartHookNEQ applies the NEQ predicate on the "pre_start_hook" field.
+func PreStartHookNEQ(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNEQ(FieldPreStartHook, v))
+}
+
+// PreStartHookIn applies the In predicate on the "pre_start_hook" field.
+func PreStartHookIn(vs ...string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldIn(FieldPreStartHook, vs...))
+}
+
+// PreStartHookNotIn applies the NotIn predicate on the "pre_start_hook" field.
+func PreStartHookNotIn(vs ...string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNotIn(FieldPreStartHook, vs...))
+}
+
+// PreStartHookGT applies the GT predicate on the "pre_start_hook" field.
+func PreStartHookGT(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldGT(FieldPreStartHook, v))
+}
+
+// PreStartHookGTE applies the GTE predicate on the "pre_start_hook" field.
+func PreStartHookGTE(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldGTE(FieldPreStartHook, v))
+}
+
+// PreStartHookLT applies the LT predicate on the "pre_start_hook" field.
+func PreStartHookLT(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldLT(FieldPreStartHook, v))
+}
+
+// PreStartHookLTE applies the LTE predicate on the "pre_start_hook" field.
+func PreStartHookLTE(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldLTE(FieldPreStartHook, v))
+}
+
+// PreStartHookContains applies the Contains predicate on the "pre_start_hook" field.
+func PreStartHookContains(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldContains(FieldPreStartHook, v))
+}
+
+// PreStartHookHasPrefix applies the HasPrefix predicate on the "pre_start_hook" field.
+func PreStartHookHasPrefix(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldHasPrefix(FieldPreStartHook, v))
+}
+
+// PreStartHookHasSuffix applies the HasSuffix predicate on the "pre_start_hook" field.
+func PreStartHookHasSuffix(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldHasSuffix(FieldPreStartHook, v))
+}
+
+// PreStartHookIsNil applies the IsNil predicate on the "pre_start_hook" field.
+func PreStartHookIsNil() predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldIsNull(FieldPreStartHook))
+}
+
+// PreStartHookNotNil applies the NotNil predicate on the "pre_start_hook" field.
+func PreStartHookNotNil() predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNotNull(FieldPreStartHook))
+}
+
+// PreStartHookEqualFold applies the EqualFold predicate on the "pre_start_hook" field.
+func PreStartHookEqualFold(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEqualFold(FieldPreStartHook, v))
+}
+
+// PreStartHookContainsFold applies the ContainsFold predicate on the "pre_start_hook" field.
+func PreStartHookContainsFold(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldContainsFold(FieldPreStartHook, v))
+}
+
+// PostStopHookEQ applies the EQ predicate on the "post_stop_hook" field.
+func PostStopHookEQ(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEQ(FieldPostStopHook, v))
+}
+
+// PostStopHookNEQ applies the NEQ predicate on the "post_stop_hook" field.
+func PostStopHookNEQ(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNEQ(FieldPostStopHook, v))
+}
+
+// PostStopHookIn applies the In predicate on the "post_stop_hook" field.
+func PostStopHookIn(vs ...string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldIn(FieldPostStopHook, vs...))
+}
+
+// PostStopHookNotIn applies the NotIn predicate on the "post_stop_hook" field.
+func PostStopHookNotIn(vs ...string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNotIn(FieldPostStopHook, vs...))
+}
+
+// PostStopHookGT applies the GT predicate on the "post_stop_hook" field.
+func PostStopHookGT(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldGT(FieldPostStopHook, v))
+}
+
+// PostStopHookGTE applies the GTE predicate on the "post_stop_hook" field.
+func PostStopHookGTE(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldGTE(FieldPostStopHook, v))
+}
+
+// PostStopHookLT applies the LT predicate on the "post_stop_hook" field.
+func PostStopHookLT(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldLT(FieldPostStopHook, v))
+}
+
+// PostStopHookLTE applies the LTE predicate on the "post_stop_hook" field.
+func PostStopHookLTE(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldLTE(FieldPostStopHook, v))
+}
+
+// PostStopHookContains applies the Contains predicate on the "post_stop_hook" field.
+func PostStopHookContains(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldContains(FieldPostStopHook, v))
+}
+
+// PostStopHookHasPrefix applies the HasPrefix predicate on the "post_stop_hook" field.
+func PostStopHookHasPrefix(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldHasPrefix(FieldPostStopHook, v))
+}
+
+// PostStopHookHasSuffix applies the HasSuffix predicate on the "post_stop_hook" field.
+func PostStopHookHasSuffix(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldHasSuffix(FieldPostStopHook, v))
+}
+
+// PostStopHookIsNil applies the IsNil predicate on the "post_stop_hook" field.
+func PostStopHookIsNil() predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldIsNull(FieldPostStopHook))
+}
+
+// PostStopHookNotNil applies the NotNil predicate on the "post_stop_hook" field.
+func PostStopHookNotNil() predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldNotNull(FieldPostStopHook))
+}
+
+// PostStopHookEqualFold applies the EqualFold predicate on the "post_stop_hook" field.
+func PostStopHookEqualFold(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldEqualFold(FieldPostStopHook, v))
+}
+
+// PostStopHookContainsFold applies the ContainsFold predicate on the "post_stop_hook" field.
+func PostStopHookContainsFold(v string) predicate.Tunnel {
+	return predicate.Tunnel(sql.FieldContainsFold(FieldPostStopHook, v))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Tunnel) predicate.Tunnel {
 	return predicate.Tunnel(sql.AndPredicates(predicates...))
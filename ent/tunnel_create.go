@@ -9,6 +9,8 @@ import (
 	"pont/ent/tunnel"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
@@ -19,6 +21,7 @@ type TunnelCreate struct {
 	config
 	mutation *TunnelMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetName sets the "name" field.
@@ -39,6 +42,20 @@ func (_c *TunnelCreate) SetTarget(v string) *TunnelCreate {
 	return _c
 }
 
+// SetGroup sets the "group" field.
+func (_c *TunnelCreate) SetGroup(v string) *TunnelCreate {
+	_c.mutation.SetGroup(v)
+	return _c
+}
+
+// SetNillableGroup sets the "group" field if the given value is not nil.
+func (_c *TunnelCreate) SetNillableGroup(v *string) *TunnelCreate {
+	if v != nil {
+		_c.SetGroup(*v)
+	}
+	return _c
+}
+
 // SetEnabled sets the "enabled" field.
 func (_c *TunnelCreate) SetEnabled(v bool) *TunnelCreate {
 	_c.mutation.SetEnabled(v)
@@ -67,6 +84,20 @@ func (_c *TunnelCreate) SetNillableMcpEnabled(v *bool) *TunnelCreate {
 	return _c
 }
 
+// SetFavorite sets the "favorite" field.
+func (_c *TunnelCreate) SetFavorite(v bool) *TunnelCreate {
+	_c.mutation.SetFavorite(v)
+	return _c
+}
+
+// SetNillableFavorite sets the "favorite" field if the given value is not nil.
+func (_c *TunnelCreate) SetNillableFavorite(v *bool) *TunnelCreate {
+	if v != nil {
+		_c.SetFavorite(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *TunnelCreate) SetCreatedAt(v time.Time) *TunnelCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -109,6 +140,20 @@ func (_c *TunnelCreate) SetNillableNgrokAuthtoken(v *string) *TunnelCreate {
 	return _c
 }
 
+// SetNgrokCredential sets the "ngrok_credential" field.
+func (_c *TunnelCreate) SetNgrokCredential(v string) *TunnelCreate {
+	_c.mutation.SetNgrokCredential(v)
+	return _c
+}
+
+// SetNillableNgrokCredential sets the "ngrok_credential" field if the given value is not nil.
+func (_c *TunnelCreate) SetNillableNgrokCredential(v *string) *TunnelCreate {
+	if v != nil {
+		_c.SetNgrokCredential(*v)
+	}
+	return _c
+}
+
 // SetNgrokDomain sets the "ngrok_domain" field.
 func (_c *TunnelCreate) SetNgrokDomain(v string) *TunnelCreate {
 	_c.mutation.SetNgrokDomain(v)
@@ -123,6 +168,292 @@ func (_c *TunnelCreate) SetNillableNgrokDomain(v *string) *TunnelCreate {
 	return _c
 }
 
+// SetNgrokWebhookProvider sets the "ngrok_webhook_provider" field.
+func (_c *TunnelCreate) SetNgrokWebhookProvider(v string) *TunnelCreate {
+	_c.mutation.SetNgrokWebhookProvider(v)
+	return _c
+}
+
+// SetNillableNgrokWebhookProvider sets the "ngrok_webhook_provider" field if the given value is not nil.
+func (_c *TunnelCreate) SetNillableNgrokWebhookProvider(v *string) *TunnelCreate {
+	if v != nil {
+		_c.SetNgrokWebhookProvider(*v)
+	}
+	return _c
+}
+
+// SetNgrokWebhookSecret sets the "ngrok_webhook_secret" field.
+func (_c *TunnelCreate) SetNgrokWebhookSecret(v string) *TunnelCreate {
+	_c.mutation.SetNgrokWebhookSecret(v)
+	return _c
+}
+
+// SetNillableNgrokWebhookSecret sets the "ngrok_webhook_secret" field if the given value is not nil.
+func (_c *TunnelCreate) SetNillableNgrokWebhookSecret(v *string) *TunnelCreate {
+	if v != nil {
+		_c.SetNgrokWebhookSecret(*v)
+	}
+	return _c
+}
+
+// SetNgrokInternal sets the "ngrok_internal" field.
+func (_c *TunnelCreate) SetNgrokInternal(v bool) *TunnelCreate {
+	_c.mutation.SetNgrokInternal(v)
+	return _c
+}
+
+// SetNillableNgrokInternal sets the "ngrok_internal" field if the given value is not nil.
+func (_c *TunnelCreate) SetNillableNgrokInternal(v *bool) *TunnelCreate {
+	if v != nil {
+		_c.SetNgrokInternal(*v)
+	}
+	return _c
+}
+
+// SetNgrokRateLimit sets the "ngrok_rate_limit" field.
+func (_c *TunnelCreate) SetNgrokRateLimit(v string) *TunnelCreate {
+	_c.mutation.SetNgrokRateLimit(v)
+	return _c
+}
+
+// SetNillableNgrokRateLimit sets the "ngrok_rate_limit" field if the given value is not nil.
+func (_c *TunnelCreate) SetNillableNgrokRateLimit(v *string) *TunnelCreate {
+	if v != nil {
+		_c.SetNgrokRateLimit(*v)
+	}
+	return _c
+}
+
+// SetCloudflareConnectTimeout sets the "cloudflare_connect_timeout" field.
+func (_c *TunnelCreate) SetCloudflareConnectTimeout(v string) *TunnelCreate {
+	_c.mutation.SetCloudflareConnectTimeout(v)
+	return _c
+}
+
+// SetNillableCloudflareConnectTimeout sets the "cloudflare_connect_timeout" field if the given value is not nil.
+func (_c *TunnelCreate) SetNillableCloudflareConnectTimeout(v *string) *TunnelCreate {
+	if v != nil {
+		_c.SetCloudflareConnectTimeout(*v)
+	}
+	return _c
+}
+
+// SetCloudflareNoTLSVerify sets the "cloudflare_no_tls_verify" field.
+func (_c *TunnelCreate) SetCloudflareNoTLSVerify(v bool) *TunnelCreate {
+	_c.mutation.SetCloudflareNoTLSVerify(v)
+	return _c
+}
+
+// SetNillableCloudflareNoTLSVerify sets the "cloudflare_no_tls_verify" field if the given value is not nil.
+func (_c *TunnelCreate) SetNillableCloudflareNoTLSVerify(v *bool) *TunnelCreate {
+	if v != nil {
+		_c.SetCloudflareNoTLSVerify(*v)
+	}
+	return _c
+}
+
+// SetCloudflareHTTPHostHeader sets the "cloudflare_http_host_header" field.
+func (_c *TunnelCreate) SetCloudflareHTTPHostHeader(v string) *TunnelCreate {
+	_c.mutation.SetCloudflareHTTPHostHeader(v)
+	return _c
+}
+
+// SetNillableCloudflareHTTPHostHeader sets the "cloudflare_http_host_header" field if the given value is not nil.
+func (_c *TunnelCreate) SetNillableCloudflareHTTPHostHeader(v *string) *TunnelCreate {
+	if v != nil {
+		_c.SetCloudflareHTTPHostHeader(*v)
+	}
+	return _c
+}
+
+// SetCloudflareRegion sets the "cloudflare_region" field.
+func (_c *TunnelCreate) SetCloudflareRegion(v string) *TunnelCreate {
+	_c.mutation.SetCloudflareRegion(v)
+	return _c
+}
+
+// SetNillableCloudflareRegion sets the "cloudflare_region" field if the given value is not nil.
+func (_c *TunnelCreate) SetNillableCloudflareRegion(v *string) *TunnelCreate {
+	if v != nil {
+		_c.SetCloudflareRegion(*v)
+	}
+	return _c
+}
+
+// SetCloudflareEnv sets the "cloudflare_env" field.
+func (_c *TunnelCreate) SetCloudflareEnv(v map[string]string) *TunnelCreate {
+	_c.mutation.SetCloudflareEnv(v)
+	return _c
+}
+
+// SetCloudflareErrorPage sets the "cloudflare_error_page" field.
+func (_c *TunnelCreate) SetCloudflareErrorPage(v string) *TunnelCreate {
+	_c.mutation.SetCloudflareErrorPage(v)
+	return _c
+}
+
+// SetNillableCloudflareErrorPage sets the "cloudflare_error_page" field if the given value is not nil.
+func (_c *TunnelCreate) SetNillableCloudflareErrorPage(v *string) *TunnelCreate {
+	if v != nil {
+		_c.SetCloudflareErrorPage(*v)
+	}
+	return _c
+}
+
+// SetErrorGrace sets the "error_grace" field.
+func (_c *TunnelCreate) SetErrorGrace(v string) *TunnelCreate {
+	_c.mutation.SetErrorGrace(v)
+	return _c
+}
+
+// SetNillableErrorGrace sets the "error_grace" field if the given value is not nil.
+func (_c *TunnelCreate) SetNillableErrorGrace(v *string) *TunnelCreate {
+	if v != nil {
+		_c.SetErrorGrace(*v)
+	}
+	return _c
+}
+
+// SetArchived sets the "archived" field.
+func (_c *TunnelCreate) SetArchived(v bool) *TunnelCreate {
+	_c.mutation.SetArchived(v)
+	return _c
+}
+
+// SetNillableArchived sets the "archived" field if the given value is not nil.
+func (_c *TunnelCreate) SetNillableArchived(v *bool) *TunnelCreate {
+	if v != nil {
+		_c.SetArchived(*v)
+	}
+	return _c
+}
+
+// SetPaused sets the "paused" field.
+func (_c *TunnelCreate) SetPaused(v bool) *TunnelCreate {
+	_c.mutation.SetPaused(v)
+	return _c
+}
+
+// SetNillablePaused sets the "paused" field if the given value is not nil.
+func (_c *TunnelCreate) SetNillablePaused(v *bool) *TunnelCreate {
+	if v != nil {
+		_c.SetPaused(*v)
+	}
+	return _c
+}
+
+// SetProbeEnabled sets the "probe_enabled" field.
+func (_c *TunnelCreate) SetProbeEnabled(v bool) *TunnelCreate {
+	_c.mutation.SetProbeEnabled(v)
+	return _c
+}
+
+// SetNillableProbeEnabled sets the "probe_enabled" field if the given value is not nil.
+func (_c *TunnelCreate) SetNillableProbeEnabled(v *bool) *TunnelCreate {
+	if v != nil {
+		_c.SetProbeEnabled(*v)
+	}
+	return _c
+}
+
+// SetProbeInterval sets the "probe_interval" field.
+func (_c *TunnelCreate) SetProbeInterval(v string) *TunnelCreate {
+	_c.mutation.SetProbeInterval(v)
+	return _c
+}
+
+// SetNillableProbeInterval sets the "probe_interval" field if the given value is not nil.
+func (_c *TunnelCreate) SetNillableProbeInterval(v *string) *TunnelCreate {
+	if v != nil {
+		_c.SetProbeInterval(*v)
+	}
+	return _c
+}
+
+// SetProbeTimeout sets the "probe_timeout" field.
+func (_c *TunnelCreate) SetProbeTimeout(v string) *TunnelCreate {
+	_c.mutation.SetProbeTimeout(v)
+	return _c
+}
+
+// SetNillableProbeTimeout sets the "probe_timeout" field if the given value is not nil.
+func (_c *TunnelCreate) SetNillableProbeTimeout(v *string) *TunnelCreate {
+	if v != nil {
+		_c.SetProbeTimeout(*v)
+	}
+	return _c
+}
+
+// SetProbeRetries sets the "probe_retries" field.
+func (_c *TunnelCreate) SetProbeRetries(v int) *TunnelCreate {
+	_c.mutation.SetProbeRetries(v)
+	return _c
+}
+
+// SetNillableProbeRetries sets the "probe_retries" field if the given value is not nil.
+func (_c *TunnelCreate) SetNillableProbeRetries(v *int) *TunnelCreate {
+	if v != nil {
+		_c.SetProbeRetries(*v)
+	}
+	return _c
+}
+
+// SetProbePath sets the "probe_path" field.
+func (_c *TunnelCreate) SetProbePath(v string) *TunnelCreate {
+	_c.mutation.SetProbePath(v)
+	return _c
+}
+
+// SetNillableProbePath sets the "probe_path" field if the given value is not nil.
+func (_c *TunnelCreate) SetNillableProbePath(v *string) *TunnelCreate {
+	if v != nil {
+		_c.SetProbePath(*v)
+	}
+	return _c
+}
+
+// SetIdleTimeout sets the "idle_timeout" field.
+func (_c *TunnelCreate) SetIdleTimeout(v string) *TunnelCreate {
+	_c.mutation.SetIdleTimeout(v)
+	return _c
+}
+
+// SetNillableIdleTimeout sets the "idle_timeout" field if the given value is not nil.
+func (_c *TunnelCreate) SetNillableIdleTimeout(v *string) *TunnelCreate {
+	if v != nil {
+		_c.SetIdleTimeout(*v)
+	}
+	return _c
+}
+
+// SetPreStartHook sets the "pre_start_hook" field.
+func (_c *TunnelCreate) SetPreStartHook(v string) *TunnelCreate {
+	_c.mutation.SetPreStartHook(v)
+	return _c
+}
+
+// SetNillablePreStartHook sets the "pre_start_hook" field if the given value is not nil.
+func (_c *TunnelCreate) SetNillablePreStartHook(v *string) *TunnelCreate {
+	if v != nil {
+		_c.SetPreStartHook(*v)
+	}
+	return _c
+}
+
+// SetPostStopHook sets the "post_stop_hook" field.
+func (_c *TunnelCreate) SetPostStopHook(v string) *TunnelCreate {
+	_c.mutation.SetPostStopHook(v)
+	return _c
+}
+
+// SetNillablePostStopHook sets the "post_stop_hook" field if the given value is not nil.
+func (_c *TunnelCreate) SetNillablePostStopHook(v *string) *TunnelCreate {
+	if v != nil {
+		_c.SetPostStopHook(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *TunnelCreate) SetID(v uuid.UUID) *TunnelCreate {
 	_c.mutation.SetID(v)
@@ -180,6 +511,10 @@ func (_c *TunnelCreate) defaults() {
 		v := tunnel.DefaultMcpEnabled
 		_c.mutation.SetMcpEnabled(v)
 	}
+	if _, ok := _c.mutation.Favorite(); !ok {
+		v := tunnel.DefaultFavorite
+		_c.mutation.SetFavorite(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := tunnel.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -188,6 +523,30 @@ func (_c *TunnelCreate) defaults() {
 		v := tunnel.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := _c.mutation.NgrokInternal(); !ok {
+		v := tunnel.DefaultNgrokInternal
+		_c.mutation.SetNgrokInternal(v)
+	}
+	if _, ok := _c.mutation.CloudflareNoTLSVerify(); !ok {
+		v := tunnel.DefaultCloudflareNoTLSVerify
+		_c.mutation.SetCloudflareNoTLSVerify(v)
+	}
+	if _, ok := _c.mutation.Archived(); !ok {
+		v := tunnel.DefaultArchived
+		_c.mutation.SetArchived(v)
+	}
+	if _, ok := _c.mutation.Paused(); !ok {
+		v := tunnel.DefaultPaused
+		_c.mutation.SetPaused(v)
+	}
+	if _, ok := _c.mutation.ProbeEnabled(); !ok {
+		v := tunnel.DefaultProbeEnabled
+		_c.mutation.SetProbeEnabled(v)
+	}
+	if _, ok := _c.mutation.ProbeRetries(); !ok {
+		v := tunnel.DefaultProbeRetries
+		_c.mutation.SetProbeRetries(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		v := tunnel.DefaultID()
 		_c.mutation.SetID(v)
@@ -199,6 +558,11 @@ func (_c *TunnelCreate) check() error {
 	if _, ok := _c.mutation.Name(); !ok {
 		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Tunnel.name"`)}
 	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := tunnel.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Tunnel.name": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.GetType(); !ok {
 		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "Tunnel.type"`)}
 	}
@@ -210,18 +574,44 @@ func (_c *TunnelCreate) check() error {
 	if _, ok := _c.mutation.Target(); !ok {
 		return &ValidationError{Name: "target", err: errors.New(`ent: missing required field "Tunnel.target"`)}
 	}
+	if v, ok := _c.mutation.Target(); ok {
+		if err := tunnel.TargetValidator(v); err != nil {
+			return &ValidationError{Name: "target", err: fmt.Errorf(`ent: validator failed for field "Tunnel.target": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.Enabled(); !ok {
 		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "Tunnel.enabled"`)}
 	}
 	if _, ok := _c.mutation.McpEnabled(); !ok {
 		return &ValidationError{Name: "mcp_enabled", err: errors.New(`ent: missing required field "Tunnel.mcp_enabled"`)}
 	}
+	if _, ok := _c.mutation.Favorite(); !ok {
+		return &ValidationError{Name: "favorite", err: errors.New(`ent: missing required field "Tunnel.favorite"`)}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Tunnel.created_at"`)}
 	}
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Tunnel.updated_at"`)}
 	}
+	if _, ok := _c.mutation.NgrokInternal(); !ok {
+		return &ValidationError{Name: "ngrok_internal", err: errors.New(`ent: missing required field "Tunnel.ngrok_internal"`)}
+	}
+	if _, ok := _c.mutation.CloudflareNoTLSVerify(); !ok {
+		return &ValidationError{Name: "cloudflare_no_tls_verify", err: errors.New(`ent: missing required field "Tunnel.cloudflare_no_tls_verify"`)}
+	}
+	if _, ok := _c.mutation.Archived(); !ok {
+		return &ValidationError{Name: "archived", err: errors.New(`ent: missing required field "Tunnel.archived"`)}
+	}
+	if _, ok := _c.mutation.Paused(); !ok {
+		return &ValidationError{Name: "paused", err: errors.New(`ent: missing required field "Tunnel.paused"`)}
+	}
+	if _, ok := _c.mutation.ProbeEnabled(); !ok {
+		return &ValidationError{Name: "probe_enabled", err: errors.New(`ent: missing required field "Tunnel.probe_enabled"`)}
+	}
+	if _, ok := _c.mutation.ProbeRetries(); !ok {
+		return &ValidationError{Name: "probe_retries", err: errors.New(`ent: missing required field "Tunnel.probe_retries"`)}
+	}
 	return nil
 }
 
@@ -253,6 +643,7 @@ func (_c *TunnelCreate) createSpec() (*Tunnel, *sqlgraph.CreateSpec) {
 		_node = &Tunnel{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(tunnel.Table, sqlgraph.NewFieldSpec(tunnel.FieldID, field.TypeUUID))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = &id
@@ -269,6 +660,10 @@ func (_c *TunnelCreate) createSpec() (*Tunnel, *sqlgraph.CreateSpec) {
 		_spec.SetField(tunnel.FieldTarget, field.TypeString, value)
 		_node.Target = value
 	}
+	if value, ok := _c.mutation.Group(); ok {
+		_spec.SetField(tunnel.FieldGroup, field.TypeString, value)
+		_node.Group = &value
+	}
 	if value, ok := _c.mutation.Enabled(); ok {
 		_spec.SetField(tunnel.FieldEnabled, field.TypeBool, value)
 		_node.Enabled = value
@@ -277,6 +672,10 @@ func (_c *TunnelCreate) createSpec() (*Tunnel, *sqlgraph.CreateSpec) {
 		_spec.SetField(tunnel.FieldMcpEnabled, field.TypeBool, value)
 		_node.McpEnabled = value
 	}
+	if value, ok := _c.mutation.Favorite(); ok {
+		_spec.SetField(tunnel.FieldFavorite, field.TypeBool, value)
+		_node.Favorite = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(tunnel.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -289,47 +688,1367 @@ func (_c *TunnelCreate) createSpec() (*Tunnel, *sqlgraph.CreateSpec) {
 		_spec.SetField(tunnel.FieldNgrokAuthtoken, field.TypeString, value)
 		_node.NgrokAuthtoken = &value
 	}
+	if value, ok := _c.mutation.NgrokCredential(); ok {
+		_spec.SetField(tunnel.FieldNgrokCredential, field.TypeString, value)
+		_node.NgrokCredential = &value
+	}
 	if value, ok := _c.mutation.NgrokDomain(); ok {
 		_spec.SetField(tunnel.FieldNgrokDomain, field.TypeString, value)
 		_node.NgrokDomain = &value
 	}
+	if value, ok := _c.mutation.NgrokWebhookProvider(); ok {
+		_spec.SetField(tunnel.FieldNgrokWebhookProvider, field.TypeString, value)
+		_node.NgrokWebhookProvider = &value
+	}
+	if value, ok := _c.mutation.NgrokWebhookSecret(); ok {
+		_spec.SetField(tunnel.FieldNgrokWebhookSecret, field.TypeString, value)
+		_node.NgrokWebhookSecret = &value
+	}
+	if value, ok := _c.mutation.NgrokInternal(); ok {
+		_spec.SetField(tunnel.FieldNgrokInternal, field.TypeBool, value)
+		_node.NgrokInternal = value
+	}
+	if value, ok := _c.mutation.NgrokRateLimit(); ok {
+		_spec.SetField(tunnel.FieldNgrokRateLimit, field.TypeString, value)
+		_node.NgrokRateLimit = &value
+	}
+	if value, ok := _c.mutation.CloudflareConnectTimeout(); ok {
+		_spec.SetField(tunnel.FieldCloudflareConnectTimeout, field.TypeString, value)
+		_node.CloudflareConnectTimeout = &value
+	}
+	if value, ok := _c.mutation.CloudflareNoTLSVerify(); ok {
+		_spec.SetField(tunnel.FieldCloudflareNoTLSVerify, field.TypeBool, value)
+		_node.CloudflareNoTLSVerify = value
+	}
+	if value, ok := _c.mutation.CloudflareHTTPHostHeader(); ok {
+		_spec.SetField(tunnel.FieldCloudflareHTTPHostHeader, field.TypeString, value)
+		_node.CloudflareHTTPHostHeader = &value
+	}
+	if value, ok := _c.mutation.CloudflareRegion(); ok {
+		_spec.SetField(tunnel.FieldCloudflareRegion, field.TypeString, value)
+		_node.CloudflareRegion = &value
+	}
+	if value, ok := _c.mutation.CloudflareEnv(); ok {
+		_spec.SetField(tunnel.FieldCloudflareEnv, field.TypeJSON, value)
+		_node.CloudflareEnv = value
+	}
+	if value, ok := _c.mutation.CloudflareErrorPage(); ok {
+		_spec.SetField(tunnel.FieldCloudflareErrorPage, field.TypeString, value)
+		_node.CloudflareErrorPage = &value
+	}
+	if value, ok := _c.mutation.ErrorGrace(); ok {
+		_spec.SetField(tunnel.FieldErrorGrace, field.TypeString, value)
+		_node.ErrorGrace = &value
+	}
+	if value, ok := _c.mutation.Archived(); ok {
+		_spec.SetField(tunnel.FieldArchived, field.TypeBool, value)
+		_node.Archived = value
+	}
+	if value, ok := _c.mutation.Paused(); ok {
+		_spec.SetField(tunnel.FieldPaused, field.TypeBool, value)
+		_node.Paused = value
+	}
+	if value, ok := _c.mutation.ProbeEnabled(); ok {
+		_spec.SetField(tunnel.FieldProbeEnabled, field.TypeBool, value)
+		_node.ProbeEnabled = value
+	}
+	if value, ok := _c.mutation.ProbeInterval(); ok {
+		_spec.SetField(tunnel.FieldProbeInterval, field.TypeString, value)
+		_node.ProbeInterval = &value
+	}
+	if value, ok := _c.mutation.ProbeTimeout(); ok {
+		_spec.SetField(tunnel.FieldProbeTimeout, field.TypeString, value)
+		_node.ProbeTimeout = &value
+	}
+	if value, ok := _c.mutation.ProbeRetries(); ok {
+		_spec.SetField(tunnel.FieldProbeRetries, field.TypeInt, value)
+		_node.ProbeRetries = value
+	}
+	if value, ok := _c.mutation.ProbePath(); ok {
+		_spec.SetField(tunnel.FieldProbePath, field.TypeString, value)
+		_node.ProbePath = &value
+	}
+	if value, ok := _c.mutation.IdleTimeout(); ok {
+		_spec.SetField(tunnel.FieldIdleTimeout, field.TypeString, value)
+		_node.IdleTimeout = &value
+	}
+	if value, ok := _c.mutation.PreStartHook(); ok {
+		_spec.SetField(tunnel.FieldPreStartHook, field.TypeString, value)
+		_node.PreStartHook = &value
+	}
+	if value, ok := _c.mutation.PostStopHook(); ok {
+		_spec.SetField(tunnel.FieldPostStopHook, field.TypeString, value)
+		_node.PostStopHook = &value
+	}
 	return _node, _spec
 }
 
-// TunnelCreateBulk is the builder for creating many Tunnel entities in bulk.
-type TunnelCreateBulk struct {
-	config
-	err      error
-	builders []*TunnelCreate
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Tunnel.Create().
+//		SetName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TunnelUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TunnelCreate) OnConflict(opts ...sql.ConflictOption) *TunnelUpsertOne {
+	_c.conflict = opts
+	return &TunnelUpsertOne{
+		create: _c,
+	}
 }
 
-// Save creates the Tunnel entities in the database.
-func (_c *TunnelCreateBulk) Save(ctx context.Context) ([]*Tunnel, error) {
-	if _c.err != nil {
-		return nil, _c.err
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Tunnel.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TunnelCreate) OnConflictColumns(columns ...string) *TunnelUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TunnelUpsertOne{
+		create: _c,
 	}
-	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
-	nodes := make([]*Tunnel, len(_c.builders))
-	mutators := make([]Mutator, len(_c.builders))
-	for i := range _c.builders {
-		func(i int, root context.Context) {
-			builder := _c.builders[i]
-			builder.defaults()
-			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
-				mutation, ok := m.(*TunnelMutation)
-				if !ok {
-					return nil, fmt.Errorf("unexpected mutation type %T", m)
-				}
-				if err := builder.check(); err != nil {
-					return nil, err
-				}
-				builder.mutation = mutation
-				var err error
+}
+
+type (
+	// TunnelUpsertOne is the builder for "upsert"-ing
+	//  one Tunnel node.
+	TunnelUpsertOne struct {
+		create *TunnelCreate
+	}
+
+	// TunnelUpsert is the "OnConflict" setter.
+	TunnelUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetName sets the "name" field.
+func (u *TunnelUpsert) SetName(v string) *TunnelUpsert {
+	u.Set(tunnel.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TunnelUpsert) UpdateName() *TunnelUpsert {
+	u.SetExcluded(tunnel.FieldName)
+	return u
+}
+
+// SetType sets the "type" field.
+func (u *TunnelUpsert) SetType(v tunnel.Type) *TunnelUpsert {
+	u.Set(tunnel.FieldType, v)
+	return u
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *TunnelUpsert) UpdateType() *TunnelUpsert {
+	u.SetExcluded(tunnel.FieldType)
+	return u
+}
+
+// SetTarget sets the "target" field.
+func (u *TunnelUpsert) SetTarget(v string) *TunnelUpsert {
+	u.Set(tunnel.FieldTarget, v)
+	return u
+}
+
+// UpdateTarget sets the "target" field to the value that was provided on create.
+func (u *TunnelUpsert) UpdateTarget() *TunnelUpsert {
+	u.SetExcluded(tunnel.FieldTarget)
+	return u
+}
+
+// SetGroup sets the "group" field.
+func (u *TunnelUpsert) SetGroup(v string) *TunnelUpsert {
+	u.Set(tunnel.FieldGroup, v)
+	return u
+}
+
+// UpdateGroup sets the "group" field to the value that was provided on create.
+func (u *TunnelUpsert) UpdateGroup() *TunnelUpsert {
+	u.SetExcluded(tunnel.FieldGroup)
+	return u
+}
+
+// ClearGroup clears the value of the "group" field.
+func (u *TunnelUpsert) ClearGroup() *TunnelUpsert {
+	u.SetNull(tunnel.FieldGroup)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *TunnelUpsert) SetEnabled(v bool) *TunnelUpsert {
+	u.Set(tunnel.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *TunnelUpsert) UpdateEnabled() *TunnelUpsert {
+	u.SetExcluded(tunnel.FieldEnabled)
+	return u
+}
+
+// SetMcpEnabled sets the "mcp_enabled" field.
+func (u *TunnelUpsert) SetMcpEnabled(v bool) *TunnelUpsert {
+	u.Set(tunnel.FieldMcpEnabled, v)
+	return u
+}
+
+// UpdateMcpEnabled sets the "mcp_enabled" field to the value that was provided on create.
+func (u *TunnelUpsert) UpdateMcpEnabled() *TunnelUpsert {
+	u.SetExcluded(tunnel.FieldMcpEnabled)
+	return u
+}
+
+// SetFavorite sets the "favorite" field.
+func (u *TunnelUpsert) SetFavorite(v bool) *TunnelUpsert {
+	u.Set(tunnel.FieldFavorite, v)
+	return u
+}
+
+// UpdateFavorite sets the "favorite" field to the value that was provided on create.
+func (u *TunnelUpsert) UpdateFavorite() *TunnelUpsert {
+	u.SetExcluded(tunnel.FieldFavorite)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TunnelUpsert) SetUpdatedAt(v time.Time) *TunnelUpsert {
+	u.Set(tunnel.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TunnelUpsert) UpdateUpdatedAt() *TunnelUpsert {
+	u.SetExcluded(tunnel.FieldUpdatedAt)
+	return u
+}
+
+// SetNgrokAuthtoken sets the "ngrok_authtoken" field.
+func (u *TunnelUpsert) SetNgrokAuthtoken(v string) *TunnelUpsert {
+	u.Set(tunnel.FieldNgrokAuthtoken, v)
+	return u
+}
+
+// UpdateNgrokAuthtoken sets the "ngrok_authtoken" field to the value that was provided on create.
+func (u *TunnelUpsert) UpdateNgrokAuthtoken() *TunnelUpsert {
+	u.SetExcluded(tunnel.FieldNgrokAuthtoken)
+	return u
+}
+
+// ClearNgrokAuthtoken clears the value of the "ngrok_authtoken" field.
+func (u *TunnelUpsert) ClearNgrokAuthtoken() *TunnelUpsert {
+	u.SetNull(tunnel.FieldNgrokAuthtoken)
+	return u
+}
+
+// SetNgrokCredential sets the "ngrok_credential" field.
+func (u *TunnelUpsert) SetNgrokCredential(v string) *TunnelUpsert {
+	u.Set(tunnel.FieldNgrokCredential, v)
+	return u
+}
+
+// UpdateNgrokCredential sets the "ngrok_credential" field to the value that was provided on create.
+func (u *TunnelUpsert) UpdateNgrokCredential() *TunnelUpsert {
+	u.SetExcluded(tunnel.FieldNgrokCredential)
+	return u
+}
+
+// ClearNgrokCredential clears the value of the "ngrok_credential" field.
+func (u *TunnelUpsert) ClearNgrokCredential() *TunnelUpsert {
+	u.SetNull(tunnel.FieldNgrokCredential)
+	return u
+}
+
+// SetNgrokDomain sets the "ngrok_domain" field.
+func (u *TunnelUpsert) SetNgrokDomain(v string) *TunnelUpsert {
+	u.Set(tunnel.FieldNgrokDomain, v)
+	return u
+}
+
+// UpdateNgrokDomain sets the "ngrok_domain" field to the value that was provided on create.
+func (u *TunnelUpsert) UpdateNgrokDomain() *TunnelUpsert {
+	u.SetExcluded(tunnel.FieldNgrokDomain)
+	return u
+}
+
+// ClearNgrokDomain clears the value of the "ngrok_domain" field.
+func (u *TunnelUpsert) ClearNgrokDomain() *TunnelUpsert {
+	u.SetNull(tunnel.FieldNgrokDomain)
+	return u
+}
+
+// SetNgrokWebhookProvider sets the "ngrok_webhook_provider" field.
+func (u *TunnelUpsert) SetNgrokWebhookProvider(v string) *TunnelUpsert {
+	u.Set(tunnel.FieldNgrokWebhookProvider, v)
+	return u
+}
+
+// UpdateNgrokWebhookProvider sets the "ngrok_webhook_provider" field to the value that was provided on create.
+func (u *TunnelUpsert) UpdateNgrokWebhookProvider() *TunnelUpsert {
+	u.SetExcluded(tunnel.FieldNgrokWebhookProvider)
+	return u
+}
+
+// ClearNgrokWebhookProvider clears the value of the "ngrok_webhook_provider" field.
+func (u *TunnelUpsert) ClearNgrokWebhookProvider() *TunnelUpsert {
+	u.SetNull(tunnel.FieldNgrokWebhookProvider)
+	return u
+}
+
+// SetNgrokWebhookSecret sets the "ngrok_webhook_secret" field.
+func (u *TunnelUpsert) SetNgrokWebhookSecret(v string) *TunnelUpsert {
+	u.Set(tunnel.FieldNgrokWebhookSecret, v)
+	return u
+}
+
+// UpdateNgrokWebhookSecret sets the "ngrok_webhook_secret" field to the value that was provided on create.
+func (u *TunnelUpsert) UpdateNgrokWebhookSecret() *TunnelUpsert {
+	u.SetExcluded(tunnel.FieldNgrokWebhookSecret)
+	return u
+}
+
+// ClearNgrokWebhookSecret clears the value of the "ngrok_webhook_secret" field.
+func (u *TunnelUpsert) ClearNgrokWebhookSecret() *TunnelUpsert {
+	u.SetNull(tunnel.FieldNgrokWebhookSecret)
+	return u
+}
+
+// SetNgrokInternal sets the "ngrok_internal" field.
+func (u *TunnelUpsert) SetNgrokInternal(v bool) *TunnelUpsert {
+	u.Set(tunnel.FieldNgrokInternal, v)
+	return u
+}
+
+// UpdateNgrokInternal sets the "ngrok_internal" field to the value that was provided on create.
+func (u *TunnelUpsert) UpdateNgrokInternal() *TunnelUpsert {
+	u.SetExcluded(tunnel.FieldNgrokInternal)
+	return u
+}
+
+// SetNgrokRateLimit sets the "ngrok_rate_limit" field.
+func (u *TunnelUpsert) SetNgrokRateLimit(v string) *TunnelUpsert {
+	u.Set(tunnel.FieldNgrokRateLimit, v)
+	return u
+}
+
+// UpdateNgrokRateLimit sets the "ngrok_rate_limit" field to the value that was provided on create.
+func (u *TunnelUpsert) UpdateNgrokRateLimit() *TunnelUpsert {
+	u.SetExcluded(tunnel.FieldNgrokRateLimit)
+	return u
+}
+
+// ClearNgrokRateLimit clears the value of the "ngrok_rate_limit" field.
+func (u *TunnelUpsert) ClearNgrokRateLimit() *TunnelUpsert {
+	u.SetNull(tunnel.FieldNgrokRateLimit)
+	return u
+}
+
+// SetCloudflareConnectTimeout sets the "cloudflare_connect_timeout" field.
+func (u *TunnelUpsert) SetCloudflareConnectTimeout(v string) *TunnelUpsert {
+	u.Set(tunnel.FieldCloudflareConnectTimeout, v)
+	return u
+}
+
+// UpdateCloudflareConnectTimeout sets the "cloudflare_connect_timeout" field to the value that was provided on create.
+func (u *TunnelUpsert) UpdateCloudflareConnectTimeout() *TunnelUpsert {
+	u.SetExcluded(tunnel.FieldCloudflareConnectTimeout)
+	return u
+}
+
+// ClearCloudflareConnectTimeout clears the value of the "cloudflare_connect_timeout" field.
+func (u *TunnelUpsert) ClearCloudflareConnectTimeout() *TunnelUpsert {
+	u.SetNull(tunnel.FieldCloudflareConnectTimeout)
+	return u
+}
+
+// SetCloudflareNoTLSVerify sets the "cloudflare_no_tls_verify" field.
+func (u *TunnelUpsert) SetCloudflareNoTLSVerify(v bool) *TunnelUpsert {
+	u.Set(tunnel.FieldCloudflareNoTLSVerify, v)
+	return u
+}
+
+// UpdateCloudflareNoTLSVerify sets the "cloudflare_no_tls_verify" field to the value that was provided on create.
+func (u *TunnelUpsert) UpdateCloudflareNoTLSVerify() *TunnelUpsert {
+	u.SetExcluded(tunnel.FieldCloudflareNoTLSVerify)
+	return u
+}
+
+// SetCloudflareHTTPHostHeader sets the "cloudflare_http_host_header" field.
+func (u *TunnelUpsert) SetCloudflareHTTPHostHeader(v string) *TunnelUpsert {
+	u.Set(tunnel.FieldCloudflareHTTPHostHeader, v)
+	return u
+}
+
+// UpdateCloudflareHTTPHostHeader sets the "cloudflare_http_host_header" field to the value that was provided on create.
+func (u *TunnelUpsert) UpdateCloudflareHTTPHostHeader() *TunnelUpsert {
+	u.SetExcluded(tunnel.FieldCloudflareHTTPHostHeader)
+	return u
+}
+
+// ClearCloudflareHTTPHostHeader clears the value of the "cloudflare_http_host_header" field.
+func (u *TunnelUpsert) ClearCloudflareHTTPHostHeader() *TunnelUpsert {
+	u.SetNull(tunnel.FieldCloudflareHTTPHostHeader)
+	return u
+}
+
+// SetCloudflareRegion sets the "cloudflare_region" field.
+func (u *TunnelUpsert) SetCloudflareRegion(v string) *TunnelUpsert {
+	u.Set(tunnel.FieldCloudflareRegion, v)
+	return u
+}
+
+// UpdateCloudflareRegion sets the "cloudflare_region" field to the value that was provided on create.
+func (u *TunnelUpsert) UpdateCloudflareRegion() *TunnelUpsert {
+	u.SetExcluded(tunnel.FieldCloudflareRegion)
+	return u
+}
+
+// ClearCloudflareRegion clears the value of the "cloudflare_region" field.
+func (u *TunnelUpsert) ClearCloudflareRegion() *TunnelUpsert {
+	u.SetNull(tunnel.FieldCloudflareRegion)
+	return u
+}
+
+// SetCloudflareEnv sets the "cloudflare_env" field.
+func (u *TunnelUpsert) SetCloudflareEnv(v map[string]string) *TunnelUpsert {
+	u.Set(tunnel.FieldCloudflareEnv, v)
+	return u
+}
+
+// UpdateCloudflareEnv sets the "cloudflare_env" field to the value that was provided on create.
+func (u *TunnelUpsert) UpdateCloudflareEnv() *TunnelUpsert {
+	u.SetExcluded(tunnel.FieldCloudflareEnv)
+	return u
+}
+
+// ClearCloudflareEnv clears the value of the "cloudflare_env" field.
+func (u *TunnelUpsert) ClearCloudflareEnv() *TunnelUpsert {
+	u.SetNull(tunnel.FieldCloudflareEnv)
+	return u
+}
+
+// SetCloudflareErrorPage sets the "cloudflare_error_page" field.
+func (u *TunnelUpsert) SetCloudflareErrorPage(v string) *TunnelUpsert {
+	u.Set(tunnel.FieldCloudflareErrorPage, v)
+	return u
+}
+
+// UpdateCloudflareErrorPage sets the "cloudflare_error_page" field to the value that was provided on create.
+func (u *TunnelUpsert) UpdateCloudflareErrorPage() *TunnelUpsert {
+	u.SetExcluded(tunnel.FieldCloudflareErrorPage)
+	return u
+}
+
+// ClearCloudflareErrorPage clears the value of the "cloudflare_error_page" field.
+func (u *TunnelUpsert) ClearCloudflareErrorPage() *TunnelUpsert {
+	u.SetNull(tunnel.FieldCloudflareErrorPage)
+	return u
+}
+
+// SetErrorGrace sets the "error_grace" field.
+func (u *TunnelUpsert) SetErrorGrace(v string) *TunnelUpsert {
+	u.Set(tunnel.FieldErrorGrace, v)
+	return u
+}
+
+// UpdateErrorGrace sets the "error_grace" field to the value that was provided on create.
+func (u *TunnelUpsert) UpdateErrorGrace() *TunnelUpsert {
+	u.SetExcluded(tunnel.FieldErrorGrace)
+	return u
+}
+
+// ClearErrorGrace clears the value of the "error_grace" field.
+func (u *TunnelUpsert) ClearErrorGrace() *TunnelUpsert {
+	u.SetNull(tunnel.FieldErrorGrace)
+	return u
+}
+
+// SetArchived sets the "archived" field.
+func (u *TunnelUpsert) SetArchived(v bool) *TunnelUpsert {
+	u.Set(tunnel.FieldArchived, v)
+	return u
+}
+
+// UpdateArchived sets the "archived" field to the value that was provided on create.
+func (u *TunnelUpsert) UpdateArchived() *TunnelUpsert {
+	u.SetExcluded(tunnel.FieldArchived)
+	return u
+}
+
+// SetPaused sets the "paused" field.
+func (u *TunnelUpsert) SetPaused(v bool) *TunnelUpsert {
+	u.Set(tunnel.FieldPaused, v)
+	return u
+}
+
+// UpdatePaused sets the "paused" field to the value that was provided on create.
+func (u *TunnelUpsert) UpdatePaused() *TunnelUpsert {
+	u.SetExcluded(tunnel.FieldPaused)
+	return u
+}
+
+// SetProbeEnabled sets the "probe_enabled" field.
+func (u *TunnelUpsert) SetProbeEnabled(v bool) *TunnelUpsert {
+	u.Set(tunnel.FieldProbeEnabled, v)
+	return u
+}
+
+// UpdateProbeEnabled sets the "probe_enabled" field to the value that was provided on create.
+func (u *TunnelUpsert) UpdateProbeEnabled() *TunnelUpsert {
+	u.SetExcluded(tunnel.FieldProbeEnabled)
+	return u
+}
+
+// SetProbeInterval sets the "probe_interval" field.
+func (u *TunnelUpsert) SetProbeInterval(v string) *TunnelUpsert {
+	u.Set(tunnel.FieldProbeInterval, v)
+	return u
+}
+
+// UpdateProbeInterval sets the "probe_interval" field to the value that was provided on create.
+func (u *TunnelUpsert) UpdateProbeInterval() *TunnelUpsert {
+	u.SetExcluded(tunnel.FieldProbeInterval)
+	return u
+}
+
+// ClearProbeInterval clears the value of the "probe_interval" field.
+func (u *TunnelUpsert) ClearProbeInterval() *TunnelUpsert {
+	u.SetNull(tunnel.FieldProbeInterval)
+	return u
+}
+
+// SetProbeTimeout sets the "probe_timeout" field.
+func (u *TunnelUpsert) SetProbeTimeout(v string) *TunnelUpsert {
+	u.Set(tunnel.FieldProbeTimeout, v)
+	return u
+}
+
+// UpdateProbeTimeout sets the "probe_timeout" field to the value that was provided on create.
+func (u *TunnelUpsert) UpdateProbeTimeout() *TunnelUpsert {
+	u.SetExcluded(tunnel.FieldProbeTimeout)
+	return u
+}
+
+// ClearProbeTimeout clears the value of the "probe_timeout" field.
+func (u *TunnelUpsert) ClearProbeTimeout() *TunnelUpsert {
+	u.SetNull(tunnel.FieldProbeTimeout)
+	return u
+}
+
+// SetProbeRetries sets the "probe_retries" field.
+func (u *TunnelUpsert) SetProbeRetries(v int) *TunnelUpsert {
+	u.Set(tunnel.FieldProbeRetries, v)
+	return u
+}
+
+// UpdateProbeRetries sets the "probe_retries" field to the value that was provided on create.
+func (u *TunnelUpsert) UpdateProbeRetries() *TunnelUpsert {
+	u.SetExcluded(tunnel.FieldProbeRetries)
+	return u
+}
+
+// AddProbeRetries adds v to the "probe_retries" field.
+func (u *TunnelUpsert) AddProbeRetries(v int) *TunnelUpsert {
+	u.Add(tunnel.FieldProbeRetries, v)
+	return u
+}
+
+// SetProbePath sets the "probe_path" field.
+func (u *TunnelUpsert) SetProbePath(v string) *TunnelUpsert {
+	u.Set(tunnel.FieldProbePath, v)
+	return u
+}
+
+// UpdateProbePath sets the "probe_path" field to the value that was provided on create.
+func (u *TunnelUpsert) UpdateProbePath() *TunnelUpsert {
+	u.SetExcluded(tunnel.FieldProbePath)
+	return u
+}
+
+// ClearProbePath clears the value of the "probe_path" field.
+func (u *TunnelUpsert) ClearProbePath() *TunnelUpsert {
+	u.SetNull(tunnel.FieldProbePath)
+	return u
+}
+
+// SetIdleTimeout sets the "idle_timeout" field.
+func (u *TunnelUpsert) SetIdleTimeout(v string) *TunnelUpsert {
+	u.Set(tunnel.FieldIdleTimeout, v)
+	return u
+}
+
+// UpdateIdleTimeout sets the "idle_timeout" field to the value that was provided on create.
+func (u *TunnelUpsert) UpdateIdleTimeout() *TunnelUpsert {
+	u.SetExcluded(tunnel.FieldIdleTimeout)
+	return u
+}
+
+// ClearIdleTimeout clears the value of the "idle_timeout" field.
+func (u *TunnelUpsert) ClearIdleTimeout() *TunnelUpsert {
+	u.SetNull(tunnel.FieldIdleTimeout)
+	return u
+}
+
+// SetPreStartHook sets the "pre_start_hook" field.
+func (u *TunnelUpsert) SetPreStartHook(v string) *TunnelUpsert {
+	u.Set(tunnel.FieldPreStartHook, v)
+	return u
+}
+
+// UpdatePreStartHook sets the "pre_start_hook" field to the value that was provided on create.
+func (u *TunnelUpsert) UpdatePreStartHook() *TunnelUpsert {
+	u.SetExcluded(tunnel.FieldPreStartHook)
+	return u
+}
+
+// ClearPreStartHook clears the value of the "pre_start_hook" field.
+func (u *TunnelUpsert) ClearPreStartHook() *TunnelUpsert {
+	u.SetNull(tunnel.FieldPreStartHook)
+	return u
+}
+
+// SetPostStopHook sets the "post_stop_hook" field.
+func (u *TunnelUpsert) SetPostStopHook(v string) *TunnelUpsert {
+	u.Set(tunnel.FieldPostStopHook, v)
+	return u
+}
+
+// UpdatePostStopHook sets the "post_stop_hook" field to the value that was provided on create.
+func (u *TunnelUpsert) UpdatePostStopHook() *TunnelUpsert {
+	u.SetExcluded(tunnel.FieldPostStopHook)
+	return u
+}
+
+// ClearPostStopHook clears the value of the "post_stop_hook" field.
+func (u *TunnelUpsert) ClearPostStopHook() *TunnelUpsert {
+	u.SetNull(tunnel.FieldPostStopHook)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Tunnel.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(tunnel.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TunnelUpsertOne) UpdateNewValues() *TunnelUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(tunnel.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(tunnel.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Tunnel.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TunnelUpsertOne) Ignore() *TunnelUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TunnelUpsertOne) DoNothing() *TunnelUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TunnelCreate.OnConflict
+// documentation for more info.
+func (u *TunnelUpsertOne) Update(set func(*TunnelUpsert)) *TunnelUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TunnelUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *TunnelUpsertOne) SetName(v string) *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TunnelUpsertOne) UpdateName() *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *TunnelUpsertOne) SetType(v tunnel.Type) *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *TunnelUpsertOne) UpdateType() *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetTarget sets the "target" field.
+func (u *TunnelUpsertOne) SetTarget(v string) *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetTarget(v)
+	})
+}
+
+// UpdateTarget sets the "target" field to the value that was provided on create.
+func (u *TunnelUpsertOne) UpdateTarget() *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateTarget()
+	})
+}
+
+// SetGroup sets the "group" field.
+func (u *TunnelUpsertOne) SetGroup(v string) *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetGroup(v)
+	})
+}
+
+// UpdateGroup sets the "group" field to the value that was provided on create.
+func (u *TunnelUpsertOne) UpdateGroup() *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateGroup()
+	})
+}
+
+// ClearGroup clears the value of the "group" field.
+func (u *TunnelUpsertOne) ClearGroup() *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.ClearGroup()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *TunnelUpsertOne) SetEnabled(v bool) *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *TunnelUpsertOne) UpdateEnabled() *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetMcpEnabled sets the "mcp_enabled" field.
+func (u *TunnelUpsertOne) SetMcpEnabled(v bool) *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetMcpEnabled(v)
+	})
+}
+
+// UpdateMcpEnabled sets the "mcp_enabled" field to the value that was provided on create.
+func (u *TunnelUpsertOne) UpdateMcpEnabled() *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateMcpEnabled()
+	})
+}
+
+// SetFavorite sets the "favorite" field.
+func (u *TunnelUpsertOne) SetFavorite(v bool) *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetFavorite(v)
+	})
+}
+
+// UpdateFavorite sets the "favorite" field to the value that was provided on create.
+func (u *TunnelUpsertOne) UpdateFavorite() *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateFavorite()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TunnelUpsertOne) SetUpdatedAt(v time.Time) *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TunnelUpsertOne) UpdateUpdatedAt() *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetNgrokAuthtoken sets the "ngrok_authtoken" field.
+func (u *TunnelUpsertOne) SetNgrokAuthtoken(v string) *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetNgrokAuthtoken(v)
+	})
+}
+
+// UpdateNgrokAuthtoken sets the "ngrok_authtoken" field to the value that was provided on create.
+func (u *TunnelUpsertOne) UpdateNgrokAuthtoken() *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateNgrokAuthtoken()
+	})
+}
+
+// ClearNgrokAuthtoken clears the value of the "ngrok_authtoken" field.
+func (u *TunnelUpsertOne) ClearNgrokAuthtoken() *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.ClearNgrokAuthtoken()
+	})
+}
+
+// SetNgrokCredential sets the "ngrok_credential" field.
+func (u *TunnelUpsertOne) SetNgrokCredential(v string) *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetNgrokCredential(v)
+	})
+}
+
+// UpdateNgrokCredential sets the "ngrok_credential" field to the value that was provided on create.
+func (u *TunnelUpsertOne) UpdateNgrokCredential() *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateNgrokCredential()
+	})
+}
+
+// ClearNgrokCredential clears the value of the "ngrok_credential" field.
+func (u *TunnelUpsertOne) ClearNgrokCredential() *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.ClearNgrokCredential()
+	})
+}
+
+// SetNgrokDomain sets the "ngrok_domain" field.
+func (u *TunnelUpsertOne) SetNgrokDomain(v string) *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetNgrokDomain(v)
+	})
+}
+
+// UpdateNgrokDomain sets the "ngrok_domain" field to the value that was provided on create.
+func (u *TunnelUpsertOne) UpdateNgrokDomain() *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateNgrokDomain()
+	})
+}
+
+// ClearNgrokDomain clears the value of the "ngrok_domain" field.
+func (u *TunnelUpsertOne) ClearNgrokDomain() *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.ClearNgrokDomain()
+	})
+}
+
+// SetNgrokWebhookProvider sets the "ngrok_webhook_provider" field.
+func (u *TunnelUpsertOne) SetNgrokWebhookProvider(v string) *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetNgrokWebhookProvider(v)
+	})
+}
+
+// UpdateNgrokWebhookProvider sets the "ngrok_webhook_provider" field to the value that was provided on create.
+func (u *TunnelUpsertOne) UpdateNgrokWebhookProvider() *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateNgrokWebhookProvider()
+	})
+}
+
+// ClearNgrokWebhookProvider clears the value of the "ngrok_webhook_provider" field.
+func (u *TunnelUpsertOne) ClearNgrokWebhookProvider() *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.ClearNgrokWebhookProvider()
+	})
+}
+
+// SetNgrokWebhookSecret sets the "ngrok_webhook_secret" field.
+func (u *TunnelUpsertOne) SetNgrokWebhookSecret(v string) *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetNgrokWebhookSecret(v)
+	})
+}
+
+// UpdateNgrokWebhookSecret sets the "ngrok_webhook_secret" field to the value that was provided on create.
+func (u *TunnelUpsertOne) UpdateNgrokWebhookSecret() *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateNgrokWebhookSecret()
+	})
+}
+
+// ClearNgrokWebhookSecret clears the value of the "ngrok_webhook_secret" field.
+func (u *TunnelUpsertOne) ClearNgrokWebhookSecret() *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.ClearNgrokWebhookSecret()
+	})
+}
+
+// SetNgrokInternal sets the "ngrok_internal" field.
+func (u *TunnelUpsertOne) SetNgrokInternal(v bool) *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetNgrokInternal(v)
+	})
+}
+
+// UpdateNgrokInternal sets the "ngrok_internal" field to the value that was provided on create.
+func (u *TunnelUpsertOne) UpdateNgrokInternal() *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateNgrokInternal()
+	})
+}
+
+// SetNgrokRateLimit sets the "ngrok_rate_limit" field.
+func (u *TunnelUpsertOne) SetNgrokRateLimit(v string) *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetNgrokRateLimit(v)
+	})
+}
+
+// UpdateNgrokRateLimit sets the "ngrok_rate_limit" field to the value that was provided on create.
+func (u *TunnelUpsertOne) UpdateNgrokRateLimit() *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateNgrokRateLimit()
+	})
+}
+
+// ClearNgrokRateLimit clears the value of the "ngrok_rate_limit" field.
+func (u *TunnelUpsertOne) ClearNgrokRateLimit() *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.ClearNgrokRateLimit()
+	})
+}
+
+// SetCloudflareConnectTimeout sets the "cloudflare_connect_timeout" field.
+func (u *TunnelUpsertOne) SetCloudflareConnectTimeout(v string) *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetCloudflareConnectTimeout(v)
+	})
+}
+
+// UpdateCloudflareConnectTimeout sets the "cloudflare_connect_timeout" field to the value that was provided on create.
+func (u *TunnelUpsertOne) UpdateCloudflareConnectTimeout() *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateCloudflareConnectTimeout()
+	})
+}
+
+// ClearCloudflareConnectTimeout clears the value of the "cloudflare_connect_timeout" field.
+func (u *TunnelUpsertOne) ClearCloudflareConnectTimeout() *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.ClearCloudflareConnectTimeout()
+	})
+}
+
+// SetCloudflareNoTLSVerify sets the "cloudflare_no_tls_verify" field.
+func (u *TunnelUpsertOne) SetCloudflareNoTLSVerify(v bool) *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetCloudflareNoTLSVerify(v)
+	})
+}
+
+// UpdateCloudflareNoTLSVerify sets the "cloudflare_no_tls_verify" field to the value that was provided on create.
+func (u *TunnelUpsertOne) UpdateCloudflareNoTLSVerify() *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateCloudflareNoTLSVerify()
+	})
+}
+
+// SetCloudflareHTTPHostHeader sets the "cloudflare_http_host_header" field.
+func (u *TunnelUpsertOne) SetCloudflareHTTPHostHeader(v string) *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetCloudflareHTTPHostHeader(v)
+	})
+}
+
+// UpdateCloudflareHTTPHostHeader sets the "cloudflare_http_host_header" field to the value that was provided on create.
+func (u *TunnelUpsertOne) UpdateCloudflareHTTPHostHeader() *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateCloudflareHTTPHostHeader()
+	})
+}
+
+// ClearCloudflareHTTPHostHeader clears the value of the "cloudflare_http_host_header" field.
+func (u *TunnelUpsertOne) ClearCloudflareHTTPHostHeader() *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.ClearCloudflareHTTPHostHeader()
+	})
+}
+
+// SetCloudflareRegion sets the "cloudflare_region" field.
+func (u *TunnelUpsertOne) SetCloudflareRegion(v string) *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetCloudflareRegion(v)
+	})
+}
+
+// UpdateCloudflareRegion sets the "cloudflare_region" field to the value that was provided on create.
+func (u *TunnelUpsertOne) UpdateCloudflareRegion() *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateCloudflareRegion()
+	})
+}
+
+// ClearCloudflareRegion clears the value of the "cloudflare_region" field.
+func (u *TunnelUpsertOne) ClearCloudflareRegion() *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.ClearCloudflareRegion()
+	})
+}
+
+// SetCloudflareEnv sets the "cloudflare_env" field.
+func (u *TunnelUpsertOne) SetCloudflareEnv(v map[string]string) *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetCloudflareEnv(v)
+	})
+}
+
+// UpdateCloudflareEnv sets the "cloudflare_env" field to the value that was provided on create.
+func (u *TunnelUpsertOne) UpdateCloudflareEnv() *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateCloudflareEnv()
+	})
+}
+
+// ClearCloudflareEnv clears the value of the "cloudflare_env" field.
+func (u *TunnelUpsertOne) ClearCloudflareEnv() *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.ClearCloudflareEnv()
+	})
+}
+
+// SetCloudflareErrorPage sets the "cloudflare_error_page" field.
+func (u *TunnelUpsertOne) SetCloudflareErrorPage(v string) *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetCloudflareErrorPage(v)
+	})
+}
+
+// UpdateCloudflareErrorPage sets the "cloudflare_error_page" field to the value that was provided on create.
+func (u *TunnelUpsertOne) UpdateCloudflareErrorPage() *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateCloudflareErrorPage()
+	})
+}
+
+// ClearCloudflareErrorPage clears the value of the "cloudflare_error_page" field.
+func (u *TunnelUpsertOne) ClearCloudflareErrorPage() *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.ClearCloudflareErrorPage()
+	})
+}
+
+// SetErrorGrace sets the "error_grace" field.
+func (u *TunnelUpsertOne) SetErrorGrace(v string) *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetErrorGrace(v)
+	})
+}
+
+// UpdateErrorGrace sets the "error_grace" field to the value that was provided on create.
+func (u *TunnelUpsertOne) UpdateErrorGrace() *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateErrorGrace()
+	})
+}
+
+// ClearErrorGrace clears the value of the "error_grace" field.
+func (u *TunnelUpsertOne) ClearErrorGrace() *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.ClearErrorGrace()
+	})
+}
+
+// SetArchived sets the "archived" field.
+func (u *TunnelUpsertOne) SetArchived(v bool) *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetArchived(v)
+	})
+}
+
+// UpdateArchived sets the "archived" field to the value that was provided on create.
+func (u *TunnelUpsertOne) UpdateArchived() *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateArchived()
+	})
+}
+
+// SetPaused sets the "paused" field.
+func (u *TunnelUpsertOne) SetPaused(v bool) *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetPaused(v)
+	})
+}
+
+// UpdatePaused sets the "paused" field to the value that was provided on create.
+func (u *TunnelUpsertOne) UpdatePaused() *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdatePaused()
+	})
+}
+
+// SetProbeEnabled sets the "probe_enabled" field.
+func (u *TunnelUpsertOne) SetProbeEnabled(v bool) *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetProbeEnabled(v)
+	})
+}
+
+// UpdateProbeEnabled sets the "probe_enabled" field to the value that was provided on create.
+func (u *TunnelUpsertOne) UpdateProbeEnabled() *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateProbeEnabled()
+	})
+}
+
+// SetProbeInterval sets the "probe_interval" field.
+func (u *TunnelUpsertOne) SetProbeInterval(v string) *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetProbeInterval(v)
+	})
+}
+
+// UpdateProbeInterval sets the "probe_interval" field to the value that was provided on create.
+func (u *TunnelUpsertOne) UpdateProbeInterval() *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateProbeInterval()
+	})
+}
+
+// ClearProbeInterval clears the value of the "probe_interval" field.
+func (u *TunnelUpsertOne) ClearProbeInterval() *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.ClearProbeInterval()
+	})
+}
+
+// SetProbeTimeout sets the "probe_timeout" field.
+func (u *TunnelUpsertOne) SetProbeTimeout(v string) *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetProbeTimeout(v)
+	})
+}
+
+// UpdateProbeTimeout sets the "probe_timeout" field to the value that was provided on create.
+func (u *TunnelUpsertOne) UpdateProbeTimeout() *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateProbeTimeout()
+	})
+}
+
+// ClearProbeTimeout clears the value of the "probe_timeout" field.
+func (u *TunnelUpsertOne) ClearProbeTimeout() *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.ClearProbeTimeout()
+	})
+}
+
+// SetProbeRetries sets the "probe_retries" field.
+func (u *TunnelUpsertOne) SetProbeRetries(v int) *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetProbeRetries(v)
+	})
+}
+
+// AddProbeRetries adds v to the "probe_retries" field.
+func (u *TunnelUpsertOne) AddProbeRetries(v int) *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.AddProbeRetries(v)
+	})
+}
+
+// UpdateProbeRetries sets the "probe_retries" field to the value that was provided on create.
+func (u *TunnelUpsertOne) UpdateProbeRetries() *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateProbeRetries()
+	})
+}
+
+// SetProbePath sets the "probe_path" field.
+func (u *TunnelUpsertOne) SetProbePath(v string) *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetProbePath(v)
+	})
+}
+
+// UpdateProbePath sets the "probe_path" field to the value that was provided on create.
+func (u *TunnelUpsertOne) UpdateProbePath() *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateProbePath()
+	})
+}
+
+// ClearProbePath clears the value of the "probe_path" field.
+func (u *TunnelUpsertOne) ClearProbePath() *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.ClearProbePath()
+	})
+}
+
+// SetIdleTimeout sets the "idle_timeout" field.
+func (u *TunnelUpsertOne) SetIdleTimeout(v string) *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetIdleTimeout(v)
+	})
+}
+
+// UpdateIdleTimeout sets the "idle_timeout" field to the value that was provided on create.
+func (u *TunnelUpsertOne) UpdateIdleTimeout() *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateIdleTimeout()
+	})
+}
+
+// ClearIdleTimeout clears the value of the "idle_timeout" field.
+func (u *TunnelUpsertOne) ClearIdleTimeout() *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.ClearIdleTimeout()
+	})
+}
+
+// SetPreStartHook sets the "pre_start_hook" field.
+func (u *TunnelUpsertOne) SetPreStartHook(v string) *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetPreStartHook(v)
+	})
+}
+
+// UpdatePreStartHook sets the "pre_start_hook" field to the value that was provided on create.
+func (u *TunnelUpsertOne) UpdatePreStartHook() *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdatePreStartHook()
+	})
+}
+
+// ClearPreStartHook clears the value of the "pre_start_hook" field.
+func (u *TunnelUpsertOne) ClearPreStartHook() *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.ClearPreStartHook()
+	})
+}
+
+// SetPostStopHook sets the "post_stop_hook" field.
+func (u *TunnelUpsertOne) SetPostStopHook(v string) *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetPostStopHook(v)
+	})
+}
+
+// UpdatePostStopHook sets the "post_stop_hook" field to the value that was provided on create.
+func (u *TunnelUpsertOne) UpdatePostStopHook() *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdatePostStopHook()
+	})
+}
+
+// ClearPostStopHook clears the value of the "post_stop_hook" field.
+func (u *TunnelUpsertOne) ClearPostStopHook() *TunnelUpsertOne {
+	return u.Update(func(s *TunnelUpsert) {
+		s.ClearPostStopHook()
+	})
+}
+
+// Exec executes the query.
+func (u *TunnelUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TunnelCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TunnelUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TunnelUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: TunnelUpsertOne.ID is not supported by MySQL driver. Use TunnelUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TunnelUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TunnelCreateBulk is the builder for creating many Tunnel entities in bulk.
+type TunnelCreateBulk struct {
+	config
+	err      error
+	builders []*TunnelCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Tunnel entities in the database.
+func (_c *TunnelCreateBulk) Save(ctx context.Context) ([]*Tunnel, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Tunnel, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TunnelMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
 				nodes[i], specs[i] = builder.createSpec()
 				if i < len(mutators)-1 {
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -379,3 +2098,708 @@ func (_c *TunnelCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Tunnel.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TunnelUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TunnelCreateBulk) OnConflict(opts ...sql.ConflictOption) *TunnelUpsertBulk {
+	_c.conflict = opts
+	return &TunnelUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Tunnel.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TunnelCreateBulk) OnConflictColumns(columns ...string) *TunnelUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TunnelUpsertBulk{
+		create: _c,
+	}
+}
+
+// TunnelUpsertBulk is the builder for "upsert"-ing
+// a bulk of Tunnel nodes.
+type TunnelUpsertBulk struct {
+	create *TunnelCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Tunnel.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(tunnel.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TunnelUpsertBulk) UpdateNewValues() *TunnelUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(tunnel.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(tunnel.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Tunnel.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TunnelUpsertBulk) Ignore() *TunnelUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TunnelUpsertBulk) DoNothing() *TunnelUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TunnelCreateBulk.OnConflict
+// documentation for more info.
+func (u *TunnelUpsertBulk) Update(set func(*TunnelUpsert)) *TunnelUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TunnelUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *TunnelUpsertBulk) SetName(v string) *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TunnelUpsertBulk) UpdateName() *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *TunnelUpsertBulk) SetType(v tunnel.Type) *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *TunnelUpsertBulk) UpdateType() *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetTarget sets the "target" field.
+func (u *TunnelUpsertBulk) SetTarget(v string) *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetTarget(v)
+	})
+}
+
+// UpdateTarget sets the "target" field to the value that was provided on create.
+func (u *TunnelUpsertBulk) UpdateTarget() *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateTarget()
+	})
+}
+
+// SetGroup sets the "group" field.
+func (u *TunnelUpsertBulk) SetGroup(v string) *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetGroup(v)
+	})
+}
+
+// UpdateGroup sets the "group" field to the value that was provided on create.
+func (u *TunnelUpsertBulk) UpdateGroup() *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateGroup()
+	})
+}
+
+// ClearGroup clears the value of the "group" field.
+func (u *TunnelUpsertBulk) ClearGroup() *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.ClearGroup()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *TunnelUpsertBulk) SetEnabled(v bool) *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *TunnelUpsertBulk) UpdateEnabled() *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetMcpEnabled sets the "mcp_enabled" field.
+func (u *TunnelUpsertBulk) SetMcpEnabled(v bool) *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetMcpEnabled(v)
+	})
+}
+
+// UpdateMcpEnabled sets the "mcp_enabled" field to the value that was provided on create.
+func (u *TunnelUpsertBulk) UpdateMcpEnabled() *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateMcpEnabled()
+	})
+}
+
+// SetFavorite sets the "favorite" field.
+func (u *TunnelUpsertBulk) SetFavorite(v bool) *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetFavorite(v)
+	})
+}
+
+// UpdateFavorite sets the "favorite" field to the value that was provided on create.
+func (u *TunnelUpsertBulk) UpdateFavorite() *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateFavorite()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TunnelUpsertBulk) SetUpdatedAt(v time.Time) *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TunnelUpsertBulk) UpdateUpdatedAt() *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetNgrokAuthtoken sets the "ngrok_authtoken" field.
+func (u *TunnelUpsertBulk) SetNgrokAuthtoken(v string) *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetNgrokAuthtoken(v)
+	})
+}
+
+// UpdateNgrokAuthtoken sets the "ngrok_authtoken" field to the value that was provided on create.
+func (u *TunnelUpsertBulk) UpdateNgrokAuthtoken() *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateNgrokAuthtoken()
+	})
+}
+
+// ClearNgrokAuthtoken clears the value of the "ngrok_authtoken" field.
+func (u *TunnelUpsertBulk) ClearNgrokAuthtoken() *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.ClearNgrokAuthtoken()
+	})
+}
+
+// SetNgrokCredential sets the "ngrok_credential" field.
+func (u *TunnelUpsertBulk) SetNgrokCredential(v string) *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetNgrokCredential(v)
+	})
+}
+
+// UpdateNgrokCredential sets the "ngrok_credential" field to the value that was provided on create.
+func (u *TunnelUpsertBulk) UpdateNgrokCredential() *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateNgrokCredential()
+	})
+}
+
+// ClearNgrokCredential clears the value of the "ngrok_credential" field.
+func (u *TunnelUpsertBulk) ClearNgrokCredential() *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.ClearNgrokCredential()
+	})
+}
+
+// SetNgrokDomain sets the "ngrok_domain" field.
+func (u *TunnelUpsertBulk) SetNgrokDomain(v string) *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetNgrokDomain(v)
+	})
+}
+
+// UpdateNgrokDomain sets the "ngrok_domain" field to the value that was provided on create.
+func (u *TunnelUpsertBulk) UpdateNgrokDomain() *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateNgrokDomain()
+	})
+}
+
+// ClearNgrokDomain clears the value of the "ngrok_domain" field.
+func (u *TunnelUpsertBulk) ClearNgrokDomain() *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.ClearNgrokDomain()
+	})
+}
+
+// SetNgrokWebhookProvider sets the "ngrok_webhook_provider" field.
+func (u *TunnelUpsertBulk) SetNgrokWebhookProvider(v string) *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetNgrokWebhookProvider(v)
+	})
+}
+
+// UpdateNgrokWebhookProvider sets the "ngrok_webhook_provider" field to the value that was provided on create.
+func (u *TunnelUpsertBulk) UpdateNgrokWebhookProvider() *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateNgrokWebhookProvider()
+	})
+}
+
+// ClearNgrokWebhookProvider clears the value of the "ngrok_webhook_provider" field.
+func (u *TunnelUpsertBulk) ClearNgrokWebhookProvider() *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.ClearNgrokWebhookProvider()
+	})
+}
+
+// SetNgrokWebhookSecret sets the "ngrok_webhook_secret" field.
+func (u *TunnelUpsertBulk) SetNgrokWebhookSecret(v string) *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetNgrokWebhookSecret(v)
+	})
+}
+
+// UpdateNgrokWebhookSecret sets the "ngrok_webhook_secret" field to the value that was provided on create.
+func (u *TunnelUpsertBulk) UpdateNgrokWebhookSecret() *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateNgrokWebhookSecret()
+	})
+}
+
+// ClearNgrokWebhookSecret clears the value of the "ngrok_webhook_secret" field.
+func (u *TunnelUpsertBulk) ClearNgrokWebhookSecret() *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.ClearNgrokWebhookSecret()
+	})
+}
+
+// SetNgrokInternal sets the "ngrok_internal" field.
+func (u *TunnelUpsertBulk) SetNgrokInternal(v bool) *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetNgrokInternal(v)
+	})
+}
+
+// UpdateNgrokInternal sets the "ngrok_internal" field to the value that was provided on create.
+func (u *TunnelUpsertBulk) UpdateNgrokInternal() *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateNgrokInternal()
+	})
+}
+
+// SetNgrokRateLimit sets the "ngrok_rate_limit" field.
+func (u *TunnelUpsertBulk) SetNgrokRateLimit(v string) *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetNgrokRateLimit(v)
+	})
+}
+
+// UpdateNgrokRateLimit sets the "ngrok_rate_limit" field to the value that was provided on create.
+func (u *TunnelUpsertBulk) UpdateNgrokRateLimit() *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateNgrokRateLimit()
+	})
+}
+
+// ClearNgrokRateLimit clears the value of the "ngrok_rate_limit" field.
+func (u *TunnelUpsertBulk) ClearNgrokRateLimit() *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.ClearNgrokRateLimit()
+	})
+}
+
+// SetCloudflareConnectTimeout sets the "cloudflare_connect_timeout" field.
+func (u *TunnelUpsertBulk) SetCloudflareConnectTimeout(v string) *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetCloudflareConnectTimeout(v)
+	})
+}
+
+// UpdateCloudflareConnectTimeout sets the "cloudflare_connect_timeout" field to the value that was provided on create.
+func (u *TunnelUpsertBulk) UpdateCloudflareConnectTimeout() *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateCloudflareConnectTimeout()
+	})
+}
+
+// ClearCloudflareConnectTimeout clears the value of the "cloudflare_connect_timeout" field.
+func (u *TunnelUpsertBulk) ClearCloudflareConnectTimeout() *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.ClearCloudflareConnectTimeout()
+	})
+}
+
+// SetCloudflareNoTLSVerify sets the "cloudflare_no_tls_verify" field.
+func (u *TunnelUpsertBulk) SetCloudflareNoTLSVerify(v bool) *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetCloudflareNoTLSVerify(v)
+	})
+}
+
+// UpdateCloudflareNoTLSVerify sets the "cloudflare_no_tls_verify" field to the value that was provided on create.
+func (u *TunnelUpsertBulk) UpdateCloudflareNoTLSVerify() *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateCloudflareNoTLSVerify()
+	})
+}
+
+// SetCloudflareHTTPHostHeader sets the "cloudflare_http_host_header" field.
+func (u *TunnelUpsertBulk) SetCloudflareHTTPHostHeader(v string) *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetCloudflareHTTPHostHeader(v)
+	})
+}
+
+// UpdateCloudflareHTTPHostHeader sets the "cloudflare_http_host_header" field to the value that was provided on create.
+func (u *TunnelUpsertBulk) UpdateCloudflareHTTPHostHeader() *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateCloudflareHTTPHostHeader()
+	})
+}
+
+// ClearCloudflareHTTPHostHeader clears the value of the "cloudflare_http_host_header" field.
+func (u *TunnelUpsertBulk) ClearCloudflareHTTPHostHeader() *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.ClearCloudflareHTTPHostHeader()
+	})
+}
+
+// SetCloudflareRegion sets the "cloudflare_region" field.
+func (u *TunnelUpsertBulk) SetCloudflareRegion(v string) *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetCloudflareRegion(v)
+	})
+}
+
+// UpdateCloudflareRegion sets the "cloudflare_region" field to the value that was provided on create.
+func (u *TunnelUpsertBulk) UpdateCloudflareRegion() *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateCloudflareRegion()
+	})
+}
+
+// ClearCloudflareRegion clears the value of the "cloudflare_region" field.
+func (u *TunnelUpsertBulk) ClearCloudflareRegion() *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.ClearCloudflareRegion()
+	})
+}
+
+// SetCloudflareEnv sets the "cloudflare_env" field.
+func (u *TunnelUpsertBulk) SetCloudflareEnv(v map[string]string) *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetCloudflareEnv(v)
+	})
+}
+
+// UpdateCloudflareEnv sets the "cloudflare_env" field to the value that was provided on create.
+func (u *TunnelUpsertBulk) UpdateCloudflareEnv() *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateCloudflareEnv()
+	})
+}
+
+// ClearCloudflareEnv clears the value of the "cloudflare_env" field.
+func (u *TunnelUpsertBulk) ClearCloudflareEnv() *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.ClearCloudflareEnv()
+	})
+}
+
+// SetCloudflareErrorPage sets the "cloudflare_error_page" field.
+func (u *TunnelUpsertBulk) SetCloudflareErrorPage(v string) *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetCloudflareErrorPage(v)
+	})
+}
+
+// UpdateCloudflareErrorPage sets the "cloudflare_error_page" field to the value that was provided on create.
+func (u *TunnelUpsertBulk) UpdateCloudflareErrorPage() *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateCloudflareErrorPage()
+	})
+}
+
+// ClearCloudflareErrorPage clears the value of the "cloudflare_error_page" field.
+func (u *TunnelUpsertBulk) ClearCloudflareErrorPage() *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.ClearCloudflareErrorPage()
+	})
+}
+
+// SetErrorGrace sets the "error_grace" field.
+func (u *TunnelUpsertBulk) SetErrorGrace(v string) *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetErrorGrace(v)
+	})
+}
+
+// UpdateErrorGrace sets the "error_grace" field to the value that was provided on create.
+func (u *TunnelUpsertBulk) UpdateErrorGrace() *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateErrorGrace()
+	})
+}
+
+// ClearErrorGrace clears the value of the "error_grace" field.
+func (u *TunnelUpsertBulk) ClearErrorGrace() *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.ClearErrorGrace()
+	})
+}
+
+// SetArchived sets the "archived" field.
+func (u *TunnelUpsertBulk) SetArchived(v bool) *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetArchived(v)
+	})
+}
+
+// UpdateArchived sets the "archived" field to the value that was provided on create.
+func (u *TunnelUpsertBulk) UpdateArchived() *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateArchived()
+	})
+}
+
+// SetPaused sets the "paused" field.
+func (u *TunnelUpsertBulk) SetPaused(v bool) *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetPaused(v)
+	})
+}
+
+// UpdatePaused sets the "paused" field to the value that was provided on create.
+func (u *TunnelUpsertBulk) UpdatePaused() *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdatePaused()
+	})
+}
+
+// SetProbeEnabled sets the "probe_enabled" field.
+func (u *TunnelUpsertBulk) SetProbeEnabled(v bool) *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetProbeEnabled(v)
+	})
+}
+
+// UpdateProbeEnabled sets the "probe_enabled" field to the value that was provided on create.
+func (u *TunnelUpsertBulk) UpdateProbeEnabled() *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateProbeEnabled()
+	})
+}
+
+// SetProbeInterval sets the "probe_interval" field.
+func (u *TunnelUpsertBulk) SetProbeInterval(v string) *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetProbeInterval(v)
+	})
+}
+
+// UpdateProbeInterval sets the "probe_interval" field to the value that was provided on create.
+func (u *TunnelUpsertBulk) UpdateProbeInterval() *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateProbeInterval()
+	})
+}
+
+// ClearProbeInterval clears the value of the "probe_interval" field.
+func (u *TunnelUpsertBulk) ClearProbeInterval() *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.ClearProbeInterval()
+	})
+}
+
+// SetProbeTimeout sets the "probe_timeout" field.
+func (u *TunnelUpsertBulk) SetProbeTimeout(v string) *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetProbeTimeout(v)
+	})
+}
+
+// UpdateProbeTimeout sets the "probe_timeout" field to the value that was provided on create.
+func (u *TunnelUpsertBulk) UpdateProbeTimeout() *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateProbeTimeout()
+	})
+}
+
+// ClearProbeTimeout clears the value of the "probe_timeout" field.
+func (u *TunnelUpsertBulk) ClearProbeTimeout() *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.ClearProbeTimeout()
+	})
+}
+
+// SetProbeRetries sets the "probe_retries" field.
+func (u *TunnelUpsertBulk) SetProbeRetries(v int) *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetProbeRetries(v)
+	})
+}
+
+// AddProbeRetries adds v to the "probe_retries" field.
+func (u *TunnelUpsertBulk) AddProbeRetries(v int) *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.AddProbeRetries(v)
+	})
+}
+
+// UpdateProbeRetries sets the "probe_retries" field to the value that was provided on create.
+func (u *TunnelUpsertBulk) UpdateProbeRetries() *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateProbeRetries()
+	})
+}
+
+// SetProbePath sets the "probe_path" field.
+func (u *TunnelUpsertBulk) SetProbePath(v string) *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetProbePath(v)
+	})
+}
+
+// UpdateProbePath sets the "probe_path" field to the value that was provided on create.
+func (u *TunnelUpsertBulk) UpdateProbePath() *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateProbePath()
+	})
+}
+
+// ClearProbePath clears the value of the "probe_path" field.
+func (u *TunnelUpsertBulk) ClearProbePath() *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.ClearProbePath()
+	})
+}
+
+// SetIdleTimeout sets the "idle_timeout" field.
+func (u *TunnelUpsertBulk) SetIdleTimeout(v string) *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetIdleTimeout(v)
+	})
+}
+
+// UpdateIdleTimeout sets the "idle_timeout" field to the value that was provided on create.
+func (u *TunnelUpsertBulk) UpdateIdleTimeout() *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdateIdleTimeout()
+	})
+}
+
+// ClearIdleTimeout clears the value of the "idle_timeout" field.
+func (u *TunnelUpsertBulk) ClearIdleTimeout() *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.ClearIdleTimeout()
+	})
+}
+
+// SetPreStartHook sets the "pre_start_hook" field.
+func (u *TunnelUpsertBulk) SetPreStartHook(v string) *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetPreStartHook(v)
+	})
+}
+
+// UpdatePreStartHook sets the "pre_start_hook" field to the value that was provided on create.
+func (u *TunnelUpsertBulk) UpdatePreStartHook() *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdatePreStartHook()
+	})
+}
+
+// ClearPreStartHook clears the value of the "pre_start_hook" field.
+func (u *TunnelUpsertBulk) ClearPreStartHook() *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.ClearPreStartHook()
+	})
+}
+
+// SetPostStopHook sets the "post_stop_hook" field.
+func (u *TunnelUpsertBulk) SetPostStopHook(v string) *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.SetPostStopHook(v)
+	})
+}
+
+// UpdatePostStopHook sets the "post_stop_hook" field to the value that was provided on create.
+func (u *TunnelUpsertBulk) UpdatePostStopHook() *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.UpdatePostStopHook()
+	})
+}
+
+// ClearPostStopHook clears the value of the "post_stop_hook" field.
+func (u *TunnelUpsertBulk) ClearPostStopHook() *TunnelUpsertBulk {
+	return u.Update(func(s *TunnelUpsert) {
+		s.ClearPostStopHook()
+	})
+}
+
+// Exec executes the query.
+func (u *TunnelUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TunnelCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TunnelCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TunnelUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
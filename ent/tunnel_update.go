@@ -70,306 +70,1316 @@ func (_u *TunnelUpdate) SetNillableTarget(v *string) *TunnelUpdate {
 	return _u
 }
 
+// SetGroup sets the "group" field.
+func (_u *TunnelUpdate) SetGroup(v string) *TunnelUpdate {
+	_u.mutation.SetGroup(v)
+	return _u
+}
+
+// SetNillableGroup sets the "group" field if the given value is not nil.
+func (_u *TunnelUpdate) SetNillableGroup(v *string) *TunnelUpdate {
+	if v != nil {
+		_u.SetGroup(*v)
+	}
+	return _u
+}
+
+// ClearGroup clears the value of the "group" field.
+func (_u *TunnelUpdate) ClearGroup() *TunnelUpdate {
+	_u.mutation.ClearGroup()
+	return _u
+}
+
 // SetEnabled sets the "enabled" field.
 func (_u *TunnelUpdate) SetEnabled(v bool) *TunnelUpdate {
 	_u.mutation.SetEnabled(v)
 	return _u
 }
 
-// SetNillableEnabled sets the "enabled" field if the given value is not nil.
-func (_u *TunnelUpdate) SetNillableEnabled(v *bool) *TunnelUpdate {
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *TunnelUpdate) SetNillableEnabled(v *bool) *TunnelUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetMcpEnabled sets the "mcp_enabled" field.
+func (_u *TunnelUpdate) SetMcpEnabled(v bool) *TunnelUpdate {
+	_u.mutation.SetMcpEnabled(v)
+	return _u
+}
+
+// SetNillableMcpEnabled sets the "mcp_enabled" field if the given value is not nil.
+func (_u *TunnelUpdate) SetNillableMcpEnabled(v *bool) *TunnelUpdate {
+	if v != nil {
+		_u.SetMcpEnabled(*v)
+	}
+	return _u
+}
+
+// SetFavorite sets the "favorite" field.
+func (_u *TunnelUpdate) SetFavorite(v bool) *TunnelUpdate {
+	_u.mutation.SetFavorite(v)
+	return _u
+}
+
+// SetNillableFavorite sets the "favorite" field if the given value is not nil.
+func (_u *TunnelUpdate) SetNillableFavorite(v *bool) *TunnelUpdate {
+	if v != nil {
+		_u.SetFavorite(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TunnelUpdate) SetUpdatedAt(v time.Time) *TunnelUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNgrokAuthtoken sets the "ngrok_authtoken" field.
+func (_u *TunnelUpdate) SetNgrokAuthtoken(v string) *TunnelUpdate {
+	_u.mutation.SetNgrokAuthtoken(v)
+	return _u
+}
+
+// SetNillableNgrokAuthtoken sets the "ngrok_authtoken" field if the given value is not nil.
+func (_u *TunnelUpdate) SetNillableNgrokAuthtoken(v *string) *TunnelUpdate {
+	if v != nil {
+		_u.SetNgrokAuthtoken(*v)
+	}
+	return _u
+}
+
+// ClearNgrokAuthtoken clears the value of the "ngrok_authtoken" field.
+func (_u *TunnelUpdate) ClearNgrokAuthtoken() *TunnelUpdate {
+	_u.mutation.ClearNgrokAuthtoken()
+	return _u
+}
+
+// SetNgrokCredential sets the "ngrok_credential" field.
+func (_u *TunnelUpdate) SetNgrokCredential(v string) *TunnelUpdate {
+	_u.mutation.SetNgrokCredential(v)
+	return _u
+}
+
+// SetNillableNgrokCredential sets the "ngrok_credential" field if the given value is not nil.
+func (_u *TunnelUpdate) SetNillableNgrokCredential(v *string) *TunnelUpdate {
+	if v != nil {
+		_u.SetNgrokCredential(*v)
+	}
+	return _u
+}
+
+// ClearNgrokCredential clears the value of the "ngrok_credential" field.
+func (_u *TunnelUpdate) ClearNgrokCredential() *TunnelUpdate {
+	_u.mutation.ClearNgrokCredential()
+	return _u
+}
+
+// SetNgrokDomain sets the "ngrok_domain" field.
+func (_u *TunnelUpdate) SetNgrokDomain(v string) *TunnelUpdate {
+	_u.mutation.SetNgrokDomain(v)
+	return _u
+}
+
+// SetNillableNgrokDomain sets the "ngrok_domain" field if the given value is not nil.
+func (_u *TunnelUpdate) SetNillableNgrokDomain(v *string) *TunnelUpdate {
+	if v != nil {
+		_u.SetNgrokDomain(*v)
+	}
+	return _u
+}
+
+// ClearNgrokDomain clears the value of the "ngrok_domain" field.
+func (_u *TunnelUpdate) ClearNgrokDomain() *TunnelUpdate {
+	_u.mutation.ClearNgrokDomain()
+	return _u
+}
+
+// SetNgrokWebhookProvider sets the "ngrok_webhook_provider" field.
+func (_u *TunnelUpdate) SetNgrokWebhookProvider(v string) *TunnelUpdate {
+	_u.mutation.SetNgrokWebhookProvider(v)
+	return _u
+}
+
+// SetNillableNgrokWebhookProvider sets the "ngrok_webhook_provider" field if the given value is not nil.
+func (_u *TunnelUpdate) SetNillableNgrokWebhookProvider(v *string) *TunnelUpdate {
+	if v != nil {
+		_u.SetNgrokWebhookProvider(*v)
+	}
+	return _u
+}
+
+// ClearNgrokWebhookProvider clears the value of the "ngrok_webhook_provider" field.
+func (_u *TunnelUpdate) ClearNgrokWebhookProvider() *TunnelUpdate {
+	_u.mutation.ClearNgrokWebhookProvider()
+	return _u
+}
+
+// SetNgrokWebhookSecret sets the "ngrok_webhook_secret" field.
+func (_u *TunnelUpdate) SetNgrokWebhookSecret(v string) *TunnelUpdate {
+	_u.mutation.SetNgrokWebhookSecret(v)
+	return _u
+}
+
+// SetNillableNgrokWebhookSecret sets the "ngrok_webhook_secret" field if the given value is not nil.
+func (_u *TunnelUpdate) SetNillableNgrokWebhookSecret(v *string) *TunnelUpdate {
+	if v != nil {
+		_u.SetNgrokWebhookSecret(*v)
+	}
+	return _u
+}
+
+// ClearNgrokWebhookSecret clears the value of the "ngrok_webhook_secret" field.
+func (_u *TunnelUpdate) ClearNgrokWebhookSecret() *TunnelUpdate {
+	_u.mutation.ClearNgrokWebhookSecret()
+	return _u
+}
+
+// SetNgrokInternal sets the "ngrok_internal" field.
+func (_u *TunnelUpdate) SetNgrokInternal(v bool) *TunnelUpdate {
+	_u.mutation.SetNgrokInternal(v)
+	return _u
+}
+
+// SetNillableNgrokInternal sets the "ngrok_internal" field if the given value is not nil.
+func (_u *TunnelUpdate) SetNillableNgrokInternal(v *bool) *TunnelUpdate {
+	if v != nil {
+		_u.SetNgrokInternal(*v)
+	}
+	return _u
+}
+
+// SetNgrokRateLimit sets the "ngrok_rate_limit" field.
+func (_u *TunnelUpdate) SetNgrokRateLimit(v string) *TunnelUpdate {
+	_u.mutation.SetNgrokRateLimit(v)
+	return _u
+}
+
+// SetNillableNgrokRateLimit sets the "ngrok_rate_limit" field if the given value is not nil.
+func (_u *TunnelUpdate) SetNillableNgrokRateLimit(v *string) *TunnelUpdate {
+	if v != nil {
+		_u.SetNgrokRateLimit(*v)
+	}
+	return _u
+}
+
+// ClearNgrokRateLimit clears the value of the "ngrok_rate_limit" field.
+func (_u *TunnelUpdate) ClearNgrokRateLimit() *TunnelUpdate {
+	_u.mutation.ClearNgrokRateLimit()
+	return _u
+}
+
+// SetCloudflareConnectTimeout sets the "cloudflare_connect_timeout" field.
+func (_u *TunnelUpdate) SetCloudflareConnectTimeout(v string) *TunnelUpdate {
+	_u.mutation.SetCloudflareConnectTimeout(v)
+	return _u
+}
+
+// SetNillableCloudflareConnectTimeout sets the "cloudflare_connect_timeout" field if the given value is not nil.
+func (_u *TunnelUpdate) SetNillableCloudflareConnectTimeout(v *string) *TunnelUpdate {
+	if v != nil {
+		_u.SetCloudflareConnectTimeout(*v)
+	}
+	return _u
+}
+
+// ClearCloudflareConnectTimeout clears the value of the "cloudflare_connect_timeout" field.
+func (_u *TunnelUpdate) ClearCloudflareConnectTimeout() *TunnelUpdate {
+	_u.mutation.ClearCloudflareConnectTimeout()
+	return _u
+}
+
+// SetCloudflareNoTLSVerify sets the "cloudflare_no_tls_verify" field.
+func (_u *TunnelUpdate) SetCloudflareNoTLSVerify(v bool) *TunnelUpdate {
+	_u.mutation.SetCloudflareNoTLSVerify(v)
+	return _u
+}
+
+// SetNillableCloudflareNoTLSVerify sets the "cloudflare_no_tls_verify" field if the given value is not nil.
+func (_u *TunnelUpdate) SetNillableCloudflareNoTLSVerify(v *bool) *TunnelUpdate {
+	if v != nil {
+		_u.SetCloudflareNoTLSVerify(*v)
+	}
+	return _u
+}
+
+// SetCloudflareHTTPHostHeader sets the "cloudflare_http_host_header" field.
+func (_u *TunnelUpdate) SetCloudflareHTTPHostHeader(v string) *TunnelUpdate {
+	_u.mutation.SetCloudflareHTTPHostHeader(v)
+	return _u
+}
+
+// SetNillableCloudflareHTTPHostHeader sets the "cloudflare_http_host_header" field if the given value is not nil.
+func (_u *TunnelUpdate) SetNillableCloudflareHTTPHostHeader(v *string) *TunnelUpdate {
+	if v != nil {
+		_u.SetCloudflareHTTPHostHeader(*v)
+	}
+	return _u
+}
+
+// ClearCloudflareHTTPHostHeader clears the value of the "cloudflare_http_host_header" field.
+func (_u *TunnelUpdate) ClearCloudflareHTTPHostHeader() *TunnelUpdate {
+	_u.mutation.ClearCloudflareHTTPHostHeader()
+	return _u
+}
+
+// SetCloudflareRegion sets the "cloudflare_region" field.
+func (_u *TunnelUpdate) SetCloudflareRegion(v string) *TunnelUpdate {
+	_u.mutation.SetCloudflareRegion(v)
+	return _u
+}
+
+// SetNillableCloudflareRegion sets the "cloudflare_region" field if the given value is not nil.
+func (_u *TunnelUpdate) SetNillableCloudflareRegion(v *string) *TunnelUpdate {
+	if v != nil {
+		_u.SetCloudflareRegion(*v)
+	}
+	return _u
+}
+
+// ClearCloudflareRegion clears the value of the "cloudflare_region" field.
+func (_u *TunnelUpdate) ClearCloudflareRegion() *TunnelUpdate {
+	_u.mutation.ClearCloudflareRegion()
+	return _u
+}
+
+// SetCloudflareEnv sets the "cloudflare_env" field.
+func (_u *TunnelUpdate) SetCloudflareEnv(v map[string]string) *TunnelUpdate {
+	_u.mutation.SetCloudflareEnv(v)
+	return _u
+}
+
+// ClearCloudflareEnv clears the value of the "cloudflare_env" field.
+func (_u *TunnelUpdate) ClearCloudflareEnv() *TunnelUpdate {
+	_u.mutation.ClearCloudflareEnv()
+	return _u
+}
+
+// SetCloudflareErrorPage sets the "cloudflare_error_page" field.
+func (_u *TunnelUpdate) SetCloudflareErrorPage(v string) *TunnelUpdate {
+	_u.mutation.SetCloudflareErrorPage(v)
+	return _u
+}
+
+// SetNillableCloudflareErrorPage sets the "cloudflare_error_page" field if the given value is not nil.
+func (_u *TunnelUpdate) SetNillableCloudflareErrorPage(v *string) *TunnelUpdate {
+	if v != nil {
+		_u.SetCloudflareErrorPage(*v)
+	}
+	return _u
+}
+
+// ClearCloudflareErrorPage clears the value of the "cloudflare_error_page" field.
+func (_u *TunnelUpdate) ClearCloudflareErrorPage() *TunnelUpdate {
+	_u.mutation.ClearCloudflareErrorPage()
+	return _u
+}
+
+// SetErrorGrace sets the "error_grace" field.
+func (_u *TunnelUpdate) SetErrorGrace(v string) *TunnelUpdate {
+	_u.mutation.SetErrorGrace(v)
+	return _u
+}
+
+// SetNillableErrorGrace sets the "error_grace" field if the given value is not nil.
+func (_u *TunnelUpdate) SetNillableErrorGrace(v *string) *TunnelUpdate {
+	if v != nil {
+		_u.SetErrorGrace(*v)
+	}
+	return _u
+}
+
+// ClearErrorGrace clears the value of the "error_grace" field.
+func (_u *TunnelUpdate) ClearErrorGrace() *TunnelUpdate {
+	_u.mutation.ClearErrorGrace()
+	return _u
+}
+
+// SetArchived sets the "archived" field.
+func (_u *TunnelUpdate) SetArchived(v bool) *TunnelUpdate {
+	_u.mutation.SetArchived(v)
+	return _u
+}
+
+// SetNillableArchived sets the "archived" field if the given value is not nil.
+func (_u *TunnelUpdate) SetNillableArchived(v *bool) *TunnelUpdate {
+	if v != nil {
+		_u.SetArchived(*v)
+	}
+	return _u
+}
+
+// SetPaused sets the "paused" field.
+func (_u *TunnelUpdate) SetPaused(v bool) *TunnelUpdate {
+	_u.mutation.SetPaused(v)
+	return _u
+}
+
+// SetNillablePaused sets the "paused" field if the given value is not nil.
+func (_u *TunnelUpdate) SetNillablePaused(v *bool) *TunnelUpdate {
+	if v != nil {
+		_u.SetPaused(*v)
+	}
+	return _u
+}
+
+// SetProbeEnabled sets the "probe_enabled" field.
+func (_u *TunnelUpdate) SetProbeEnabled(v bool) *TunnelUpdate {
+	_u.mutation.SetProbeEnabled(v)
+	return _u
+}
+
+// SetNillableProbeEnabled sets the "probe_enabled" field if the given value is not nil.
+func (_u *TunnelUpdate) SetNillableProbeEnabled(v *bool) *TunnelUpdate {
+	if v != nil {
+		_u.SetProbeEnabled(*v)
+	}
+	return _u
+}
+
+// SetProbeInterval sets the "probe_interval" field.
+func (_u *TunnelUpdate) SetProbeInterval(v string) *TunnelUpdate {
+	_u.mutation.SetProbeInterval(v)
+	return _u
+}
+
+// SetNillableProbeInterval sets the "probe_interval" field if the given value is not nil.
+func (_u *TunnelUpdate) SetNillableProbeInterval(v *string) *TunnelUpdate {
+	if v != nil {
+		_u.SetProbeInterval(*v)
+	}
+	return _u
+}
+
+// ClearProbeInterval clears the value of the "probe_interval" field.
+func (_u *TunnelUpdate) ClearProbeInterval() *TunnelUpdate {
+	_u.mutation.ClearProbeInterval()
+	return _u
+}
+
+// SetProbeTimeout sets the "probe_timeout" field.
+func (_u *TunnelUpdate) SetProbeTimeout(v string) *TunnelUpdate {
+	_u.mutation.SetProbeTimeout(v)
+	return _u
+}
+
+// SetNillableProbeTimeout sets the "probe_timeout" field if the given value is not nil.
+func (_u *TunnelUpdate) SetNillableProbeTimeout(v *string) *TunnelUpdate {
+	if v != nil {
+		_u.SetProbeTimeout(*v)
+	}
+	return _u
+}
+
+// ClearProbeTimeout clears the value of the "probe_timeout" field.
+func (_u *TunnelUpdate) ClearProbeTimeout() *TunnelUpdate {
+	_u.mutation.ClearProbeTimeout()
+	return _u
+}
+
+// SetProbeRetries sets the "probe_retries" field.
+func (_u *TunnelUpdate) SetProbeRetries(v int) *TunnelUpdate {
+	_u.mutation.ResetProbeRetries()
+	_u.mutation.SetProbeRetries(v)
+	return _u
+}
+
+// SetNillableProbeRetries sets the "probe_retries" field if the given value is not nil.
+func (_u *TunnelUpdate) SetNillableProbeRetries(v *int) *TunnelUpdate {
+	if v != nil {
+		_u.SetProbeRetries(*v)
+	}
+	return _u
+}
+
+// AddProbeRetries adds value to the "probe_retries" field.
+func (_u *TunnelUpdate) AddProbeRetries(v int) *TunnelUpdate {
+	_u.mutation.AddProbeRetries(v)
+	return _u
+}
+
+// SetProbePath sets the "probe_path" field.
+func (_u *TunnelUpdate) SetProbePath(v string) *TunnelUpdate {
+	_u.mutation.SetProbePath(v)
+	return _u
+}
+
+// SetNillableProbePath sets the "probe_path" field if the given value is not nil.
+func (_u *TunnelUpdate) SetNillableProbePath(v *string) *TunnelUpdate {
+	if v != nil {
+		_u.SetProbePath(*v)
+	}
+	return _u
+}
+
+// ClearProbePath clears the value of the "probe_path" field.
+func (_u *TunnelUpdate) ClearProbePath() *TunnelUpdate {
+	_u.mutation.ClearProbePath()
+	return _u
+}
+
+// SetIdleTimeout sets the "idle_timeout" field.
+func (_u *TunnelUpdate) SetIdleTimeout(v string) *TunnelUpdate {
+	_u.mutation.SetIdleTimeout(v)
+	return _u
+}
+
+// SetNillableIdleTimeout sets the "idle_timeout" field if the given value is not nil.
+func (_u *TunnelUpdate) SetNillableIdleTimeout(v *string) *TunnelUpdate {
+	if v != nil {
+		_u.SetIdleTimeout(*v)
+	}
+	return _u
+}
+
+// ClearIdleTimeout clears the value of the "idle_timeout" field.
+func (_u *TunnelUpdate) ClearIdleTimeout() *TunnelUpdate {
+	_u.mutation.ClearIdleTimeout()
+	return _u
+}
+
+// SetPreStartHook sets the "pre_start_hook" field.
+func (_u *TunnelUpdate) SetPreStartHook(v string) *TunnelUpdate {
+	_u.mutation.SetPreStartHook(v)
+	return _u
+}
+
+// SetNillablePreStartHook sets the "pre_start_hook" field if the given value is not nil.
+func (_u *TunnelUpdate) SetNillablePreStartHook(v *string) *TunnelUpdate {
+	if v != nil {
+		_u.SetPreStartHook(*v)
+	}
+	return _u
+}
+
+// ClearPreStartHook clears the value of the "pre_start_hook" field.
+func (_u *TunnelUpdate) ClearPreStartHook() *TunnelUpdate {
+	_u.mutation.ClearPreStartHook()
+	return _u
+}
+
+// SetPostStopHook sets the "post_stop_hook" field.
+func (_u *TunnelUpdate) SetPostStopHook(v string) *TunnelUpdate {
+	_u.mutation.SetPostStopHook(v)
+	return _u
+}
+
+// SetNillablePostStopHook sets the "post_stop_hook" field if the given value is not nil.
+func (_u *TunnelUpdate) SetNillablePostStopHook(v *string) *TunnelUpdate {
+	if v != nil {
+		_u.SetPostStopHook(*v)
+	}
+	return _u
+}
+
+// ClearPostStopHook clears the value of the "post_stop_hook" field.
+func (_u *TunnelUpdate) ClearPostStopHook() *TunnelUpdate {
+	_u.mutation.ClearPostStopHook()
+	return _u
+}
+
+// Mutation returns the TunnelMutation object of the builder.
+func (_u *TunnelUpdate) Mutation() *TunnelMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TunnelUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TunnelUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TunnelUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TunnelUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TunnelUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := tunnel.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TunnelUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := tunnel.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Tunnel.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GetType(); ok {
+		if err := tunnel.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Tunnel.type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Target(); ok {
+		if err := tunnel.TargetValidator(v); err != nil {
+			return &ValidationError{Name: "target", err: fmt.Errorf(`ent: validator failed for field "Tunnel.target": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *TunnelUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tunnel.Table, tunnel.Columns, sqlgraph.NewFieldSpec(tunnel.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(tunnel.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(tunnel.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Target(); ok {
+		_spec.SetField(tunnel.FieldTarget, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Group(); ok {
+		_spec.SetField(tunnel.FieldGroup, field.TypeString, value)
+	}
+	if _u.mutation.GroupCleared() {
+		_spec.ClearField(tunnel.FieldGroup, field.TypeString)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(tunnel.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.McpEnabled(); ok {
+		_spec.SetField(tunnel.FieldMcpEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Favorite(); ok {
+		_spec.SetField(tunnel.FieldFavorite, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(tunnel.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.NgrokAuthtoken(); ok {
+		_spec.SetField(tunnel.FieldNgrokAuthtoken, field.TypeString, value)
+	}
+	if _u.mutation.NgrokAuthtokenCleared() {
+		_spec.ClearField(tunnel.FieldNgrokAuthtoken, field.TypeString)
+	}
+	if value, ok := _u.mutation.NgrokCredential(); ok {
+		_spec.SetField(tunnel.FieldNgrokCredential, field.TypeString, value)
+	}
+	if _u.mutation.NgrokCredentialCleared() {
+		_spec.ClearField(tunnel.FieldNgrokCredential, field.TypeString)
+	}
+	if value, ok := _u.mutation.NgrokDomain(); ok {
+		_spec.SetField(tunnel.FieldNgrokDomain, field.TypeString, value)
+	}
+	if _u.mutation.NgrokDomainCleared() {
+		_spec.ClearField(tunnel.FieldNgrokDomain, field.TypeString)
+	}
+	if value, ok := _u.mutation.NgrokWebhookProvider(); ok {
+		_spec.SetField(tunnel.FieldNgrokWebhookProvider, field.TypeString, value)
+	}
+	if _u.mutation.NgrokWebhookProviderCleared() {
+		_spec.ClearField(tunnel.FieldNgrokWebhookProvider, field.TypeString)
+	}
+	if value, ok := _u.mutation.NgrokWebhookSecret(); ok {
+		_spec.SetField(tunnel.FieldNgrokWebhookSecret, field.TypeString, value)
+	}
+	if _u.mutation.NgrokWebhookSecretCleared() {
+		_spec.ClearField(tunnel.FieldNgrokWebhookSecret, field.TypeString)
+	}
+	if value, ok := _u.mutation.NgrokInternal(); ok {
+		_spec.SetField(tunnel.FieldNgrokInternal, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.NgrokRateLimit(); ok {
+		_spec.SetField(tunnel.FieldNgrokRateLimit, field.TypeString, value)
+	}
+	if _u.mutation.NgrokRateLimitCleared() {
+		_spec.ClearField(tunnel.FieldNgrokRateLimit, field.TypeString)
+	}
+	if value, ok := _u.mutation.CloudflareConnectTimeout(); ok {
+		_spec.SetField(tunnel.FieldCloudflareConnectTimeout, field.TypeString, value)
+	}
+	if _u.mutation.CloudflareConnectTimeoutCleared() {
+		_spec.ClearField(tunnel.FieldCloudflareConnectTimeout, field.TypeString)
+	}
+	if value, ok := _u.mutation.CloudflareNoTLSVerify(); ok {
+		_spec.SetField(tunnel.FieldCloudflareNoTLSVerify, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CloudflareHTTPHostHeader(); ok {
+		_spec.SetField(tunnel.FieldCloudflareHTTPHostHeader, field.TypeString, value)
+	}
+	if _u.mutation.CloudflareHTTPHostHeaderCleared() {
+		_spec.ClearField(tunnel.FieldCloudflareHTTPHostHeader, field.TypeString)
+	}
+	if value, ok := _u.mutation.CloudflareRegion(); ok {
+		_spec.SetField(tunnel.FieldCloudflareRegion, field.TypeString, value)
+	}
+	if _u.mutation.CloudflareRegionCleared() {
+		_spec.ClearField(tunnel.FieldCloudflareRegion, field.TypeString)
+	}
+	if value, ok := _u.mutation.CloudflareEnv(); ok {
+		_spec.SetField(tunnel.FieldCloudflareEnv, field.TypeJSON, value)
+	}
+	if _u.mutation.CloudflareEnvCleared() {
+		_spec.ClearField(tunnel.FieldCloudflareEnv, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CloudflareErrorPage(); ok {
+		_spec.SetField(tunnel.FieldCloudflareErrorPage, field.TypeString, value)
+	}
+	if _u.mutation.CloudflareErrorPageCleared() {
+		_spec.ClearField(tunnel.FieldCloudflareErrorPage, field.TypeString)
+	}
+	if value, ok := _u.mutation.ErrorGrace(); ok {
+		_spec.SetField(tunnel.FieldErrorGrace, field.TypeString, value)
+	}
+	if _u.mutation.ErrorGraceCleared() {
+		_spec.ClearField(tunnel.FieldErrorGrace, field.TypeString)
+	}
+	if value, ok := _u.mutation.Archived(); ok {
+		_spec.SetField(tunnel.FieldArchived, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Paused(); ok {
+		_spec.SetField(tunnel.FieldPaused, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ProbeEnabled(); ok {
+		_spec.SetField(tunnel.FieldProbeEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ProbeInterval(); ok {
+		_spec.SetField(tunnel.FieldProbeInterval, field.TypeString, value)
+	}
+	if _u.mutation.ProbeIntervalCleared() {
+		_spec.ClearField(tunnel.FieldProbeInterval, field.TypeString)
+	}
+	if value, ok := _u.mutation.ProbeTimeout(); ok {
+		_spec.SetField(tunnel.FieldProbeTimeout, field.TypeString, value)
+	}
+	if _u.mutation.ProbeTimeoutCleared() {
+		_spec.ClearField(tunnel.FieldProbeTimeout, field.TypeString)
+	}
+	if value, ok := _u.mutation.ProbeRetries(); ok {
+		_spec.SetField(tunnel.FieldProbeRetries, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedProbeRetries(); ok {
+		_spec.AddField(tunnel.FieldProbeRetries, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ProbePath(); ok {
+		_spec.SetField(tunnel.FieldProbePath, field.TypeString, value)
+	}
+	if _u.mutation.ProbePathCleared() {
+		_spec.ClearField(tunnel.FieldProbePath, field.TypeString)
+	}
+	if value, ok := _u.mutation.IdleTimeout(); ok {
+		_spec.SetField(tunnel.FieldIdleTimeout, field.TypeString, value)
+	}
+	if _u.mutation.IdleTimeoutCleared() {
+		_spec.ClearField(tunnel.FieldIdleTimeout, field.TypeString)
+	}
+	if value, ok := _u.mutation.PreStartHook(); ok {
+		_spec.SetField(tunnel.FieldPreStartHook, field.TypeString, value)
+	}
+	if _u.mutation.PreStartHookCleared() {
+		_spec.ClearField(tunnel.FieldPreStartHook, field.TypeString)
+	}
+	if value, ok := _u.mutation.PostStopHook(); ok {
+		_spec.SetField(tunnel.FieldPostStopHook, field.TypeString, value)
+	}
+	if _u.mutation.PostStopHookCleared() {
+		_spec.ClearField(tunnel.FieldPostStopHook, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tunnel.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TunnelUpdateOne is the builder for updating a single Tunnel entity.
+type TunnelUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TunnelMutation
+}
+
+// SetName sets the "name" field.
+func (_u *TunnelUpdateOne) SetName(v string) *TunnelUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *TunnelUpdateOne) SetNillableName(v *string) *TunnelUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *TunnelUpdateOne) SetType(v tunnel.Type) *TunnelUpdateOne {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *TunnelUpdateOne) SetNillableType(v *tunnel.Type) *TunnelUpdateOne {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetTarget sets the "target" field.
+func (_u *TunnelUpdateOne) SetTarget(v string) *TunnelUpdateOne {
+	_u.mutation.SetTarget(v)
+	return _u
+}
+
+// SetNillableTarget sets the "target" field if the given value is not nil.
+func (_u *TunnelUpdateOne) SetNillableTarget(v *string) *TunnelUpdateOne {
+	if v != nil {
+		_u.SetTarget(*v)
+	}
+	return _u
+}
+
+// SetGroup sets the "group" field.
+func (_u *TunnelUpdateOne) SetGroup(v string) *TunnelUpdateOne {
+	_u.mutation.SetGroup(v)
+	return _u
+}
+
+// SetNillableGroup sets the "group" field if the given value is not nil.
+func (_u *TunnelUpdateOne) SetNillableGroup(v *string) *TunnelUpdateOne {
+	if v != nil {
+		_u.SetGroup(*v)
+	}
+	return _u
+}
+
+// ClearGroup clears the value of the "group" field.
+func (_u *TunnelUpdateOne) ClearGroup() *TunnelUpdateOne {
+	_u.mutation.ClearGroup()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *TunnelUpdateOne) SetEnabled(v bool) *TunnelUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *TunnelUpdateOne) SetNillableEnabled(v *bool) *TunnelUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetMcpEnabled sets the "mcp_enabled" field.
+func (_u *TunnelUpdateOne) SetMcpEnabled(v bool) *TunnelUpdateOne {
+	_u.mutation.SetMcpEnabled(v)
+	return _u
+}
+
+// SetNillableMcpEnabled sets the "mcp_enabled" field if the given value is not nil.
+func (_u *TunnelUpdateOne) SetNillableMcpEnabled(v *bool) *TunnelUpdateOne {
+	if v != nil {
+		_u.SetMcpEnabled(*v)
+	}
+	return _u
+}
+
+// SetFavorite sets the "favorite" field.
+func (_u *TunnelUpdateOne) SetFavorite(v bool) *TunnelUpdateOne {
+	_u.mutation.SetFavorite(v)
+	return _u
+}
+
+// SetNillableFavorite sets the "favorite" field if the given value is not nil.
+func (_u *TunnelUpdateOne) SetNillableFavorite(v *bool) *TunnelUpdateOne {
+	if v != nil {
+		_u.SetFavorite(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TunnelUpdateOne) SetUpdatedAt(v time.Time) *TunnelUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNgrokAuthtoken sets the "ngrok_authtoken" field.
+func (_u *TunnelUpdateOne) SetNgrokAuthtoken(v string) *TunnelUpdateOne {
+	_u.mutation.SetNgrokAuthtoken(v)
+	return _u
+}
+
+// SetNillableNgrokAuthtoken sets the "ngrok_authtoken" field if the given value is not nil.
+func (_u *TunnelUpdateOne) SetNillableNgrokAuthtoken(v *string) *TunnelUpdateOne {
+	if v != nil {
+		_u.SetNgrokAuthtoken(*v)
+	}
+	return _u
+}
+
+// ClearNgrokAuthtoken clears the value of the "ngrok_authtoken" field.
+func (_u *TunnelUpdateOne) ClearNgrokAuthtoken() *TunnelUpdateOne {
+	_u.mutation.ClearNgrokAuthtoken()
+	return _u
+}
+
+// SetNgrokCredential sets the "ngrok_credential" field.
+func (_u *TunnelUpdateOne) SetNgrokCredential(v string) *TunnelUpdateOne {
+	_u.mutation.SetNgrokCredential(v)
+	return _u
+}
+
+// SetNillableNgrokCredential sets the "ngrok_credential" field if the given value is not nil.
+func (_u *TunnelUpdateOne) SetNillableNgrokCredential(v *string) *TunnelUpdateOne {
+	if v != nil {
+		_u.SetNgrokCredential(*v)
+	}
+	return _u
+}
+
+// ClearNgrokCredential clears the value of the "ngrok_credential" field.
+func (_u *TunnelUpdateOne) ClearNgrokCredential() *TunnelUpdateOne {
+	_u.mutation.ClearNgrokCredential()
+	return _u
+}
+
+// SetNgrokDomain sets the "ngrok_domain" field.
+func (_u *TunnelUpdateOne) SetNgrokDomain(v string) *TunnelUpdateOne {
+	_u.mutation.SetNgrokDomain(v)
+	return _u
+}
+
+// SetNillableNgrokDomain sets the "ngrok_domain" field if the given value is not nil.
+func (_u *TunnelUpdateOne) SetNillableNgrokDomain(v *string) *TunnelUpdateOne {
+	if v != nil {
+		_u.SetNgrokDomain(*v)
+	}
+	return _u
+}
+
+// ClearNgrokDomain clears the value of the "ngrok_domain" field.
+func (_u *TunnelUpdateOne) ClearNgrokDomain() *TunnelUpdateOne {
+	_u.mutation.ClearNgrokDomain()
+	return _u
+}
+
+// SetNgrokWebhookProvider sets the "ngrok_webhook_provider" field.
+func (_u *TunnelUpdateOne) SetNgrokWebhookProvider(v string) *TunnelUpdateOne {
+	_u.mutation.SetNgrokWebhookProvider(v)
+	return _u
+}
+
+// SetNillableNgrokWebhookProvider sets the "ngrok_webhook_provider" field if the given value is not nil.
+func (_u *TunnelUpdateOne) SetNillableNgrokWebhookProvider(v *string) *TunnelUpdateOne {
+	if v != nil {
+		_u.SetNgrokWebhookProvider(*v)
+	}
+	return _u
+}
+
+// ClearNgrokWebhookProvider clears the value of the "ngrok_webhook_provider" field.
+func (_u *TunnelUpdateOne) ClearNgrokWebhookProvider() *TunnelUpdateOne {
+	_u.mutation.ClearNgrokWebhookProvider()
+	return _u
+}
+
+// SetNgrokWebhookSecret sets the "ngrok_webhook_secret" field.
+func (_u *TunnelUpdateOne) SetNgrokWebhookSecret(v string) *TunnelUpdateOne {
+	_u.mutation.SetNgrokWebhookSecret(v)
+	return _u
+}
+
+// SetNillableNgrokWebhookSecret sets the "ngrok_webhook_secret" field if the given value is not nil.
+func (_u *TunnelUpdateOne) SetNillableNgrokWebhookSecret(v *string) *TunnelUpdateOne {
+	if v != nil {
+		_u.SetNgrokWebhookSecret(*v)
+	}
+	return _u
+}
+
+// ClearNgrokWebhookSecret clears the value of the "ngrok_webhook_secret" field.
+func (_u *TunnelUpdateOne) ClearNgrokWebhookSecret() *TunnelUpdateOne {
+	_u.mutation.ClearNgrokWebhookSecret()
+	return _u
+}
+
+// SetNgrokInternal sets the "ngrok_internal" field.
+func (_u *TunnelUpdateOne) SetNgrokInternal(v bool) *TunnelUpdateOne {
+	_u.mutation.SetNgrokInternal(v)
+	return _u
+}
+
+// SetNillableNgrokInternal sets the "ngrok_internal" field if the given value is not nil.
+func (_u *TunnelUpdateOne) SetNillableNgrokInternal(v *bool) *TunnelUpdateOne {
+	if v != nil {
+		_u.SetNgrokInternal(*v)
+	}
+	return _u
+}
+
+// SetNgrokRateLimit sets the "ngrok_rate_limit" field.
+func (_u *TunnelUpdateOne) SetNgrokRateLimit(v string) *TunnelUpdateOne {
+	_u.mutation.SetNgrokRateLimit(v)
+	return _u
+}
+
+// SetNillableNgrokRateLimit sets the "ngrok_rate_limit" field if the given value is not nil.
+func (_u *TunnelUpdateOne) SetNillableNgrokRateLimit(v *string) *TunnelUpdateOne {
+	if v != nil {
+		_u.SetNgrokRateLimit(*v)
+	}
+	return _u
+}
+
+// ClearNgrokRateLimit clears the value of the "ngrok_rate_limit" field.
+func (_u *TunnelUpdateOne) ClearNgrokRateLimit() *TunnelUpdateOne {
+	_u.mutation.ClearNgrokRateLimit()
+	return _u
+}
+
+// SetCloudflareConnectTimeout sets the "cloudflare_connect_timeout" field.
+func (_u *TunnelUpdateOne) SetCloudflareConnectTimeout(v string) *TunnelUpdateOne {
+	_u.mutation.SetCloudflareConnectTimeout(v)
+	return _u
+}
+
+// SetNillableCloudflareConnectTimeout sets the "cloudflare_connect_timeout" field if the given value is not nil.
+func (_u *TunnelUpdateOne) SetNillableCloudflareConnectTimeout(v *string) *TunnelUpdateOne {
+	if v != nil {
+		_u.SetCloudflareConnectTimeout(*v)
+	}
+	return _u
+}
+
+// ClearCloudflareConnectTimeout clears the value of the "cloudflare_connect_timeout" field.
+func (_u *TunnelUpdateOne) ClearCloudflareConnectTimeout() *TunnelUpdateOne {
+	_u.mutation.ClearCloudflareConnectTimeout()
+	return _u
+}
+
+// SetCloudflareNoTLSVerify sets the "cloudflare_no_tls_verify" field.
+func (_u *TunnelUpdateOne) SetCloudflareNoTLSVerify(v bool) *TunnelUpdateOne {
+	_u.mutation.SetCloudflareNoTLSVerify(v)
+	return _u
+}
+
+// SetNillableCloudflareNoTLSVerify sets the "cloudflare_no_tls_verify" field if the given value is not nil.
+func (_u *TunnelUpdateOne) SetNillableCloudflareNoTLSVerify(v *bool) *TunnelUpdateOne {
 	if v != nil {
-		_u.SetEnabled(*v)
+		_u.SetCloudflareNoTLSVerify(*v)
 	}
 	return _u
 }
 
-// SetMcpEnabled sets the "mcp_enabled" field.
-func (_u *TunnelUpdate) SetMcpEnabled(v bool) *TunnelUpdate {
-	_u.mutation.SetMcpEnabled(v)
+// SetCloudflareHTTPHostHeader sets the "cloudflare_http_host_header" field.
+func (_u *TunnelUpdateOne) SetCloudflareHTTPHostHeader(v string) *TunnelUpdateOne {
+	_u.mutation.SetCloudflareHTTPHostHeader(v)
 	return _u
 }
 
-// SetNillableMcpEnabled sets the "mcp_enabled" field if the given value is not nil.
-func (_u *TunnelUpdate) SetNillableMcpEnabled(v *bool) *TunnelUpdate {
+// SetNillableCloudflareHTTPHostHeader sets the "cloudflare_http_host_header" field if the given value is not nil.
+func (_u *TunnelUpdateOne) SetNillableCloudflareHTTPHostHeader(v *string) *TunnelUpdateOne {
 	if v != nil {
-		_u.SetMcpEnabled(*v)
+		_u.SetCloudflareHTTPHostHeader(*v)
 	}
 	return _u
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (_u *TunnelUpdate) SetUpdatedAt(v time.Time) *TunnelUpdate {
-	_u.mutation.SetUpdatedAt(v)
+// ClearCloudflareHTTPHostHeader clears the value of the "cloudflare_http_host_header" field.
+func (_u *TunnelUpdateOne) ClearCloudflareHTTPHostHeader() *TunnelUpdateOne {
+	_u.mutation.ClearCloudflareHTTPHostHeader()
 	return _u
 }
 
-// SetNgrokAuthtoken sets the "ngrok_authtoken" field.
-func (_u *TunnelUpdate) SetNgrokAuthtoken(v string) *TunnelUpdate {
-	_u.mutation.SetNgrokAuthtoken(v)
+// SetCloudflareRegion sets the "cloudflare_region" field.
+func (_u *TunnelUpdateOne) SetCloudflareRegion(v string) *TunnelUpdateOne {
+	_u.mutation.SetCloudflareRegion(v)
 	return _u
 }
 
-// SetNillableNgrokAuthtoken sets the "ngrok_authtoken" field if the given value is not nil.
-func (_u *TunnelUpdate) SetNillableNgrokAuthtoken(v *string) *TunnelUpdate {
+// SetNillableCloudflareRegion sets the "cloudflare_region" field if the given value is not nil.
+func (_u *TunnelUpdateOne) SetNillableCloudflareRegion(v *string) *TunnelUpdateOne {
 	if v != nil {
-		_u.SetNgrokAuthtoken(*v)
+		_u.SetCloudflareRegion(*v)
 	}
 	return _u
 }
 
-// ClearNgrokAuthtoken clears the value of the "ngrok_authtoken" field.
-func (_u *TunnelUpdate) ClearNgrokAuthtoken() *TunnelUpdate {
-	_u.mutation.ClearNgrokAuthtoken()
+// ClearCloudflareRegion clears the value of the "cloudflare_region" field.
+func (_u *TunnelUpdateOne) ClearCloudflareRegion() *TunnelUpdateOne {
+	_u.mutation.ClearCloudflareRegion()
 	return _u
 }
 
-// SetNgrokDomain sets the "ngrok_domain" field.
-func (_u *TunnelUpdate) SetNgrokDomain(v string) *TunnelUpdate {
-	_u.mutation.SetNgrokDomain(v)
+// SetCloudflareEnv sets the "cloudflare_env" field.
+func (_u *TunnelUpdateOne) SetCloudflareEnv(v map[string]string) *TunnelUpdateOne {
+	_u.mutation.SetCloudflareEnv(v)
 	return _u
 }
 
-// SetNillableNgrokDomain sets the "ngrok_domain" field if the given value is not nil.
-func (_u *TunnelUpdate) SetNillableNgrokDomain(v *string) *TunnelUpdate {
+// ClearCloudflareEnv clears the value of the "cloudflare_env" field.
+func (_u *TunnelUpdateOne) ClearCloudflareEnv() *TunnelUpdateOne {
+	_u.mutation.ClearCloudflareEnv()
+	return _u
+}
+
+// SetCloudflareErrorPage sets the "cloudflare_error_page" field.
+func (_u *TunnelUpdateOne) SetCloudflareErrorPage(v string) *TunnelUpdateOne {
+	_u.mutation.SetCloudflareErrorPage(v)
+	return _u
+}
+
+// SetNillableCloudflareErrorPage sets the "cloudflare_error_page" field if the given value is not nil.
+func (_u *TunnelUpdateOne) SetNillableCloudflareErrorPage(v *string) *TunnelUpdateOne {
 	if v != nil {
-		_u.SetNgrokDomain(*v)
+		_u.SetCloudflareErrorPage(*v)
 	}
 	return _u
 }
 
-// ClearNgrokDomain clears the value of the "ngrok_domain" field.
-func (_u *TunnelUpdate) ClearNgrokDomain() *TunnelUpdate {
-	_u.mutation.ClearNgrokDomain()
+// ClearCloudflareErrorPage clears the value of the "cloudflare_error_page" field.
+func (_u *TunnelUpdateOne) ClearCloudflareErrorPage() *TunnelUpdateOne {
+	_u.mutation.ClearCloudflareErrorPage()
 	return _u
 }
 
-// Mutation returns the TunnelMutation object of the builder.
-func (_u *TunnelUpdate) Mutation() *TunnelMutation {
-	return _u.mutation
+// SetErrorGrace sets the "error_grace" field.
+func (_u *TunnelUpdateOne) SetErrorGrace(v string) *TunnelUpdateOne {
+	_u.mutation.SetErrorGrace(v)
+	return _u
 }
 
-// Save executes the query and returns the number of nodes affected by the update operation.
-func (_u *TunnelUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
-	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+// SetNillableErrorGrace sets the "error_grace" field if the given value is not nil.
+func (_u *TunnelUpdateOne) SetNillableErrorGrace(v *string) *TunnelUpdateOne {
+	if v != nil {
+		_u.SetErrorGrace(*v)
+	}
+	return _u
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (_u *TunnelUpdate) SaveX(ctx context.Context) int {
-	affected, err := _u.Save(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return affected
+// ClearErrorGrace clears the value of the "error_grace" field.
+func (_u *TunnelUpdateOne) ClearErrorGrace() *TunnelUpdateOne {
+	_u.mutation.ClearErrorGrace()
+	return _u
 }
 
-// Exec executes the query.
-func (_u *TunnelUpdate) Exec(ctx context.Context) error {
-	_, err := _u.Save(ctx)
-	return err
+// SetArchived sets the "archived" field.
+func (_u *TunnelUpdateOne) SetArchived(v bool) *TunnelUpdateOne {
+	_u.mutation.SetArchived(v)
+	return _u
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (_u *TunnelUpdate) ExecX(ctx context.Context) {
-	if err := _u.Exec(ctx); err != nil {
-		panic(err)
+// SetNillableArchived sets the "archived" field if the given value is not nil.
+func (_u *TunnelUpdateOne) SetNillableArchived(v *bool) *TunnelUpdateOne {
+	if v != nil {
+		_u.SetArchived(*v)
 	}
+	return _u
 }
 
-// defaults sets the default values of the builder before save.
-func (_u *TunnelUpdate) defaults() {
-	if _, ok := _u.mutation.UpdatedAt(); !ok {
-		v := tunnel.UpdateDefaultUpdatedAt()
-		_u.mutation.SetUpdatedAt(v)
-	}
+// SetPaused sets the "paused" field.
+func (_u *TunnelUpdateOne) SetPaused(v bool) *TunnelUpdateOne {
+	_u.mutation.SetPaused(v)
+	return _u
 }
 
-// check runs all checks and user-defined validators on the builder.
-func (_u *TunnelUpdate) check() error {
-	if v, ok := _u.mutation.GetType(); ok {
-		if err := tunnel.TypeValidator(v); err != nil {
-			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Tunnel.type": %w`, err)}
-		}
+// SetNillablePaused sets the "paused" field if the given value is not nil.
+func (_u *TunnelUpdateOne) SetNillablePaused(v *bool) *TunnelUpdateOne {
+	if v != nil {
+		_u.SetPaused(*v)
 	}
-	return nil
+	return _u
 }
 
-func (_u *TunnelUpdate) sqlSave(ctx context.Context) (_node int, err error) {
-	if err := _u.check(); err != nil {
-		return _node, err
-	}
-	_spec := sqlgraph.NewUpdateSpec(tunnel.Table, tunnel.Columns, sqlgraph.NewFieldSpec(tunnel.FieldID, field.TypeUUID))
-	if ps := _u.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
-		}
-	}
-	if value, ok := _u.mutation.Name(); ok {
-		_spec.SetField(tunnel.FieldName, field.TypeString, value)
-	}
-	if value, ok := _u.mutation.GetType(); ok {
-		_spec.SetField(tunnel.FieldType, field.TypeEnum, value)
-	}
-	if value, ok := _u.mutation.Target(); ok {
-		_spec.SetField(tunnel.FieldTarget, field.TypeString, value)
-	}
-	if value, ok := _u.mutation.Enabled(); ok {
-		_spec.SetField(tunnel.FieldEnabled, field.TypeBool, value)
-	}
-	if value, ok := _u.mutation.McpEnabled(); ok {
-		_spec.SetField(tunnel.FieldMcpEnabled, field.TypeBool, value)
-	}
-	if value, ok := _u.mutation.UpdatedAt(); ok {
-		_spec.SetField(tunnel.FieldUpdatedAt, field.TypeTime, value)
-	}
-	if value, ok := _u.mutation.NgrokAuthtoken(); ok {
-		_spec.SetField(tunnel.FieldNgrokAuthtoken, field.TypeString, value)
-	}
-	if _u.mutation.NgrokAuthtokenCleared() {
-		_spec.ClearField(tunnel.FieldNgrokAuthtoken, field.TypeString)
-	}
-	if value, ok := _u.mutation.NgrokDomain(); ok {
-		_spec.SetField(tunnel.FieldNgrokDomain, field.TypeString, value)
-	}
-	if _u.mutation.NgrokDomainCleared() {
-		_spec.ClearField(tunnel.FieldNgrokDomain, field.TypeString)
-	}
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{tunnel.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
-		}
-		return 0, err
-	}
-	_u.mutation.done = true
-	return _node, nil
+// SetProbeEnabled sets the "probe_enabled" field.
+func (_u *TunnelUpdateOne) SetProbeEnabled(v bool) *TunnelUpdateOne {
+	_u.mutation.SetProbeEnabled(v)
+	return _u
 }
 
-// TunnelUpdateOne is the builder for updating a single Tunnel entity.
-type TunnelUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *TunnelMutation
+// SetNillableProbeEnabled sets the "probe_enabled" field if the given value is not nil.
+func (_u *TunnelUpdateOne) SetNillableProbeEnabled(v *bool) *TunnelUpdateOne {
+	if v != nil {
+		_u.SetProbeEnabled(*v)
+	}
+	return _u
 }
 
-// SetName sets the "name" field.
-func (_u *TunnelUpdateOne) SetName(v string) *TunnelUpdateOne {
-	_u.mutation.SetName(v)
+// SetProbeInterval sets the "probe_interval" field.
+func (_u *TunnelUpdateOne) SetProbeInterval(v string) *TunnelUpdateOne {
+	_u.mutation.SetProbeInterval(v)
 	return _u
 }
 
-// SetNillableName sets the "name" field if the given value is not nil.
-func (_u *TunnelUpdateOne) SetNillableName(v *string) *TunnelUpdateOne {
+// SetNillableProbeInterval sets the "probe_interval" field if the given value is not nil.
+func (_u *TunnelUpdateOne) SetNillableProbeInterval(v *string) *TunnelUpdateOne {
 	if v != nil {
-		_u.SetName(*v)
+		_u.SetProbeInterval(*v)
 	}
 	return _u
 }
 
-// SetType sets the "type" field.
-func (_u *TunnelUpdateOne) SetType(v tunnel.Type) *TunnelUpdateOne {
-	_u.mutation.SetType(v)
+// ClearProbeInterval clears the value of the "probe_interval" field.
+func (_u *TunnelUpdateOne) ClearProbeInterval() *TunnelUpdateOne {
+	_u.mutation.ClearProbeInterval()
 	return _u
 }
 
-// SetNillableType sets the "type" field if the given value is not nil.
-func (_u *TunnelUpdateOne) SetNillableType(v *tunnel.Type) *TunnelUpdateOne {
+// SetProbeTimeout sets the "probe_timeout" field.
+func (_u *TunnelUpdateOne) SetProbeTimeout(v string) *TunnelUpdateOne {
+	_u.mutation.SetProbeTimeout(v)
+	return _u
+}
+
+// SetNillableProbeTimeout sets the "probe_timeout" field if the given value is not nil.
+func (_u *TunnelUpdateOne) SetNillableProbeTimeout(v *string) *TunnelUpdateOne {
 	if v != nil {
-		_u.SetType(*v)
+		_u.SetProbeTimeout(*v)
 	}
 	return _u
 }
 
-// SetTarget sets the "target" field.
-func (_u *TunnelUpdateOne) SetTarget(v string) *TunnelUpdateOne {
-	_u.mutation.SetTarget(v)
+// ClearProbeTimeout clears the value of the "probe_timeout" field.
+func (_u *TunnelUpdateOne) ClearProbeTimeout() *TunnelUpdateOne {
+	_u.mutation.ClearProbeTimeout()
 	return _u
 }
 
-// SetNillableTarget sets the "target" field if the given value is not nil.
-func (_u *TunnelUpdateOne) SetNillableTarget(v *string) *TunnelUpdateOne {
+// SetProbeRetries sets the "probe_retries" field.
+func (_u *TunnelUpdateOne) SetProbeRetries(v int) *TunnelUpdateOne {
+	_u.mutation.ResetProbeRetries()
+	_u.mutation.SetProbeRetries(v)
+	return _u
+}
+
+// SetNillableProbeRetries sets the "probe_retries" field if the given value is not nil.
+func (_u *TunnelUpdateOne) SetNillableProbeRetries(v *int) *TunnelUpdateOne {
 	if v != nil {
-		_u.SetTarget(*v)
+		_u.SetProbeRetries(*v)
 	}
 	return _u
 }
 
-// SetEnabled sets the "enabled" field.
-func (_u *TunnelUpdateOne) SetEnabled(v bool) *TunnelUpdateOne {
-	_u.mutation.SetEnabled(v)
+// AddProbeRetries adds value to the "probe_retries" field.
+func (_u *TunnelUpdateOne) AddProbeRetries(v int) *TunnelUpdateOne {
+	_u.mutation.AddProbeRetries(v)
 	return _u
 }
 
-// SetNillableEnabled sets the "enabled" field if the given value is not nil.
-func (_u *TunnelUpdateOne) SetNillableEnabled(v *bool) *TunnelUpdateOne {
+// SetProbePath sets the "probe_path" field.
+func (_u *TunnelUpdateOne) SetProbePath(v string) *TunnelUpdateOne {
+	_u.mutation.SetProbePath(v)
+	return _u
+}
+
+// SetNillableProbePath sets the "probe_path" field if the given value is not nil.
+func (_u *TunnelUpdateOne) SetNillableProbePath(v *string) *TunnelUpdateOne {
 	if v != nil {
-		_u.SetEnabled(*v)
+		_u.SetProbePath(*v)
 	}
 	return _u
 }
 
-// SetMcpEnabled sets the "mcp_enabled" field.
-func (_u *TunnelUpdateOne) SetMcpEnabled(v bool) *TunnelUpdateOne {
-	_u.mutation.SetMcpEnabled(v)
+// ClearProbePath clears the value of the "probe_path" field.
+func (_u *TunnelUpdateOne) ClearProbePath() *TunnelUpdateOne {
+	_u.mutation.ClearProbePath()
 	return _u
 }
 
-// SetNillableMcpEnabled sets the "mcp_enabled" field if the given value is not nil.
-func (_u *TunnelUpdateOne) SetNillableMcpEnabled(v *bool) *TunnelUpdateOne {
+// SetIdleTimeout sets the "idle_timeout" field.
+func (_u *TunnelUpdateOne) SetIdleTimeout(v string) *TunnelUpdateOne {
+	_u.mutation.SetIdleTimeout(v)
+	return _u
+}
+
+// SetNillableIdleTimeout sets the "idle_timeout" field if the given value is not nil.
+func (_u *TunnelUpdateOne) SetNillableIdleTimeout(v *string) *TunnelUpdateOne {
 	if v != nil {
-		_u.SetMcpEnabled(*v)
+		_u.SetIdleTimeout(*v)
 	}
 	return _u
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (_u *TunnelUpdateOne) SetUpdatedAt(v time.Time) *TunnelUpdateOne {
-	_u.mutation.SetUpdatedAt(v)
+// ClearIdleTimeout clears the value of the "idle_timeout" field.
+func (_u *TunnelUpdateOne) ClearIdleTimeout() *TunnelUpdateOne {
+	_u.mutation.ClearIdleTimeout()
 	return _u
 }
 
-// SetNgrokAuthtoken sets the "ngrok_authtoken" field.
-func (_u *TunnelUpdateOne) SetNgrokAuthtoken(v string) *TunnelUpdateOne {
-	_u.mutation.SetNgrokAuthtoken(v)
+// SetPreStartHook sets the "pre_start_hook" field.
+func (_u *TunnelUpdateOne) SetPreStartHook(v string) *TunnelUpdateOne {
+	_u.mutation.SetPreStartHook(v)
 	return _u
 }
 
-// SetNillableNgrokAuthtoken sets the "ngrok_authtoken" field if the given value is not nil.
-func (_u *TunnelUpdateOne) SetNillableNgrokAuthtoken(v *string) *TunnelUpdateOne {
+// SetNillablePreStartHook sets the "pre_start_hook" field if the given value is not nil.
+func (_u *TunnelUpdateOne) SetNillablePreStartHook(v *string) *TunnelUpdateOne {
 	if v != nil {
-		_u.SetNgrokAuthtoken(*v)
+		_u.SetPreStartHook(*v)
 	}
 	return _u
 }
 
-// ClearNgrokAuthtoken clears the value of the "ngrok_authtoken" field.
-func (_u *TunnelUpdateOne) ClearNgrokAuthtoken() *TunnelUpdateOne {
-	_u.mutation.ClearNgrokAuthtoken()
+// ClearPreStartHook clears the value of the "pre_start_hook" field.
+func (_u *TunnelUpdateOne) ClearPreStartHook() *TunnelUpdateOne {
+	_u.mutation.ClearPreStartHook()
 	return _u
 }
 
-// SetNgrokDomain sets the "ngrok_domain" field.
-func (_u *TunnelUpdateOne) SetNgrokDomain(v string) *TunnelUpdateOne {
-	_u.mutation.SetNgrokDomain(v)
+// SetPostStopHook sets the "post_stop_hook" field.
+func (_u *TunnelUpdateOne) SetPostStopHook(v string) *TunnelUpdateOne {
+	_u.mutation.SetPostStopHook(v)
 	return _u
 }
 
-// SetNillableNgrokDomain sets the "ngrok_domain" field if the given value is not nil.
-func (_u *TunnelUpdateOne) SetNillableNgrokDomain(v *string) *TunnelUpdateOne {
+// SetNillablePostStopHook sets the "post_stop_hook" field if the given value is not nil.
+func (_u *TunnelUpdateOne) SetNillablePostStopHook(v *string) *TunnelUpdateOne {
 	if v != nil {
-		_u.SetNgrokDomain(*v)
+		_u.SetPostStopHook(*v)
 	}
 	return _u
 }
 
-// ClearNgrokDomain clears the value of the "ngrok_domain" field.
-func (_u *TunnelUpdateOne) ClearNgrokDomain() *TunnelUpdateOne {
-	_u.mutation.ClearNgrokDomain()
+// ClearPostStopHook clears the value of the "post_stop_hook" field.
+func (_u *TunnelUpdateOne) ClearPostStopHook() *TunnelUpdateOne {
+	_u.mutation.ClearPostStopHook()
 	return _u
 }
 
@@ -429,11 +1439,21 @@ func (_u *TunnelUpdateOne) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *TunnelUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := tunnel.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Tunnel.name": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.GetType(); ok {
 		if err := tunnel.TypeValidator(v); err != nil {
 			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Tunnel.type": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Target(); ok {
+		if err := tunnel.TargetValidator(v); err != nil {
+			return &ValidationError{Name: "target", err: fmt.Errorf(`ent: validator failed for field "Tunnel.target": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -475,12 +1495,21 @@ func (_u *TunnelUpdateOne) sqlSave(ctx context.Context) (_node *Tunnel, err erro
 	if value, ok := _u.mutation.Target(); ok {
 		_spec.SetField(tunnel.FieldTarget, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Group(); ok {
+		_spec.SetField(tunnel.FieldGroup, field.TypeString, value)
+	}
+	if _u.mutation.GroupCleared() {
+		_spec.ClearField(tunnel.FieldGroup, field.TypeString)
+	}
 	if value, ok := _u.mutation.Enabled(); ok {
 		_spec.SetField(tunnel.FieldEnabled, field.TypeBool, value)
 	}
 	if value, ok := _u.mutation.McpEnabled(); ok {
 		_spec.SetField(tunnel.FieldMcpEnabled, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.Favorite(); ok {
+		_spec.SetField(tunnel.FieldFavorite, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(tunnel.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -490,12 +1519,129 @@ func (_u *TunnelUpdateOne) sqlSave(ctx context.Context) (_node *Tunnel, err erro
 	if _u.mutation.NgrokAuthtokenCleared() {
 		_spec.ClearField(tunnel.FieldNgrokAuthtoken, field.TypeString)
 	}
+	if value, ok := _u.mutation.NgrokCredential(); ok {
+		_spec.SetField(tunnel.FieldNgrokCredential, field.TypeString, value)
+	}
+	if _u.mutation.NgrokCredentialCleared() {
+		_spec.ClearField(tunnel.FieldNgrokCredential, field.TypeString)
+	}
 	if value, ok := _u.mutation.NgrokDomain(); ok {
 		_spec.SetField(tunnel.FieldNgrokDomain, field.TypeString, value)
 	}
 	if _u.mutation.NgrokDomainCleared() {
 		_spec.ClearField(tunnel.FieldNgrokDomain, field.TypeString)
 	}
+	if value, ok := _u.mutation.NgrokWebhookProvider(); ok {
+		_spec.SetField(tunnel.FieldNgrokWebhookProvider, field.TypeString, value)
+	}
+	if _u.mutation.NgrokWebhookProviderCleared() {
+		_spec.ClearField(tunnel.FieldNgrokWebhookProvider, field.TypeString)
+	}
+	if value, ok := _u.mutation.NgrokWebhookSecret(); ok {
+		_spec.SetField(tunnel.FieldNgrokWebhookSecret, field.TypeString, value)
+	}
+	if _u.mutation.NgrokWebhookSecretCleared() {
+		_spec.ClearField(tunnel.FieldNgrokWebhookSecret, field.TypeString)
+	}
+	if value, ok := _u.mutation.NgrokInternal(); ok {
+		_spec.SetField(tunnel.FieldNgrokInternal, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.NgrokRateLimit(); ok {
+		_spec.SetField(tunnel.FieldNgrokRateLimit, field.TypeString, value)
+	}
+	if _u.mutation.NgrokRateLimitCleared() {
+		_spec.ClearField(tunnel.FieldNgrokRateLimit, field.TypeString)
+	}
+	if value, ok := _u.mutation.CloudflareConnectTimeout(); ok {
+		_spec.SetField(tunnel.FieldCloudflareConnectTimeout, field.TypeString, value)
+	}
+	if _u.mutation.CloudflareConnectTimeoutCleared() {
+		_spec.ClearField(tunnel.FieldCloudflareConnectTimeout, field.TypeString)
+	}
+	if value, ok := _u.mutation.CloudflareNoTLSVerify(); ok {
+		_spec.SetField(tunnel.FieldCloudflareNoTLSVerify, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CloudflareHTTPHostHeader(); ok {
+		_spec.SetField(tunnel.FieldCloudflareHTTPHostHeader, field.TypeString, value)
+	}
+	if _u.mutation.CloudflareHTTPHostHeaderCleared() {
+		_spec.ClearField(tunnel.FieldCloudflareHTTPHostHeader, field.TypeString)
+	}
+	if value, ok := _u.mutation.CloudflareRegion(); ok {
+		_spec.SetField(tunnel.FieldCloudflareRegion, field.TypeString, value)
+	}
+	if _u.mutation.CloudflareRegionCleared() {
+		_spec.ClearField(tunnel.FieldCloudflareRegion, field.TypeString)
+	}
+	if value, ok := _u.mutation.CloudflareEnv(); ok {
+		_spec.SetField(tunnel.FieldCloudflareEnv, field.TypeJSON, value)
+	}
+	if _u.mutation.CloudflareEnvCleared() {
+		_spec.ClearField(tunnel.FieldCloudflareEnv, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CloudflareErrorPage(); ok {
+		_spec.SetField(tunnel.FieldCloudflareErrorPage, field.TypeString, value)
+	}
+	if _u.mutation.CloudflareErrorPageCleared() {
+		_spec.ClearField(tunnel.FieldCloudflareErrorPage, field.TypeString)
+	}
+	if value, ok := _u.mutation.ErrorGrace(); ok {
+		_spec.SetField(tunnel.FieldErrorGrace, field.TypeString, value)
+	}
+	if _u.mutation.ErrorGraceCleared() {
+		_spec.ClearField(tunnel.FieldErrorGrace, field.TypeString)
+	}
+	if value, ok := _u.mutation.Archived(); ok {
+		_spec.SetField(tunnel.FieldArchived, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Paused(); ok {
+		_spec.SetField(tunnel.FieldPaused, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ProbeEnabled(); ok {
+		_spec.SetField(tunnel.FieldProbeEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ProbeInterval(); ok {
+		_spec.SetField(tunnel.FieldProbeInterval, field.TypeString, value)
+	}
+	if _u.mutation.ProbeIntervalCleared() {
+		_spec.ClearField(tunnel.FieldProbeInterval, field.TypeString)
+	}
+	if value, ok := _u.mutation.ProbeTimeout(); ok {
+		_spec.SetField(tunnel.FieldProbeTimeout, field.TypeString, value)
+	}
+	if _u.mutation.ProbeTimeoutCleared() {
+		_spec.ClearField(tunnel.FieldProbeTimeout, field.TypeString)
+	}
+	if value, ok := _u.mutation.ProbeRetries(); ok {
+		_spec.SetField(tunnel.FieldProbeRetries, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedProbeRetries(); ok {
+		_spec.AddField(tunnel.FieldProbeRetries, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ProbePath(); ok {
+		_spec.SetField(tunnel.FieldProbePath, field.TypeString, value)
+	}
+	if _u.mutation.ProbePathCleared() {
+		_spec.ClearField(tunnel.FieldProbePath, field.TypeString)
+	}
+	if value, ok := _u.mutation.IdleTimeout(); ok {
+		_spec.SetField(tunnel.FieldIdleTimeout, field.TypeString, value)
+	}
+	if _u.mutation.IdleTimeoutCleared() {
+		_spec.ClearField(tunnel.FieldIdleTimeout, field.TypeString)
+	}
+	if value, ok := _u.mutation.PreStartHook(); ok {
+		_spec.SetField(tunnel.FieldPreStartHook, field.TypeString, value)
+	}
+	if _u.mutation.PreStartHookCleared() {
+		_spec.ClearField(tunnel.FieldPreStartHook, field.TypeString)
+	}
+	if value, ok := _u.mutation.PostStopHook(); ok {
+		_spec.SetField(tunnel.FieldPostStopHook, field.TypeString, value)
+	}
+	if _u.mutation.PostStopHookCleared() {
+		_spec.ClearField(tunnel.FieldPostStopHook, field.TypeString)
+	}
 	_node = &Tunnel{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -12,10 +12,16 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
+	// NgrokCredential is the client for interacting with the NgrokCredential builders.
+	NgrokCredential *NgrokCredentialClient
 	// Setting is the client for interacting with the Setting builders.
 	Setting *SettingClient
 	// Tunnel is the client for interacting with the Tunnel builders.
 	Tunnel *TunnelClient
+	// URLHistory is the client for interacting with the URLHistory builders.
+	URLHistory *URLHistoryClient
 
 	// lazily loaded.
 	client     *Client
@@ -147,8 +153,11 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.AuditLog = NewAuditLogClient(tx.config)
+	tx.NgrokCredential = NewNgrokCredentialClient(tx.config)
 	tx.Setting = NewSettingClient(tx.config)
 	tx.Tunnel = NewTunnelClient(tx.config)
+	tx.URLHistory = NewURLHistoryClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -158,7 +167,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Setting.QueryXXX(), the query will be executed
+// applies a query, for example: AuditLog.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -0,0 +1,70 @@
+// Code generated by ent, DO NOT EDIT.
+
+package urlhistory
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the urlhistory type in the database.
+	Label = "url_history"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTunnelID holds the string denoting the tunnel_id field in the database.
+	FieldTunnelID = "tunnel_id"
+	// FieldURL holds the string denoting the url field in the database.
+	FieldURL = "url"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the urlhistory in the database.
+	Table = "url_histories"
+)
+
+// Columns holds all SQL columns for urlhistory fields.
+var Columns = []string{
+	FieldID,
+	FieldTunnelID,
+	FieldURL,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the URLHistory queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTunnelID orders the results by the tunnel_id field.
+func ByTunnelID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTunnelID, opts...).ToFunc()
+}
+
+// ByURL orders the results by the url field.
+func ByURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldURL, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,255 @@
+// Code generated by ent, DO NOT EDIT.
+
+package urlhistory
+
+import (
+	"pont/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.URLHistory {
+	return predicate.URLHistory(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.URLHistory {
+	return predicate.URLHistory(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.URLHistory {
+	return predicate.URLHistory(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.URLHistory {
+	return predicate.URLHistory(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.URLHistory {
+	return predicate.URLHistory(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.URLHistory {
+	return predicate.URLHistory(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.URLHistory {
+	return predicate.URLHistory(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.URLHistory {
+	return predicate.URLHistory(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.URLHistory {
+	return predicate.URLHistory(sql.FieldLTE(FieldID, id))
+}
+
+// TunnelID applies equality check predicate on the "tunnel_id" field. It's identical to TunnelIDEQ.
+func TunnelID(v string) predicate.URLHistory {
+	return predicate.URLHistory(sql.FieldEQ(FieldTunnelID, v))
+}
+
+// URL applies equality check predicate on the "url" field. It's identical to URLEQ.
+func URL(v string) predicate.URLHistory {
+	return predicate.URLHistory(sql.FieldEQ(FieldURL, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.URLHistory {
+	return predicate.URLHistory(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TunnelIDEQ applies the EQ predicate on the "tunnel_id" field.
+func TunnelIDEQ(v string) predicate.URLHistory {
+	return predicate.URLHistory(sql.FieldEQ(FieldTunnelID, v))
+}
+
+// TunnelIDNEQ applies the NEQ predicate on the "tunnel_id" field.
+func TunnelIDNEQ(v string) predicate.URLHistory {
+	return predicate.URLHistory(sql.FieldNEQ(FieldTunnelID, v))
+}
+
+// TunnelIDIn applies the In predicate on the "tunnel_id" field.
+func TunnelIDIn(vs ...string) predicate.URLHistory {
+	return predicate.URLHistory(sql.FieldIn(FieldTunnelID, vs...))
+}
+
+// TunnelIDNotIn applies the NotIn predicate on the "tunnel_id" field.
+func TunnelIDNotIn(vs ...string) predicate.URLHistory {
+	return predicate.URLHistory(sql.FieldNotIn(FieldTunnelID, vs...))
+}
+
+// TunnelIDGT applies the GT predicate on the "tunnel_id" field.
+func TunnelIDGT(v string) predicate.URLHistory {
+	return predicate.URLHistory(sql.FieldGT(FieldTunnelID, v))
+}
+
+// TunnelIDGTE applies the GTE predicate on the "tunnel_id" field.
+func TunnelIDGTE(v string) predicate.URLHistory {
+	return predicate.URLHistory(sql.FieldGTE(FieldTunnelID, v))
+}
+
+// TunnelIDLT applies the LT predicate on the "tunnel_id" field.
+func TunnelIDLT(v string) predicate.URLHistory {
+	return predicate.URLHistory(sql.FieldLT(FieldTunnelID, v))
+}
+
+// TunnelIDLTE applies the LTE predicate on the "tunnel_id" field.
+func TunnelIDLTE(v string) predicate.URLHistory {
+	return predicate.URLHistory(sql.FieldLTE(FieldTunnelID, v))
+}
+
+// TunnelIDContains applies the Contains predicate on the "tunnel_id" field.
+func TunnelIDContains(v string) predicate.URLHistory {
+	return predicate.URLHistory(sql.FieldContains(FieldTunnelID, v))
+}
+
+// TunnelIDHasPrefix applies the HasPrefix predicate on the "tunnel_id" field.
+func TunnelIDHasPrefix(v string) predicate.URLHistory {
+	return predicate.URLHistory(sql.FieldHasPrefix(FieldTunnelID, v))
+}
+
+// TunnelIDHasSuffix applies the HasSuffix predicate on the "tunnel_id" field.
+func TunnelIDHasSuffix(v string) predicate.URLHistory {
+	return predicate.URLHistory(sql.FieldHasSuffix(FieldTunnelID, v))
+}
+
+// TunnelIDEqualFold applies the EqualFold predicate on the "tunnel_id" field.
+func TunnelIDEqualFold(v string) predicate.URLHistory {
+	return predicate.URLHistory(sql.FieldEqualFold(FieldTunnelID, v))
+}
+
+// TunnelIDContainsFold applies the ContainsFold predicate on the "tunnel_id" field.
+func TunnelIDContainsFold(v string) predicate.URLHistory {
+	return predicate.URLHistory(sql.FieldContainsFold(FieldTunnelID, v))
+}
+
+// URLEQ applies the EQ predicate on the "url" field.
+func URLEQ(v string) predicate.URLHistory {
+	return predicate.URLHistory(sql.FieldEQ(FieldURL, v))
+}
+
+// URLNEQ applies the NEQ predicate on the "url" field.
+func URLNEQ(v string) predicate.URLHistory {
+	return predicate.URLHistory(sql.FieldNEQ(FieldURL, v))
+}
+
+// URLIn applies the In predicate on the "url" field.
+func URLIn(vs ...string) predicate.URLHistory {
+	return predicate.URLHistory(sql.FieldIn(FieldURL, vs...))
+}
+
+// URLNotIn applies the NotIn predicate on the "url" field.
+func URLNotIn(vs ...string) predicate.URLHistory {
+	return predicate.URLHistory(sql.FieldNotIn(FieldURL, vs...))
+}
+
+// URLGT applies the GT predicate on the "url" field.
+func URLGT(v string) predicate.URLHistory {
+	return predicate.URLHistory(sql.FieldGT(FieldURL, v))
+}
+
+// URLGTE applies the GTE predicate on the "url" field.
+func URLGTE(v string) predicate.URLHistory {
+	return predicate.URLHistory(sql.FieldGTE(FieldURL, v))
+}
+
+// URLLT applies the LT predicate on the "url" field.
+func URLLT(v string) predicate.URLHistory {
+	return predicate.URLHistory(sql.FieldLT(FieldURL, v))
+}
+
+// URLLTE applies the LTE predicate on the "url" field.
+func URLLTE(v string) predicate.URLHistory {
+	return predicate.URLHistory(sql.FieldLTE(FieldURL, v))
+}
+
+// URLContains applies the Contains predicate on the "url" field.
+func URLContains(v string) predicate.URLHistory {
+	return predicate.URLHistory(sql.FieldContains(FieldURL, v))
+}
+
+// URLHasPrefix applies the HasPrefix predicate on the "url" field.
+func URLHasPrefix(v string) predicate.URLHistory {
+	return predicate.URLHistory(sql.FieldHasPrefix(FieldURL, v))
+}
+
+// URLHasSuffix applies the HasSuffix predicate on the "url" field.
+func URLHasSuffix(v string) predicate.URLHistory {
+	return predicate.URLHistory(sql.FieldHasSuffix(FieldURL, v))
+}
+
+// URLEqualFold applies the EqualFold predicate on the "url" field.
+func URLEqualFold(v string) predicate.URLHistory {
+	return predicate.URLHistory(sql.FieldEqualFold(FieldURL, v))
+}
+
+// URLContainsFold applies the ContainsFold predicate on the "url" field.
+func URLContainsFold(v string) predicate.URLHistory {
+	return predicate.URLHistory(sql.FieldContainsFold(FieldURL, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.URLHistory {
+	return predicate.URLHistory(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.URLHistory {
+	return predicate.URLHistory(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.URLHistory {
+	return predicate.URLHistory(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.URLHistory {
+	return predicate.URLHistory(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.URLHistory {
+	return predicate.URLHistory(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.URLHistory {
+	return predicate.URLHistory(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.URLHistory {
+	return predicate.URLHistory(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.URLHistory {
+	return predicate.URLHistory(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.URLHistory) predicate.URLHistory {
+	return predicate.URLHistory(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.URLHistory) predicate.URLHistory {
+	return predicate.URLHistory(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.URLHistory) predicate.URLHistory {
+	return predicate.URLHistory(sql.NotPredicates(p))
+}
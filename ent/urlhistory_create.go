@@ -0,0 +1,551 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"pont/ent/urlhistory"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// URLHistoryCreate is the builder for creating a URLHistory entity.
+type URLHistoryCreate struct {
+	config
+	mutation *URLHistoryMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTunnelID sets the "tunnel_id" field.
+func (_c *URLHistoryCreate) SetTunnelID(v string) *URLHistoryCreate {
+	_c.mutation.SetTunnelID(v)
+	return _c
+}
+
+// SetURL sets the "url" field.
+func (_c *URLHistoryCreate) SetURL(v string) *URLHistoryCreate {
+	_c.mutation.SetURL(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *URLHistoryCreate) SetCreatedAt(v time.Time) *URLHistoryCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *URLHistoryCreate) SetNillableCreatedAt(v *time.Time) *URLHistoryCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the URLHistoryMutation object of the builder.
+func (_c *URLHistoryCreate) Mutation() *URLHistoryMutation {
+	return _c.mutation
+}
+
+// Save creates the URLHistory in the database.
+func (_c *URLHistoryCreate) Save(ctx context.Context) (*URLHistory, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *URLHistoryCreate) SaveX(ctx context.Context) *URLHistory {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *URLHistoryCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *URLHistoryCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *URLHistoryCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := urlhistory.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *URLHistoryCreate) check() error {
+	if _, ok := _c.mutation.TunnelID(); !ok {
+		return &ValidationError{Name: "tunnel_id", err: errors.New(`ent: missing required field "URLHistory.tunnel_id"`)}
+	}
+	if _, ok := _c.mutation.URL(); !ok {
+		return &ValidationError{Name: "url", err: errors.New(`ent: missing required field "URLHistory.url"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "URLHistory.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *URLHistoryCreate) sqlSave(ctx context.Context) (*URLHistory, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *URLHistoryCreate) createSpec() (*URLHistory, *sqlgraph.CreateSpec) {
+	var (
+		_node = &URLHistory{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(urlhistory.Table, sqlgraph.NewFieldSpec(urlhistory.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.TunnelID(); ok {
+		_spec.SetField(urlhistory.FieldTunnelID, field.TypeString, value)
+		_node.TunnelID = value
+	}
+	if value, ok := _c.mutation.URL(); ok {
+		_spec.SetField(urlhistory.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(urlhistory.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.URLHistory.Create().
+//		SetTunnelID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.URLHistoryUpsert) {
+//			SetTunnelID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *URLHistoryCreate) OnConflict(opts ...sql.ConflictOption) *URLHistoryUpsertOne {
+	_c.conflict = opts
+	return &URLHistoryUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.URLHistory.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *URLHistoryCreate) OnConflictColumns(columns ...string) *URLHistoryUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &URLHistoryUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// URLHistoryUpsertOne is the builder for "upsert"-ing
+	//  one URLHistory node.
+	URLHistoryUpsertOne struct {
+		create *URLHistoryCreate
+	}
+
+	// URLHistoryUpsert is the "OnConflict" setter.
+	URLHistoryUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTunnelID sets the "tunnel_id" field.
+func (u *URLHistoryUpsert) SetTunnelID(v string) *URLHistoryUpsert {
+	u.Set(urlhistory.FieldTunnelID, v)
+	return u
+}
+
+// UpdateTunnelID sets the "tunnel_id" field to the value that was provided on create.
+func (u *URLHistoryUpsert) UpdateTunnelID() *URLHistoryUpsert {
+	u.SetExcluded(urlhistory.FieldTunnelID)
+	return u
+}
+
+// SetURL sets the "url" field.
+func (u *URLHistoryUpsert) SetURL(v string) *URLHistoryUpsert {
+	u.Set(urlhistory.FieldURL, v)
+	return u
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *URLHistoryUpsert) UpdateURL() *URLHistoryUpsert {
+	u.SetExcluded(urlhistory.FieldURL)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.URLHistory.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *URLHistoryUpsertOne) UpdateNewValues() *URLHistoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(urlhistory.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.URLHistory.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *URLHistoryUpsertOne) Ignore() *URLHistoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *URLHistoryUpsertOne) DoNothing() *URLHistoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the URLHistoryCreate.OnConflict
+// documentation for more info.
+func (u *URLHistoryUpsertOne) Update(set func(*URLHistoryUpsert)) *URLHistoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&URLHistoryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTunnelID sets the "tunnel_id" field.
+func (u *URLHistoryUpsertOne) SetTunnelID(v string) *URLHistoryUpsertOne {
+	return u.Update(func(s *URLHistoryUpsert) {
+		s.SetTunnelID(v)
+	})
+}
+
+// UpdateTunnelID sets the "tunnel_id" field to the value that was provided on create.
+func (u *URLHistoryUpsertOne) UpdateTunnelID() *URLHistoryUpsertOne {
+	return u.Update(func(s *URLHistoryUpsert) {
+		s.UpdateTunnelID()
+	})
+}
+
+// SetURL sets the "url" field.
+func (u *URLHistoryUpsertOne) SetURL(v string) *URLHistoryUpsertOne {
+	return u.Update(func(s *URLHistoryUpsert) {
+		s.SetURL(v)
+	})
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *URLHistoryUpsertOne) UpdateURL() *URLHistoryUpsertOne {
+	return u.Update(func(s *URLHistoryUpsert) {
+		s.UpdateURL()
+	})
+}
+
+// Exec executes the query.
+func (u *URLHistoryUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for URLHistoryCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *URLHistoryUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *URLHistoryUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *URLHistoryUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// URLHistoryCreateBulk is the builder for creating many URLHistory entities in bulk.
+type URLHistoryCreateBulk struct {
+	config
+	err      error
+	builders []*URLHistoryCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the URLHistory entities in the database.
+func (_c *URLHistoryCreateBulk) Save(ctx context.Context) ([]*URLHistory, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*URLHistory, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*URLHistoryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *URLHistoryCreateBulk) SaveX(ctx context.Context) []*URLHistory {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *URLHistoryCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *URLHistoryCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.URLHistory.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.URLHistoryUpsert) {
+//			SetTunnelID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *URLHistoryCreateBulk) OnConflict(opts ...sql.ConflictOption) *URLHistoryUpsertBulk {
+	_c.conflict = opts
+	return &URLHistoryUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.URLHistory.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *URLHistoryCreateBulk) OnConflictColumns(columns ...string) *URLHistoryUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &URLHistoryUpsertBulk{
+		create: _c,
+	}
+}
+
+// URLHistoryUpsertBulk is the builder for "upsert"-ing
+// a bulk of URLHistory nodes.
+type URLHistoryUpsertBulk struct {
+	create *URLHistoryCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.URLHistory.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *URLHistoryUpsertBulk) UpdateNewValues() *URLHistoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(urlhistory.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.URLHistory.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *URLHistoryUpsertBulk) Ignore() *URLHistoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *URLHistoryUpsertBulk) DoNothing() *URLHistoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the URLHistoryCreateBulk.OnConflict
+// documentation for more info.
+func (u *URLHistoryUpsertBulk) Update(set func(*URLHistoryUpsert)) *URLHistoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&URLHistoryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTunnelID sets the "tunnel_id" field.
+func (u *URLHistoryUpsertBulk) SetTunnelID(v string) *URLHistoryUpsertBulk {
+	return u.Update(func(s *URLHistoryUpsert) {
+		s.SetTunnelID(v)
+	})
+}
+
+// UpdateTunnelID sets the "tunnel_id" field to the value that was provided on create.
+func (u *URLHistoryUpsertBulk) UpdateTunnelID() *URLHistoryUpsertBulk {
+	return u.Update(func(s *URLHistoryUpsert) {
+		s.UpdateTunnelID()
+	})
+}
+
+// SetURL sets the "url" field.
+func (u *URLHistoryUpsertBulk) SetURL(v string) *URLHistoryUpsertBulk {
+	return u.Update(func(s *URLHistoryUpsert) {
+		s.SetURL(v)
+	})
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *URLHistoryUpsertBulk) UpdateURL() *URLHistoryUpsertBulk {
+	return u.Update(func(s *URLHistoryUpsert) {
+		s.UpdateURL()
+	})
+}
+
+// Exec executes the query.
+func (u *URLHistoryUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the URLHistoryCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for URLHistoryCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *URLHistoryUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,243 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"pont/ent/predicate"
+	"pont/ent/urlhistory"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// URLHistoryUpdate is the builder for updating URLHistory entities.
+type URLHistoryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *URLHistoryMutation
+}
+
+// Where appends a list predicates to the URLHistoryUpdate builder.
+func (_u *URLHistoryUpdate) Where(ps ...predicate.URLHistory) *URLHistoryUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTunnelID sets the "tunnel_id" field.
+func (_u *URLHistoryUpdate) SetTunnelID(v string) *URLHistoryUpdate {
+	_u.mutation.SetTunnelID(v)
+	return _u
+}
+
+// SetNillableTunnelID sets the "tunnel_id" field if the given value is not nil.
+func (_u *URLHistoryUpdate) SetNillableTunnelID(v *string) *URLHistoryUpdate {
+	if v != nil {
+		_u.SetTunnelID(*v)
+	}
+	return _u
+}
+
+// SetURL sets the "url" field.
+func (_u *URLHistoryUpdate) SetURL(v string) *URLHistoryUpdate {
+	_u.mutation.SetURL(v)
+	return _u
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (_u *URLHistoryUpdate) SetNillableURL(v *string) *URLHistoryUpdate {
+	if v != nil {
+		_u.SetURL(*v)
+	}
+	return _u
+}
+
+// Mutation returns the URLHistoryMutation object of the builder.
+func (_u *URLHistoryUpdate) Mutation() *URLHistoryMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *URLHistoryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *URLHistoryUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *URLHistoryUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *URLHistoryUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *URLHistoryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(urlhistory.Table, urlhistory.Columns, sqlgraph.NewFieldSpec(urlhistory.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TunnelID(); ok {
+		_spec.SetField(urlhistory.FieldTunnelID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.URL(); ok {
+		_spec.SetField(urlhistory.FieldURL, field.TypeString, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{urlhistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// URLHistoryUpdateOne is the builder for updating a single URLHistory entity.
+type URLHistoryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *URLHistoryMutation
+}
+
+// SetTunnelID sets the "tunnel_id" field.
+func (_u *URLHistoryUpdateOne) SetTunnelID(v string) *URLHistoryUpdateOne {
+	_u.mutation.SetTunnelID(v)
+	return _u
+}
+
+// SetNillableTunnelID sets the "tunnel_id" field if the given value is not nil.
+func (_u *URLHistoryUpdateOne) SetNillableTunnelID(v *string) *URLHistoryUpdateOne {
+	if v != nil {
+		_u.SetTunnelID(*v)
+	}
+	return _u
+}
+
+// SetURL sets the "url" field.
+func (_u *URLHistoryUpdateOne) SetURL(v string) *URLHistoryUpdateOne {
+	_u.mutation.SetURL(v)
+	return _u
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (_u *URLHistoryUpdateOne) SetNillableURL(v *string) *URLHistoryUpdateOne {
+	if v != nil {
+		_u.SetURL(*v)
+	}
+	return _u
+}
+
+// Mutation returns the URLHistoryMutation object of the builder.
+func (_u *URLHistoryUpdateOne) Mutation() *URLHistoryMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the URLHistoryUpdate builder.
+func (_u *URLHistoryUpdateOne) Where(ps ...predicate.URLHistory) *URLHistoryUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *URLHistoryUpdateOne) Select(field string, fields ...string) *URLHistoryUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated URLHistory entity.
+func (_u *URLHistoryUpdateOne) Save(ctx context.Context) (*URLHistory, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *URLHistoryUpdateOne) SaveX(ctx context.Context) *URLHistory {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *URLHistoryUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *URLHistoryUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *URLHistoryUpdateOne) sqlSave(ctx context.Context) (_node *URLHistory, err error) {
+	_spec := sqlgraph.NewUpdateSpec(urlhistory.Table, urlhistory.Columns, sqlgraph.NewFieldSpec(urlhistory.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "URLHistory.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, urlhistory.FieldID)
+		for _, f := range fields {
+			if !urlhistory.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != urlhistory.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TunnelID(); ok {
+		_spec.SetField(urlhistory.FieldTunnelID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.URL(); ok {
+		_spec.SetField(urlhistory.FieldURL, field.TypeString, value)
+	}
+	_node = &URLHistory{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{urlhistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,197 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// targetLookupTimeout bounds the DNS lookup of a target host checked against
+// CIDR entries
+const targetLookupTimeout = 5 * time.Second
+
+// TargetAllowlist restricts which hosts and ports tunnel targets may point
+// at. A nil allowlist allows every target.
+type TargetAllowlist struct {
+	raw     []string
+	entries []allowEntry
+}
+
+// allowEntry is one allowlist pattern: a CIDR (any port), or a host glob
+// with a port or "*"
+type allowEntry struct {
+	cidr *net.IPNet
+	host string
+	port string // "*" for any port
+}
+
+// ParseTargetAllowlist parses a comma-separated allowlist such as
+// "localhost:*, 127.0.0.0/8, *.svc.cluster.local:8080". Entries are CIDRs or
+// IPs (any port), or host globs with an optional ":port" or ":*" (any port,
+// the default). An empty list returns nil.
+func ParseTargetAllowlist(list string) (*TargetAllowlist, error) {
+	a := &TargetAllowlist{}
+	for _, raw := range strings.Split(list, ",") {
+		raw = strings.TrimSpace(raw)
+		if raw == "" {
+			continue
+		}
+		entry, err := parseAllowEntry(raw)
+		if err != nil {
+			return nil, err
+		}
+		a.raw = append(a.raw, raw)
+		a.entries = append(a.entries, entry)
+	}
+	if len(a.entries) == 0 {
+		return nil, nil
+	}
+	return a, nil
+}
+
+func parseAllowEntry(raw string) (allowEntry, error) {
+	if strings.Contains(raw, "/") {
+		_, cidr, err := net.ParseCIDR(raw)
+		if err != nil {
+			return allowEntry{}, fmt.Errorf("invalid allowlist entry %q: %w", raw, err)
+		}
+		return allowEntry{cidr: cidr}, nil
+	}
+	if ip := net.ParseIP(raw); ip != nil {
+		return allowEntry{cidr: singleIPNet(ip)}, nil
+	}
+
+	host, port := raw, "*"
+	if h, p, err := net.SplitHostPort(raw); err == nil {
+		host, port = h, p
+	}
+	if port != "*" {
+		if n, err := strconv.Atoi(port); err != nil || n < 1 || n > 65535 {
+			return allowEntry{}, fmt.Errorf("invalid allowlist entry %q: port must be 1-65535 or *", raw)
+		}
+	}
+	if _, err := path.Match(host, ""); err != nil || host == "" {
+		return allowEntry{}, fmt.Errorf("invalid allowlist entry %q: bad host pattern", raw)
+	}
+	return allowEntry{host: strings.ToLower(host), port: port}, nil
+}
+
+func singleIPNet(ip net.IP) *net.IPNet {
+	bits := 128
+	if ip.To4() != nil {
+		ip, bits = ip.To4(), 32
+	}
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}
+}
+
+// String lists the entries as configured
+func (a *TargetAllowlist) String() string {
+	if a == nil {
+		return ""
+	}
+	return strings.Join(a.raw, ", ")
+}
+
+// Check returns an error unless the resolved target is allowed. A target
+// host matches a host entry by name; an IP matches a CIDR entry directly,
+// and a host name matches CIDR entries only if every address it resolves to
+// is covered, so names pointing at forbidden addresses are caught.
+func (a *TargetAllowlist) Check(target string) error {
+	if a == nil {
+		return nil
+	}
+
+	host, port := targetHostPort(target)
+	host = strings.ToLower(host)
+	for _, e := range a.entries {
+		if e.cidr != nil {
+			continue
+		}
+		if ok, _ := path.Match(e.host, host); ok && (e.port == "*" || e.port == port) {
+			return nil
+		}
+	}
+
+	if ips := a.resolve(host); len(ips) > 0 && a.coveredByCIDRs(ips) {
+		return nil
+	}
+	return fmt.Errorf("target %q is not allowed by TARGET_ALLOWLIST (allowed: %s)", target, a)
+}
+
+// resolve returns the addresses of host, nil when there are no CIDR entries
+// or the lookup fails
+func (a *TargetAllowlist) resolve(host string) []net.IP {
+	hasCIDR := false
+	for _, e := range a.entries {
+		hasCIDR = hasCIDR || e.cidr != nil
+	}
+	if !hasCIDR {
+		return nil
+	}
+	if ip := net.ParseIP(host); ip != nil {
+		return []net.IP{ip}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), targetLookupTimeout)
+	defer cancel()
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return nil
+	}
+	ips := make([]net.IP, len(addrs))
+	for i, addr := range addrs {
+		ips[i] = addr.IP
+	}
+	return ips
+}
+
+func (a *TargetAllowlist) coveredByCIDRs(ips []net.IP) bool {
+	for _, ip := range ips {
+		covered := false
+		for _, e := range a.entries {
+			if e.cidr != nil && e.cidr.Contains(ip) {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			return false
+		}
+	}
+	return true
+}
+
+// targetHostPort extracts the host and port a target forwards to. Targets
+// may be URLs, host:port or a bare port, which means localhost.
+func targetHostPort(target string) (host, port string) {
+	rest := target
+	scheme := ""
+	if s, r, found := strings.Cut(target, "://"); found {
+		scheme, rest = strings.ToLower(s), r
+	}
+	if i := strings.IndexAny(rest, "/?#"); i >= 0 {
+		rest = rest[:i]
+	}
+	if i := strings.LastIndex(rest, "@"); i >= 0 {
+		rest = rest[i+1:]
+	}
+
+	if _, err := strconv.Atoi(rest); err == nil {
+		return "localhost", rest
+	}
+	if h, p, err := net.SplitHostPort(rest); err == nil {
+		return h, p
+	}
+
+	switch scheme {
+	case "https":
+		port = "443"
+	case "http", "":
+		port = "80"
+	}
+	return strings.Trim(rest, "[]"), port
+}
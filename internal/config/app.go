@@ -0,0 +1,299 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// AppConfig is the startup configuration read from the environment, with
+// defaults applied. Every environment variable Pont reads is listed here.
+type AppConfig struct {
+	DataDir              string
+	LogDir               string
+	LogLevel             string
+	LogTZ                string
+	LogBroadcastQueue    int
+	LogMaxMessageSize    int
+	Port                 int
+	MCPPort              int // serves MCP on its own listener when set
+	BasePath             string
+	DefaultLang          string
+	URL                  string // API of a running instance, used by the CLI subcommands
+	Namespace            string
+	PrettyJSON           bool
+	DBAutoMigrate        bool
+	DBWAL                bool
+	DBMoveCorrupt        bool // moves a corrupt database aside instead of failing
+	StatusCacheTTL       time.Duration
+	CloudflareURLTimeout time.Duration
+	NgrokAuthtoken       string // default for ngrok tunnels without their own
+	AuthToken            string // required on the API when set
+	AuthLocalhostBypass  bool   // loopback clients skip AuthToken
+	OTLPEndpoint         string // enables OpenTelemetry tracing when set
+	MCPStartWait         time.Duration
+	TargetAllowlist      *TargetAllowlist // nil allows every target
+	TunnelNameMaxLen     int
+	TunnelTargetMaxLen   int
+
+	// CloudflareStopTimeout bounds how long stopping a cloudflare tunnel
+	// waits for cloudflared to exit
+	CloudflareStopTimeout time.Duration
+
+	// AllowHooks lets tunnels run pre-start and post-stop commands, each for
+	// at most HookTimeout
+	AllowHooks  bool
+	HookTimeout time.Duration
+
+	// UnixSocket is a socket path the HTTP server listens on as well, or
+	// instead of PORT with UnixSocketOnly
+	UnixSocket     string
+	UnixSocketOnly bool
+
+	// Audit entries and URL history older than PruneRetention are deleted
+	// every PruneInterval; 0 keeps them
+	PruneRetention time.Duration
+	PruneInterval  time.Duration
+
+	// HTTP server timeouts, 0 disables a timeout. Streaming endpoints are
+	// exempt from the read and write timeouts.
+	HTTPReadTimeout  time.Duration
+	HTTPWriteTimeout time.Duration
+	HTTPIdleTimeout  time.Duration
+
+	logLocation *time.Location
+}
+
+// LoadAppConfig reads and validates the environment. All invalid values are
+// reported together so they can be fixed in one go.
+func LoadAppConfig() (*AppConfig, error) {
+	var errs []error
+	env := envReader{errs: &errs}
+
+	cfg := &AppConfig{
+		DataDir:              env.str("DATA_DIR", "./data"),
+		LogLevel:             env.str("LOG_LEVEL", "info"),
+		LogTZ:                env.str("LOG_TZ", ""),
+		LogBroadcastQueue:    env.int("LOG_BROADCAST_QUEUE", 1024, 1, 1<<20),
+		LogMaxMessageSize:    env.int("LOG_MAX_MESSAGE_SIZE", 16384, 0, 1<<30),
+		Port:                 env.int("PORT", 13333, 1, 65535),
+		MCPPort:              env.int("MCP_PORT", 0, 0, 65535),
+		BasePath:             env.str("BASE_PATH", ""),
+		DefaultLang:          env.str("DEFAULT_LANG", "en"),
+		URL:                  env.str("PONT_URL", ""),
+		Namespace:            env.str("PONT_NAMESPACE", ""),
+		PrettyJSON:           env.bool("PONT_PRETTY_JSON", false),
+		DBAutoMigrate:        env.str("DB_AUTO_MIGRATE", "true") != "false",
+		DBWAL:                env.bool("DB_WAL", true),
+		DBMoveCorrupt:        env.bool("DB_MOVE_CORRUPT", false),
+		StatusCacheTTL:       env.duration("STATUS_CACHE_TTL", time.Second),
+		CloudflareURLTimeout: env.duration("CLOUDFLARE_URL_TIMEOUT", 60*time.Second),
+		NgrokAuthtoken:       env.str("NGROK_AUTHTOKEN", ""),
+		AuthToken:            env.str("AUTH_TOKEN", ""),
+		AuthLocalhostBypass:  env.bool("AUTH_LOCALHOST_BYPASS", false),
+		OTLPEndpoint:         env.str("OTEL_EXPORTER_OTLP_ENDPOINT", ""),
+		MCPStartWait:         env.duration("MCP_START_WAIT", 15*time.Second),
+		TunnelNameMaxLen:     env.int("TUNNEL_NAME_MAX_LEN", DefaultTunnelNameLength, 1, MaxTunnelNameLength),
+		TunnelTargetMaxLen:   env.int("TUNNEL_TARGET_MAX_LEN", DefaultTunnelTargetLength, 1, MaxTunnelTargetLength),
+		HTTPReadTimeout:      env.duration("HTTP_READ_TIMEOUT", 30*time.Second),
+		HTTPWriteTimeout:     env.duration("HTTP_WRITE_TIMEOUT", 60*time.Second),
+		HTTPIdleTimeout:      env.duration("HTTP_IDLE_TIMEOUT", 120*time.Second),
+		logLocation:          time.Local,
+
+		CloudflareStopTimeout: env.duration("CLOUDFLARE_STOP_TIMEOUT", 15*time.Second),
+
+		AllowHooks:  env.bool("ALLOW_HOOKS", false),
+		HookTimeout: env.duration("HOOK_TIMEOUT", 30*time.Second),
+
+		UnixSocket:     env.str("UNIX_SOCKET", ""),
+		UnixSocketOnly: env.bool("UNIX_SOCKET_ONLY", false),
+
+		PruneRetention: env.duration("PRUNE_RETENTION", 0),
+		PruneInterval:  env.duration("PRUNE_INTERVAL", time.Hour),
+	}
+	cfg.LogDir = env.str("LOG_DIR", filepath.Join(cfg.DataDir, "logs"))
+
+	if err := validateEnumSetting("log_level", cfg.LogLevel); err != nil {
+		errs = append(errs, fmt.Errorf("LOG_LEVEL: %w", err))
+	}
+	if cfg.LogTZ != "" {
+		loc, err := time.LoadLocation(cfg.LogTZ)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("LOG_TZ: unknown timezone %q", cfg.LogTZ))
+		} else {
+			cfg.logLocation = loc
+		}
+	}
+	if cfg.MCPPort != 0 && cfg.MCPPort == cfg.Port {
+		errs = append(errs, fmt.Errorf("MCP_PORT: must differ from PORT (%d)", cfg.Port))
+	}
+	allowlist, err := ParseTargetAllowlist(env.str("TARGET_ALLOWLIST", ""))
+	if err != nil {
+		errs = append(errs, fmt.Errorf("TARGET_ALLOWLIST: %w", err))
+	}
+	cfg.TargetAllowlist = allowlist
+
+	// Hooks run commands for anyone who can edit tunnels
+	if cfg.AllowHooks && cfg.AuthToken == "" {
+		errs = append(errs, fmt.Errorf("ALLOW_HOOKS: requires AUTH_TOKEN to be set"))
+	}
+
+	if cfg.UnixSocketOnly && cfg.UnixSocket == "" {
+		errs = append(errs, fmt.Errorf("UNIX_SOCKET_ONLY: requires UNIX_SOCKET to be set"))
+	}
+
+	if strings.ContainsAny(cfg.BasePath, "?# ") {
+		errs = append(errs, fmt.Errorf("BASE_PATH: %q is not a valid path prefix", cfg.BasePath))
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return cfg, nil
+}
+
+// Addr is the address the HTTP server listens on
+func (c *AppConfig) Addr() string {
+	return "0.0.0.0:" + strconv.Itoa(c.Port)
+}
+
+// MCPAddr is the address of the dedicated MCP listener, empty when MCP is
+// served on the main HTTP server
+func (c *AppConfig) MCPAddr() string {
+	if c.MCPPort == 0 {
+		return ""
+	}
+	return "0.0.0.0:" + strconv.Itoa(c.MCPPort)
+}
+
+// APIBaseURL is where the CLI subcommands reach the API: PONT_URL, or this
+// host's PORT and BASE_PATH. The host is a placeholder when the CLI connects
+// over UNIX_SOCKET, see CLISocket.
+func (c *AppConfig) APIBaseURL() string {
+	if c.URL != "" {
+		return strings.TrimSuffix(c.URL, "/")
+	}
+	base := "http://127.0.0.1:" + strconv.Itoa(c.Port)
+	if c.CLISocket() != "" {
+		base = "http://pont"
+	}
+	if p := strings.Trim(c.BasePath, "/"); p != "" {
+		base += "/" + p
+	}
+	return base
+}
+
+// CLISocket is the Unix socket the CLI subcommands connect to: UNIX_SOCKET
+// when PONT_URL is not set, otherwise empty
+func (c *AppConfig) CLISocket() string {
+	if c.URL != "" {
+		return ""
+	}
+	return c.UnixSocket
+}
+
+// LogLocation is the timezone of log timestamps
+func (c *AppConfig) LogLocation() *time.Location {
+	return c.logLocation
+}
+
+// Effective returns the resolved configuration keyed by environment variable
+func (c *AppConfig) Effective() map[string]interface{} {
+	return map[string]interface{}{
+		"DATA_DIR":               c.DataDir,
+		"LOG_DIR":                c.LogDir,
+		"LOG_LEVEL":              c.LogLevel,
+		"LOG_TZ":                 c.logLocation.String(),
+		"LOG_BROADCAST_QUEUE":    c.LogBroadcastQueue,
+		"LOG_MAX_MESSAGE_SIZE":   c.LogMaxMessageSize,
+		"PORT":                   c.Port,
+		"MCP_PORT":               c.MCPPort,
+		"BASE_PATH":              c.BasePath,
+		"DEFAULT_LANG":           c.DefaultLang,
+		"PONT_URL":               c.URL,
+		"PONT_NAMESPACE":         c.Namespace,
+		"PONT_PRETTY_JSON":       c.PrettyJSON,
+		"DB_AUTO_MIGRATE":        c.DBAutoMigrate,
+		"DB_WAL":                 c.DBWAL,
+		"DB_MOVE_CORRUPT":        c.DBMoveCorrupt,
+		"STATUS_CACHE_TTL":       c.StatusCacheTTL.String(),
+		"CLOUDFLARE_URL_TIMEOUT": c.CloudflareURLTimeout.String(),
+		"MCP_START_WAIT":         c.MCPStartWait.String(),
+		"TARGET_ALLOWLIST":       c.TargetAllowlist.String(),
+		"TUNNEL_NAME_MAX_LEN":    c.TunnelNameMaxLen,
+		"TUNNEL_TARGET_MAX_LEN":  c.TunnelTargetMaxLen,
+		"NGROK_AUTHTOKEN":        c.NgrokAuthtoken != "", // only whether it is set
+		"AUTH_TOKEN":             c.AuthToken != "",
+		"AUTH_LOCALHOST_BYPASS":  c.AuthLocalhostBypass,
+		"HTTP_READ_TIMEOUT":      c.HTTPReadTimeout.String(),
+		"HTTP_WRITE_TIMEOUT":     c.HTTPWriteTimeout.String(),
+		"HTTP_IDLE_TIMEOUT":      c.HTTPIdleTimeout.String(),
+
+		"OTEL_EXPORTER_OTLP_ENDPOINT": c.OTLPEndpoint,
+		"CLOUDFLARE_STOP_TIMEOUT":     c.CloudflareStopTimeout.String(),
+
+		"ALLOW_HOOKS":  c.AllowHooks,
+		"HOOK_TIMEOUT": c.HookTimeout.String(),
+
+		"UNIX_SOCKET":      c.UnixSocket,
+		"UNIX_SOCKET_ONLY": c.UnixSocketOnly,
+
+		"PRUNE_RETENTION": c.PruneRetention.String(),
+		"PRUNE_INTERVAL":  c.PruneInterval.String(),
+	}
+}
+
+// envReader reads typed environment variables, collecting parse errors
+type envReader struct {
+	errs *[]error
+}
+
+func (e envReader) str(key, def string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return def
+}
+
+func (e envReader) bool(key string, def bool) bool {
+	v := os.Getenv(key)
+	if v == "" {
+		return def
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		*e.errs = append(*e.errs, fmt.Errorf("%s: %q is not a boolean", key, v))
+		return def
+	}
+	return b
+}
+
+func (e envReader) int(key string, def, min, max int) int {
+	v := os.Getenv(key)
+	if v == "" {
+		return def
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n < min || n > max {
+		*e.errs = append(*e.errs, fmt.Errorf("%s: %q must be an integer between %d and %d", key, v, min, max))
+		return def
+	}
+	return n
+}
+
+func (e envReader) duration(key string, def time.Duration) time.Duration {
+	v := os.Getenv(key)
+	if v == "" {
+		return def
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil || d < 0 {
+		*e.errs = append(*e.errs, fmt.Errorf("%s: %q is not a duration such as 30s", key, v))
+		return def
+	}
+	return d
+}
@@ -0,0 +1,40 @@
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLoadAppConfigHooksNeedAuthToken(t *testing.T) {
+	t.Setenv("DATA_DIR", t.TempDir())
+	t.Setenv("ALLOW_HOOKS", "true")
+	t.Setenv("AUTH_TOKEN", "")
+	if _, err := LoadAppConfig(); err == nil || !strings.Contains(err.Error(), "ALLOW_HOOKS: requires AUTH_TOKEN") {
+		t.Errorf("LoadAppConfig = %v, want ALLOW_HOOKS rejected", err)
+	}
+
+	t.Setenv("AUTH_TOKEN", "secret")
+	cfg, err := LoadAppConfig()
+	if err != nil {
+		t.Fatalf("LoadAppConfig: %v", err)
+	}
+	if !cfg.AllowHooks {
+		t.Error("AllowHooks not set")
+	}
+}
+
+// DB_AUTO_MIGRATE keeps its original parsing: only "false" disables it
+func TestLoadAppConfigAutoMigrate(t *testing.T) {
+	t.Setenv("DATA_DIR", t.TempDir())
+	for v, want := range map[string]bool{"": true, "true": true, "false": false, "yes": true, "0": true} {
+		t.Setenv("DB_AUTO_MIGRATE", v)
+		cfg, err := LoadAppConfig()
+		if err != nil {
+			t.Errorf("DB_AUTO_MIGRATE=%q: %v", v, err)
+			continue
+		}
+		if cfg.DBAutoMigrate != want {
+			t.Errorf("DB_AUTO_MIGRATE=%q gives %v, want %v", v, cfg.DBAutoMigrate, want)
+		}
+	}
+}
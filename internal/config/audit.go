@@ -0,0 +1,189 @@
+package config
+
+import (
+	"context"
+	"maps"
+	"pont/ent"
+	"pont/ent/auditlog"
+	"pont/internal/logger"
+	"sort"
+	"time"
+)
+
+// AuditEntry represents a recorded mutating operation
+type AuditEntry struct {
+	ID       int       `json:"id"`
+	Time     time.Time `json:"time"`
+	Actor    string    `json:"actor"`
+	Action   string    `json:"action"`
+	TunnelID string    `json:"tunnel_id,omitempty"`
+	Details  string    `json:"details,omitempty"`
+}
+
+// AuditFilter narrows down audit log queries; zero values match everything
+type AuditFilter struct {
+	Actor    string
+	Action   string
+	TunnelID string
+	Since    time.Time
+	Limit    int
+}
+
+// MaxAuditLimit bounds the number of audit entries returned by one query
+const MaxAuditLimit = 1000
+
+// RecordAudit stores an audit entry when the audit_log setting is enabled.
+// Failures are logged rather than returned so auditing never blocks an operation.
+func (m *Manager) RecordAudit(actor, action, tunnelID, details string) {
+	settings, err := m.GetSettings()
+	if err != nil || !settings.AuditLog {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	builder := m.client.AuditLog.Create().
+		SetActor(actor).
+		SetAction(action)
+	if tunnelID != "" {
+		builder.SetTunnelID(tunnelID)
+	}
+	if details != "" {
+		builder.SetDetails(details)
+	}
+
+	err = retryLocked(func() error {
+		_, err := builder.Save(context.Background())
+		return err
+	})
+	if err != nil {
+		logger.Sugar.Warnf("Failed to write audit entry %s: %v", action, err)
+	}
+}
+
+// ListAudit returns audit entries matching the filter, newest first
+func (m *Manager) ListAudit(filter AuditFilter) ([]AuditEntry, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	query := m.client.AuditLog.Query()
+	if filter.Actor != "" {
+		query = query.Where(auditlog.ActorEQ(filter.Actor))
+	}
+	if filter.Action != "" {
+		query = query.Where(auditlog.ActionEQ(filter.Action))
+	}
+	if filter.TunnelID != "" {
+		query = query.Where(auditlog.TunnelIDEQ(filter.TunnelID))
+	}
+	if !filter.Since.IsZero() {
+		query = query.Where(auditlog.CreatedAtGTE(filter.Since))
+	}
+
+	limit := filter.Limit
+	if limit <= 0 || limit > MaxAuditLimit {
+		limit = MaxAuditLimit
+	}
+
+	rows, err := query.
+		Order(ent.Desc(auditlog.FieldCreatedAt), ent.Desc(auditlog.FieldID)).
+		Limit(limit).
+		All(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]AuditEntry, len(rows))
+	for i, row := range rows {
+		entries[i] = AuditEntry{
+			ID:       row.ID,
+			Time:     row.CreatedAt,
+			Actor:    row.Actor,
+			Action:   row.Action,
+			TunnelID: row.TunnelID,
+			Details:  row.Details,
+		}
+	}
+	return entries, nil
+}
+
+// ChangedFields lists the names of the fields that differ between two tunnel
+// configurations. Secret values are compared but never included in the result.
+func ChangedFields(old, updated *TunnelConfig) []string {
+	changes := map[string]bool{
+		"name":            old.Name != updated.Name,
+		"type":            old.Type != updated.Type,
+		"target":          old.Target != updated.Target,
+		"group":           old.Group != updated.Group,
+		"enabled":         old.Enabled != updated.Enabled,
+		"mcp_enabled":     old.MCPEnabled != updated.MCPEnabled,
+		"favorite":        old.Favorite != updated.Favorite,
+		"error_grace":     old.ErrorGrace != updated.ErrorGrace,
+		"ngrok_authtoken": old.NgrokAuthtoken != updated.NgrokAuthtoken,
+		"ngrok_domain":    old.NgrokDomain != updated.NgrokDomain,
+
+		"ngrok_webhook_provider": old.NgrokWebhookProvider != updated.NgrokWebhookProvider,
+		"ngrok_webhook_secret":   old.NgrokWebhookSecret != updated.NgrokWebhookSecret,
+		"ngrok_internal":         old.NgrokInternal != updated.NgrokInternal,
+		"ngrok_rate_limit":       old.NgrokRateLimit != updated.NgrokRateLimit,
+		"ngrok_credential":       old.NgrokCredential != updated.NgrokCredential,
+
+		"cloudflare_connect_timeout":  old.CloudflareConnectTimeout != updated.CloudflareConnectTimeout,
+		"cloudflare_no_tls_verify":    old.CloudflareNoTLSVerify != updated.CloudflareNoTLSVerify,
+		"cloudflare_http_host_header": old.CloudflareHTTPHostHeader != updated.CloudflareHTTPHostHeader,
+		"cloudflare_region":           old.CloudflareRegion != updated.CloudflareRegion,
+		"cloudflare_env":              !maps.Equal(old.CloudflareEnv, updated.CloudflareEnv),
+		"cloudflare_error_page":       old.CloudflareErrorPage != updated.CloudflareErrorPage,
+
+		"probe_enabled":  old.ProbeEnabled != updated.ProbeEnabled,
+		"probe_interval": old.ProbeInterval != updated.ProbeInterval,
+		"probe_timeout":  old.ProbeTimeout != updated.ProbeTimeout,
+		"probe_retries":  old.ProbeRetries != updated.ProbeRetries,
+		"probe_path":     old.ProbePath != updated.ProbePath,
+		"idle_timeout":   old.IdleTimeout != updated.IdleTimeout,
+		"pre_start_hook": old.PreStartHook != updated.PreStartHook,
+		"post_stop_hook": old.PostStopHook != updated.PostStopHook,
+	}
+
+	var fields []string
+	for name, changed := range changes {
+		if changed {
+			fields = append(fields, name)
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// connectionFields are the tunnel fields a running tunnel only picks up when
+// it is restarted
+var connectionFields = map[string]bool{
+	"type":                        true,
+	"target":                      true,
+	"ngrok_authtoken":             true,
+	"ngrok_domain":                true,
+	"ngrok_webhook_provider":      true,
+	"ngrok_webhook_secret":        true,
+	"ngrok_internal":              true,
+	"ngrok_rate_limit":            true,
+	"ngrok_credential":            true,
+	"cloudflare_connect_timeout":  true,
+	"cloudflare_no_tls_verify":    true,
+	"cloudflare_http_host_header": true,
+	"cloudflare_region":           true,
+	"cloudflare_env":              true,
+	"cloudflare_error_page":       true,
+}
+
+// ConnectionFields filters the result of ChangedFields down to the fields
+// that need a restart to take effect
+func ConnectionFields(changed []string) []string {
+	var fields []string
+	for _, name := range changed {
+		if connectionFields[name] {
+			fields = append(fields, name)
+		}
+	}
+	return fields
+}
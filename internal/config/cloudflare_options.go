@@ -0,0 +1,137 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+// MaxCloudflareConnectTimeout bounds the origin connect timeout
+const MaxCloudflareConnectTimeout = 5 * time.Minute
+
+// MaxCloudflareErrorPageSize bounds the custom error page in bytes
+const MaxCloudflareErrorPageSize = 64 << 10
+
+// cloudflareOriginTLSEnv are the cloudflare_env variables that configure the
+// connection to the target. With an error page cloudflared connects to Pont's
+// proxy instead, so they would not apply.
+var cloudflareOriginTLSEnv = []string{"TUNNEL_ORIGIN_CERT", "TUNNEL_ORIGIN_SERVER_NAME", "TUNNEL_ORIGIN_CA_POOL", "TUNNEL_ORIGIN_ENABLE_HTTP2"}
+
+// CloudflareEnvFlags maps the cloudflared environment variables a tunnel may
+// set to the flag each one controls. cloudflared runs in-process, so instead of
+// touching the process environment the values are passed as flags to the run.
+var CloudflareEnvFlags = map[string]string{
+	"TUNNEL_ORIGIN_CERT":         "origincert",
+	"TUNNEL_ORIGIN_SERVER_NAME":  "origin-server-name",
+	"TUNNEL_ORIGIN_CA_POOL":      "origin-ca-pool",
+	"TUNNEL_ORIGIN_ENABLE_HTTP2": "http2-origin",
+	"TUNNEL_NO_CHUNKED_ENCODING": "no-chunked-encoding",
+	"TUNNEL_LOGLEVEL":            "loglevel",
+	"TUNNEL_TRANSPORT_LOGLEVEL":  "transport-loglevel",
+	"TUNNEL_TRANSPORT_PROTOCOL":  "protocol",
+	"TUNNEL_EDGE_IP_VERSION":     "edge-ip-version",
+	"TUNNEL_EDGE_BIND_ADDRESS":   "edge-bind-address",
+	"TUNNEL_RETRIES":             "retries",
+	"TUNNEL_GRACE_PERIOD":        "grace-period",
+	"TUNNEL_POST_QUANTUM":        "post-quantum",
+	"TUNNEL_COMPRESSION_LEVEL":   "compression-quality",
+	"TUNNEL_METRICS":             "metrics",
+	"TUNNEL_METRICS_UPDATE_FREQ": "metrics-update-freq",
+}
+
+// CloudflareRegions are the edge regions cloudflared can be pinned to with
+// --region, besides the default global region
+var CloudflareRegions = []string{"us"}
+
+// CheckCloudflareOptions validates the cloudflare origin request options
+func CheckCloudflareOptions(tunnel *TunnelConfig) error {
+	hasOptions := tunnel.CloudflareConnectTimeout != "" || tunnel.CloudflareNoTLSVerify ||
+		tunnel.CloudflareHTTPHostHeader != "" || tunnel.CloudflareRegion != "" || len(tunnel.CloudflareEnv) > 0 ||
+		tunnel.CloudflareErrorPage != ""
+	if !hasOptions {
+		return nil
+	}
+	if tunnel.Type != TunnelTypeCloudflare {
+		return fmt.Errorf("cloudflare origin options are only supported for cloudflare tunnels")
+	}
+
+	if tunnel.CloudflareConnectTimeout != "" {
+		d, err := time.ParseDuration(tunnel.CloudflareConnectTimeout)
+		if err != nil {
+			return fmt.Errorf("invalid cloudflare_connect_timeout %q: use a duration such as 30s", tunnel.CloudflareConnectTimeout)
+		}
+		if d <= 0 || d > MaxCloudflareConnectTimeout {
+			return fmt.Errorf("cloudflare_connect_timeout must be a positive duration up to %s", MaxCloudflareConnectTimeout)
+		}
+	}
+
+	if tunnel.CloudflareNoTLSVerify && !strings.HasPrefix(tunnel.Target, "https://") {
+		return fmt.Errorf("cloudflare_no_tls_verify only applies to https:// targets")
+	}
+
+	if host := tunnel.CloudflareHTTPHostHeader; host != "" && strings.ContainsAny(host, " \t\r\n/") {
+		return fmt.Errorf("invalid cloudflare_http_host_header %q", host)
+	}
+
+	if region := tunnel.CloudflareRegion; region != "" && !slices.Contains(CloudflareRegions, region) {
+		return fmt.Errorf("unsupported cloudflare_region %q, must be one of: %s (leave empty for the global region)",
+			region, strings.Join(CloudflareRegions, ", "))
+	}
+
+	if tunnel.CloudflareErrorPage != "" {
+		if len(tunnel.CloudflareErrorPage) > MaxCloudflareErrorPageSize {
+			return fmt.Errorf("cloudflare_error_page must be at most %d bytes", MaxCloudflareErrorPageSize)
+		}
+		for _, key := range cloudflareOriginTLSEnv {
+			if _, ok := tunnel.CloudflareEnv[key]; ok {
+				return fmt.Errorf("cloudflare_env %s cannot be combined with cloudflare_error_page", key)
+			}
+		}
+	}
+
+	for key := range tunnel.CloudflareEnv {
+		if _, ok := CloudflareEnvFlags[key]; !ok {
+			supported := make([]string, 0, len(CloudflareEnvFlags))
+			for k := range CloudflareEnvFlags {
+				supported = append(supported, k)
+			}
+			sort.Strings(supported)
+			return fmt.Errorf("unsupported cloudflare_env variable %q, must be one of: %s",
+				key, strings.Join(supported, ", "))
+		}
+	}
+
+	return nil
+}
+
+// CloudflaredArgs returns the cloudflared flags for the origin options and environment
+func CloudflaredArgs(tunnel *TunnelConfig) []string {
+	var args []string
+	if tunnel.CloudflareConnectTimeout != "" {
+		args = append(args, "--proxy-connect-timeout", tunnel.CloudflareConnectTimeout)
+	}
+	if tunnel.CloudflareNoTLSVerify {
+		args = append(args, "--no-tls-verify")
+	}
+	if tunnel.CloudflareHTTPHostHeader != "" {
+		args = append(args, "--http-host-header", tunnel.CloudflareHTTPHostHeader)
+	}
+	if tunnel.CloudflareRegion != "" {
+		args = append(args, "--region", tunnel.CloudflareRegion)
+	}
+
+	// Sorted so the command line is stable in logs
+	keys := make([]string, 0, len(tunnel.CloudflareEnv))
+	for key := range tunnel.CloudflareEnv {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if flag, ok := CloudflareEnvFlags[key]; ok {
+			args = append(args, "--"+flag+"="+tunnel.CloudflareEnv[key])
+		}
+	}
+	return args
+}
@@ -0,0 +1,29 @@
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCheckCloudflareConnectTimeout(t *testing.T) {
+	tests := []struct {
+		timeout string
+		wantErr string
+	}{
+		{"30s", ""},
+		{"5m", ""},
+		{"0s", "a positive duration up to 5m0s"},
+		{"-1s", "a positive duration up to 5m0s"},
+		{"6m", "a positive duration up to 5m0s"},
+		{"soon", "use a duration such as 30s"},
+	}
+	for _, tt := range tests {
+		err := CheckCloudflareOptions(&TunnelConfig{Type: TunnelTypeCloudflare, CloudflareConnectTimeout: tt.timeout})
+		switch {
+		case tt.wantErr == "" && err != nil:
+			t.Errorf("cloudflare_connect_timeout %q: %v", tt.timeout, err)
+		case tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)):
+			t.Errorf("cloudflare_connect_timeout %q = %v, want an error containing %q", tt.timeout, err, tt.wantErr)
+		}
+	}
+}
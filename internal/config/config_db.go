@@ -2,10 +2,18 @@ package config
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net"
+	"net/url"
 	"pont/ent"
 	"pont/ent/setting"
 	"pont/ent/tunnel"
+	"pont/ent/urlhistory"
+	"pont/internal/logger"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -26,39 +34,166 @@ type TunnelConfig struct {
 	Name       string     `json:"name"`
 	Type       TunnelType `json:"type"`
 	Target     string     `json:"target"`
+	Group      string     `json:"group,omitempty"`
 	Enabled    bool       `json:"enabled"`
 	MCPEnabled bool       `json:"mcp_enabled"`
+	Favorite   bool       `json:"favorite"`
+	Archived   bool       `json:"archived"`
+	Paused     bool       `json:"paused"`                // set by pausing the tunnel, not by updates
+	ErrorGrace string     `json:"error_grace,omitempty"` // Go duration, e.g. "30s"
 	CreatedAt  time.Time  `json:"created_at"`
 	UpdatedAt  time.Time  `json:"updated_at"`
 
 	// Ngrok-specific fields
 	NgrokAuthtoken string `json:"ngrok_authtoken,omitempty"`
 	NgrokDomain    string `json:"ngrok_domain,omitempty"`
+
+	// NgrokCredential names a stored NgrokCredential to use instead of an
+	// inline NgrokAuthtoken
+	NgrokCredential string `json:"ngrok_credential,omitempty"`
+
+	// NgrokWebhookProvider enables edge verification of webhook signatures,
+	// e.g. "github" or "stripe", using NgrokWebhookSecret
+	NgrokWebhookProvider string `json:"ngrok_webhook_provider,omitempty"`
+	NgrokWebhookSecret   string `json:"ngrok_webhook_secret,omitempty"`
+
+	// NgrokInternal creates an internal endpoint, reachable only from other
+	// ngrok endpoints; NgrokDomain must then be a *.internal URL
+	NgrokInternal bool `json:"ngrok_internal,omitempty"`
+
+	// NgrokRateLimit caps requests per client IP at the edge, written as
+	// "<requests>/<window>", e.g. "100/1m", see ParseRateLimit
+	NgrokRateLimit string `json:"ngrok_rate_limit,omitempty"`
+
+	// Cloudflare origin request options
+	CloudflareConnectTimeout string `json:"cloudflare_connect_timeout,omitempty"` // Go duration, e.g. "45s"
+	CloudflareNoTLSVerify    bool   `json:"cloudflare_no_tls_verify,omitempty"`
+	CloudflareHTTPHostHeader string `json:"cloudflare_http_host_header,omitempty"`
+
+	// CloudflareRegion pins the edge region cloudflared connects to, see
+	// CloudflareRegions; empty uses the global region
+	CloudflareRegion string `json:"cloudflare_region,omitempty"`
+
+	// CloudflareEnv holds cloudflared environment variables, see CloudflareEnvFlags
+	CloudflareEnv map[string]string `json:"cloudflare_env,omitempty"`
+
+	// CloudflareErrorPage is HTML served instead of cloudflared's error page
+	// while the target is unreachable; Pont then proxies the target itself
+	CloudflareErrorPage string `json:"cloudflare_error_page,omitempty"`
+
+	// ProbeEnabled periodically requests the public URL of a running HTTP
+	// tunnel every ProbeInterval (Go duration, DefaultProbeInterval when empty)
+	ProbeEnabled  bool   `json:"probe_enabled,omitempty"`
+	ProbeInterval string `json:"probe_interval,omitempty"`
+
+	// A probe requests ProbePath (default "/") and waits ProbeTimeout (Go
+	// duration, DefaultProbeTimeout when empty) for each of 1+ProbeRetries
+	// attempts before reporting the tunnel unreachable
+	ProbeTimeout string `json:"probe_timeout,omitempty"`
+	ProbeRetries int    `json:"probe_retries,omitempty"`
+	ProbePath    string `json:"probe_path,omitempty"`
+
+	// IdleTimeout stops a running tunnel after this long without traffic
+	// (Go duration, empty never stops it), see IdleTimeoutDuration
+	IdleTimeout string `json:"idle_timeout,omitempty"`
+
+	// Shell commands run before the tunnel starts and after it stops, only
+	// when ALLOW_HOOKS is set
+	PreStartHook string `json:"pre_start_hook,omitempty"`
+	PostStopHook string `json:"post_stop_hook,omitempty"`
 }
 
 // Settings represents global application settings
 type Settings struct {
-	AutoStart bool   `json:"auto_start"`
-	LogLevel  string `json:"log_level"`
+	AutoStart         bool     `json:"auto_start"`
+	LogLevel          string   `json:"log_level"`
+	MaxRunningTunnels int      `json:"max_running_tunnels"` // 0 means unlimited
+	TrustedProxies    []string `json:"trusted_proxies"`     // CIDRs or IPs allowed to set X-Forwarded-For
+	AuditLog          bool     `json:"audit_log"`
+	WebhookURL        string   `json:"webhook_url"` // receives tunnel notifications, empty disables them
+	RestartOnUpdate   bool     `json:"restart_on_update"`
 }
 
 // Manager manages configuration with database storage
 type Manager struct {
-	mu     sync.RWMutex
-	client *ent.Client
+	mu        sync.RWMutex
+	client    *ent.Client
+	namespace string
+	allowlist *TargetAllowlist
+
+	// Length limits of names and targets in bytes, see SetLengthLimits
+	nameMaxLen   int
+	targetMaxLen int
+
+	// allowHooks permits tunnels to have hooks, see SetAllowHooks
+	allowHooks bool
 }
 
-// NewManager creates a new configuration manager
-func NewManager(client *ent.Client) *Manager {
-	return &Manager{client: client}
+// Tunnel name and target length limits. The Max values are enforced by the
+// database schema; the Default values apply unless SetLengthLimits lowers or
+// raises them up to the Max.
+const (
+	MaxTunnelNameLength       = 255
+	MaxTunnelTargetLength     = 2048
+	DefaultTunnelNameLength   = 100
+	DefaultTunnelTargetLength = 2048
+)
+
+// NewManager creates a new configuration manager. A non-empty namespace is
+// shown as a "namespace/" prefix on every tunnel name this instance returns.
+func NewManager(client *ent.Client, namespace string) *Manager {
+	return &Manager{
+		client:       client,
+		namespace:    strings.Trim(namespace, "/"),
+		nameMaxLen:   DefaultTunnelNameLength,
+		targetMaxLen: DefaultTunnelTargetLength,
+	}
 }
 
-// GetAllTunnels returns all tunnel configurations
+// SetLengthLimits sets the maximum length in bytes of tunnel names and
+// targets, capped at the schema limits; 0 keeps the default
+func (m *Manager) SetLengthLimits(name, target int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if name > 0 {
+		m.nameMaxLen = min(name, MaxTunnelNameLength)
+	}
+	if target > 0 {
+		m.targetMaxLen = min(target, MaxTunnelTargetLength)
+	}
+}
+
+// SetTargetAllowlist restricts the targets tunnels may be saved or started
+// with; nil allows every target
+func (m *Manager) SetTargetAllowlist(a *TargetAllowlist) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.allowlist = a
+}
+
+// CheckTarget checks a resolved target against the target allowlist
+func (m *Manager) CheckTarget(target string) error {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.allowlist.Check(target)
+}
+
+// GetAllTunnels returns all non-archived tunnel configurations
 func (m *Manager) GetAllTunnels() ([]TunnelConfig, error) {
+	return m.ListTunnels(false)
+}
+
+// ListTunnels returns tunnel configurations, optionally including archived ones
+func (m *Manager) ListTunnels(includeArchived bool) ([]TunnelConfig, error) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
-	tunnels, err := m.client.Tunnel.Query().
+	query := m.client.Tunnel.Query()
+	if !includeArchived {
+		query = query.Where(tunnel.ArchivedEQ(false))
+	}
+
+	tunnels, err := query.
 		Order(ent.Desc(tunnel.FieldCreatedAt)).
 		All(context.Background())
 	if err != nil {
@@ -67,53 +202,81 @@ func (m *Manager) GetAllTunnels() ([]TunnelConfig, error) {
 
 	configs := make([]TunnelConfig, len(tunnels))
 	for i, t := range tunnels {
-		configs[i] = TunnelConfig{
-			ID:             t.ID.String(),
-			Name:           t.Name,
-			Type:           TunnelType(t.Type),
-			Target:         t.Target,
-			Enabled:        t.Enabled,
-			MCPEnabled:     t.McpEnabled,
-			CreatedAt:      t.CreatedAt,
-			UpdatedAt:      t.UpdatedAt,
-			NgrokAuthtoken: stringPtrToString(t.NgrokAuthtoken),
-			NgrokDomain:    stringPtrToString(t.NgrokDomain),
-		}
+		configs[i] = *m.toTunnelConfig(t)
 	}
 
 	return configs, nil
 }
 
+// CountTunnelsByType returns the number of non-archived tunnels of each type,
+// counted in the database without loading the tunnels
+func (m *Manager) CountTunnelsByType() (map[TunnelType]int, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var rows []struct {
+		Type  tunnel.Type `json:"type"`
+		Count int         `json:"count"`
+	}
+	err := m.client.Tunnel.Query().
+		Where(tunnel.ArchivedEQ(false)).
+		GroupBy(tunnel.FieldType).
+		Aggregate(ent.Count()).
+		Scan(context.Background(), &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[TunnelType]int, len(rows))
+	for _, row := range rows {
+		counts[TunnelType(row.Type)] = row.Count
+	}
+	return counts, nil
+}
+
+// ErrTunnelNotFound is returned for IDs that match no tunnel
+var ErrTunnelNotFound = errors.New("tunnel not found")
+
 // GetTunnel returns a specific tunnel configuration
 func (m *Manager) GetTunnel(id string) (*TunnelConfig, error) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
+	// A malformed ID matches no tunnel either
 	uid, err := uuid.Parse(id)
 	if err != nil {
-		return nil, fmt.Errorf("invalid tunnel id: %w", err)
+		return nil, fmt.Errorf("%w: invalid id %q", ErrTunnelNotFound, id)
 	}
 
 	t, err := m.client.Tunnel.Get(context.Background(), uid)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return nil, fmt.Errorf("tunnel not found: %s", id)
+			return nil, fmt.Errorf("%w: %s", ErrTunnelNotFound, id)
 		}
 		return nil, err
 	}
 
-	return &TunnelConfig{
-		ID:             t.ID.String(),
-		Name:           t.Name,
-		Type:           TunnelType(t.Type),
-		Target:         t.Target,
-		Enabled:        t.Enabled,
-		MCPEnabled:     t.McpEnabled,
-		CreatedAt:      t.CreatedAt,
-		UpdatedAt:      t.UpdatedAt,
-		NgrokAuthtoken: stringPtrToString(t.NgrokAuthtoken),
-		NgrokDomain:    stringPtrToString(t.NgrokDomain),
-	}, nil
+	return m.toTunnelConfig(t), nil
+}
+
+// CheckNewTunnel runs the checks AddTunnel does, without saving anything.
+// tunnelCfg is not modified.
+func (m *Manager) CheckNewTunnel(tunnelCfg *TunnelConfig) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	check := *tunnelCfg
+	return m.checkNewTunnel(&check)
+}
+
+// checkNewTunnel strips the namespace from the name and validates a tunnel
+// about to be created; m.mu must be held
+func (m *Manager) checkNewTunnel(tunnelCfg *TunnelConfig) error {
+	tunnelCfg.Name = m.stripNamespace(tunnelCfg.Name)
+	if err := m.validateTunnel(tunnelCfg, nil); err != nil {
+		return err
+	}
+	return m.checkDuplicateName(tunnelCfg.Name, uuid.Nil)
 }
 
 // AddTunnel adds a new tunnel configuration
@@ -121,7 +284,7 @@ func (m *Manager) AddTunnel(tunnelCfg *TunnelConfig) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	if err := m.validateTunnel(tunnelCfg); err != nil {
+	if err := m.checkNewTunnel(tunnelCfg); err != nil {
 		return err
 	}
 
@@ -143,7 +306,8 @@ func (m *Manager) AddTunnel(tunnelCfg *TunnelConfig) error {
 		SetType(tunnel.Type(tunnelCfg.Type)).
 		SetTarget(tunnelCfg.Target).
 		SetEnabled(tunnelCfg.Enabled).
-		SetMcpEnabled(tunnelCfg.MCPEnabled)
+		SetMcpEnabled(tunnelCfg.MCPEnabled).
+		SetFavorite(tunnelCfg.Favorite)
 
 	if tunnelCfg.NgrokAuthtoken != "" {
 		builder.SetNillableNgrokAuthtoken(&tunnelCfg.NgrokAuthtoken)
@@ -151,12 +315,72 @@ func (m *Manager) AddTunnel(tunnelCfg *TunnelConfig) error {
 	if tunnelCfg.NgrokDomain != "" {
 		builder.SetNillableNgrokDomain(&tunnelCfg.NgrokDomain)
 	}
+	if tunnelCfg.NgrokWebhookProvider != "" {
+		builder.SetNillableNgrokWebhookProvider(&tunnelCfg.NgrokWebhookProvider)
+	}
+	if tunnelCfg.NgrokWebhookSecret != "" {
+		builder.SetNillableNgrokWebhookSecret(&tunnelCfg.NgrokWebhookSecret)
+	}
+	if tunnelCfg.NgrokCredential != "" {
+		builder.SetNillableNgrokCredential(&tunnelCfg.NgrokCredential)
+	}
+	if tunnelCfg.NgrokRateLimit != "" {
+		builder.SetNillableNgrokRateLimit(&tunnelCfg.NgrokRateLimit)
+	}
+	if tunnelCfg.CloudflareConnectTimeout != "" {
+		builder.SetNillableCloudflareConnectTimeout(&tunnelCfg.CloudflareConnectTimeout)
+	}
+	if tunnelCfg.CloudflareHTTPHostHeader != "" {
+		builder.SetNillableCloudflareHTTPHostHeader(&tunnelCfg.CloudflareHTTPHostHeader)
+	}
+	if tunnelCfg.CloudflareRegion != "" {
+		builder.SetNillableCloudflareRegion(&tunnelCfg.CloudflareRegion)
+	}
+	builder.SetCloudflareNoTLSVerify(tunnelCfg.CloudflareNoTLSVerify)
+	builder.SetNgrokInternal(tunnelCfg.NgrokInternal)
+	if len(tunnelCfg.CloudflareEnv) > 0 {
+		builder.SetCloudflareEnv(tunnelCfg.CloudflareEnv)
+	}
+	if tunnelCfg.CloudflareErrorPage != "" {
+		builder.SetNillableCloudflareErrorPage(&tunnelCfg.CloudflareErrorPage)
+	}
+	if tunnelCfg.Group != "" {
+		builder.SetNillableGroup(&tunnelCfg.Group)
+	}
+	if tunnelCfg.ErrorGrace != "" {
+		builder.SetNillableErrorGrace(&tunnelCfg.ErrorGrace)
+	}
+	builder.SetProbeEnabled(tunnelCfg.ProbeEnabled)
+	if tunnelCfg.ProbeInterval != "" {
+		builder.SetNillableProbeInterval(&tunnelCfg.ProbeInterval)
+	}
+	if tunnelCfg.ProbeTimeout != "" {
+		builder.SetNillableProbeTimeout(&tunnelCfg.ProbeTimeout)
+	}
+	builder.SetProbeRetries(tunnelCfg.ProbeRetries)
+	if tunnelCfg.ProbePath != "" {
+		builder.SetNillableProbePath(&tunnelCfg.ProbePath)
+	}
+	if tunnelCfg.IdleTimeout != "" {
+		builder.SetNillableIdleTimeout(&tunnelCfg.IdleTimeout)
+	}
+	if tunnelCfg.PreStartHook != "" {
+		builder.SetNillablePreStartHook(&tunnelCfg.PreStartHook)
+	}
+	if tunnelCfg.PostStopHook != "" {
+		builder.SetNillablePostStopHook(&tunnelCfg.PostStopHook)
+	}
 
-	t, err := builder.Save(context.Background())
+	var t *ent.Tunnel
+	err := retryLocked(func() (err error) {
+		t, err = builder.Save(context.Background())
+		return err
+	})
 	if err != nil {
 		return err
 	}
 
+	tunnelCfg.Name = m.displayName(t.Name)
 	tunnelCfg.CreatedAt = t.CreatedAt
 	tunnelCfg.UpdatedAt = t.UpdatedAt
 
@@ -168,21 +392,33 @@ func (m *Manager) UpdateTunnel(id string, tunnelCfg *TunnelConfig) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	if err := m.validateTunnel(tunnelCfg); err != nil {
-		return err
-	}
-
 	uid, err := uuid.Parse(id)
 	if err != nil {
 		return fmt.Errorf("invalid tunnel id: %w", err)
 	}
+	stored, err := m.client.Tunnel.Get(context.Background(), uid)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("%w: %s", ErrTunnelNotFound, id)
+		}
+		return err
+	}
+
+	tunnelCfg.Name = m.stripNamespace(tunnelCfg.Name)
+	if err := m.validateTunnel(tunnelCfg, stored); err != nil {
+		return err
+	}
+	if err := m.checkDuplicateName(tunnelCfg.Name, uid); err != nil {
+		return err
+	}
 
 	builder := m.client.Tunnel.UpdateOneID(uid).
 		SetName(tunnelCfg.Name).
 		SetType(tunnel.Type(tunnelCfg.Type)).
 		SetTarget(tunnelCfg.Target).
 		SetEnabled(tunnelCfg.Enabled).
-		SetMcpEnabled(tunnelCfg.MCPEnabled)
+		SetMcpEnabled(tunnelCfg.MCPEnabled).
+		SetFavorite(tunnelCfg.Favorite)
 
 	if tunnelCfg.NgrokAuthtoken != "" {
 		builder.SetNillableNgrokAuthtoken(&tunnelCfg.NgrokAuthtoken)
@@ -196,33 +432,237 @@ func (m *Manager) UpdateTunnel(id string, tunnelCfg *TunnelConfig) error {
 		builder.ClearNgrokDomain()
 	}
 
-	t, err := builder.Save(context.Background())
+	if tunnelCfg.NgrokWebhookProvider != "" {
+		builder.SetNillableNgrokWebhookProvider(&tunnelCfg.NgrokWebhookProvider)
+	} else {
+		builder.ClearNgrokWebhookProvider()
+	}
+
+	if tunnelCfg.NgrokWebhookSecret != "" {
+		builder.SetNillableNgrokWebhookSecret(&tunnelCfg.NgrokWebhookSecret)
+	} else {
+		builder.ClearNgrokWebhookSecret()
+	}
+	if tunnelCfg.NgrokCredential != "" {
+		builder.SetNillableNgrokCredential(&tunnelCfg.NgrokCredential)
+	} else {
+		builder.ClearNgrokCredential()
+	}
+	if tunnelCfg.NgrokRateLimit != "" {
+		builder.SetNillableNgrokRateLimit(&tunnelCfg.NgrokRateLimit)
+	} else {
+		builder.ClearNgrokRateLimit()
+	}
+
+	if tunnelCfg.CloudflareConnectTimeout != "" {
+		builder.SetNillableCloudflareConnectTimeout(&tunnelCfg.CloudflareConnectTimeout)
+	} else {
+		builder.ClearCloudflareConnectTimeout()
+	}
+
+	if tunnelCfg.CloudflareHTTPHostHeader != "" {
+		builder.SetNillableCloudflareHTTPHostHeader(&tunnelCfg.CloudflareHTTPHostHeader)
+	} else {
+		builder.ClearCloudflareHTTPHostHeader()
+	}
+
+	if tunnelCfg.CloudflareRegion != "" {
+		builder.SetNillableCloudflareRegion(&tunnelCfg.CloudflareRegion)
+	} else {
+		builder.ClearCloudflareRegion()
+	}
+
+	builder.SetCloudflareNoTLSVerify(tunnelCfg.CloudflareNoTLSVerify)
+	builder.SetNgrokInternal(tunnelCfg.NgrokInternal)
+
+	if len(tunnelCfg.CloudflareEnv) > 0 {
+		builder.SetCloudflareEnv(tunnelCfg.CloudflareEnv)
+	} else {
+		builder.ClearCloudflareEnv()
+	}
+
+	if tunnelCfg.CloudflareErrorPage != "" {
+		builder.SetNillableCloudflareErrorPage(&tunnelCfg.CloudflareErrorPage)
+	} else {
+		builder.ClearCloudflareErrorPage()
+	}
+
+	if tunnelCfg.ErrorGrace != "" {
+		builder.SetNillableErrorGrace(&tunnelCfg.ErrorGrace)
+	} else {
+		builder.ClearErrorGrace()
+	}
+
+	builder.SetProbeEnabled(tunnelCfg.ProbeEnabled)
+	if tunnelCfg.ProbeInterval != "" {
+		builder.SetNillableProbeInterval(&tunnelCfg.ProbeInterval)
+	} else {
+		builder.ClearProbeInterval()
+	}
+
+	if tunnelCfg.ProbeTimeout != "" {
+		builder.SetNillableProbeTimeout(&tunnelCfg.ProbeTimeout)
+	} else {
+		builder.ClearProbeTimeout()
+	}
+
+	builder.SetProbeRetries(tunnelCfg.ProbeRetries)
+
+	if tunnelCfg.ProbePath != "" {
+		builder.SetNillableProbePath(&tunnelCfg.ProbePath)
+	} else {
+		builder.ClearProbePath()
+	}
+
+	if tunnelCfg.IdleTimeout != "" {
+		builder.SetNillableIdleTimeout(&tunnelCfg.IdleTimeout)
+	} else {
+		builder.ClearIdleTimeout()
+	}
+
+	if tunnelCfg.PreStartHook != "" {
+		builder.SetNillablePreStartHook(&tunnelCfg.PreStartHook)
+	} else {
+		builder.ClearPreStartHook()
+	}
+	if tunnelCfg.PostStopHook != "" {
+		builder.SetNillablePostStopHook(&tunnelCfg.PostStopHook)
+	} else {
+		builder.ClearPostStopHook()
+	}
+
+	if tunnelCfg.Group != "" {
+		builder.SetNillableGroup(&tunnelCfg.Group)
+	} else {
+		builder.ClearGroup()
+	}
+
+	var t *ent.Tunnel
+	err = retryLocked(func() (err error) {
+		t, err = builder.Save(context.Background())
+		return err
+	})
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return fmt.Errorf("tunnel not found: %s", id)
+			return fmt.Errorf("%w: %s", ErrTunnelNotFound, id)
 		}
 		return err
 	}
 
+	tunnelCfg.Name = m.displayName(t.Name)
 	tunnelCfg.UpdatedAt = t.UpdatedAt
 
 	return nil
 }
 
-// DeleteTunnel deletes a tunnel configuration
+// DeleteTunnel permanently deletes a tunnel configuration
 func (m *Manager) DeleteTunnel(id string) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
 	uid, err := uuid.Parse(id)
 	if err != nil {
-		return fmt.Errorf("invalid tunnel id: %w", err)
+		return fmt.Errorf("%w: invalid id %q", ErrTunnelNotFound, id)
+	}
+
+	err = retryLocked(func() error {
+		return m.client.Tunnel.DeleteOneID(uid).Exec(context.Background())
+	})
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("%w: %s", ErrTunnelNotFound, id)
+		}
+		return err
+	}
+
+	// The URL history has no foreign key, clean it up with the tunnel
+	_, err = m.client.URLHistory.Delete().Where(urlhistory.TunnelIDEQ(id)).Exec(context.Background())
+	if err != nil {
+		logger.Sugar.Warnf("Failed to delete URL history of tunnel %s: %v", id, err)
+	}
+
+	return nil
+}
+
+// ArchiveTunnel hides a tunnel from listings without deleting its configuration
+func (m *Manager) ArchiveTunnel(id string) error {
+	return m.setArchived(id, true)
+}
+
+// ErrRestoreConflict is returned when restoring a tunnel whose name another
+// tunnel took while it was archived
+var ErrRestoreConflict = errors.New("cannot restore tunnel")
+
+// RestoreTunnel brings an archived tunnel back into listings
+func (m *Manager) RestoreTunnel(id string) error {
+	return m.setArchived(id, false)
+}
+
+// SetPaused records whether a tunnel is paused, so the pause survives a
+// restart
+func (m *Manager) SetPaused(id string, paused bool) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return fmt.Errorf("%w: invalid id %q", ErrTunnelNotFound, id)
+	}
+	err = retryLocked(func() error {
+		return m.client.Tunnel.UpdateOneID(uid).SetPaused(paused).Exec(context.Background())
+	})
+	if ent.IsNotFound(err) {
+		return fmt.Errorf("%w: %s", ErrTunnelNotFound, id)
+	}
+	return err
+}
+
+// PausedTunnelIDs returns the IDs of non-archived paused tunnels
+func (m *Manager) PausedTunnelIDs() ([]string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	ids, err := m.client.Tunnel.Query().
+		Where(tunnel.PausedEQ(true), tunnel.ArchivedEQ(false)).
+		IDs(context.Background())
+	if err != nil {
+		return nil, err
 	}
+	result := make([]string, len(ids))
+	for i, id := range ids {
+		result[i] = id.String()
+	}
+	return result, nil
+}
+
+func (m *Manager) setArchived(id string, archived bool) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 
-	err = m.client.Tunnel.DeleteOneID(uid).Exec(context.Background())
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return fmt.Errorf("%w: invalid id %q", ErrTunnelNotFound, id)
+	}
+
+	if !archived {
+		t, err := m.client.Tunnel.Get(context.Background(), uid)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return fmt.Errorf("%w: %s", ErrTunnelNotFound, id)
+			}
+			return err
+		}
+		if err := m.checkDuplicateName(t.Name, uid); err != nil {
+			return fmt.Errorf("%w: %v", ErrRestoreConflict, err)
+		}
+	}
+
+	err = retryLocked(func() error {
+		return m.client.Tunnel.UpdateOneID(uid).SetArchived(archived).Exec(context.Background())
+	})
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return fmt.Errorf("tunnel not found: %s", id)
+			return fmt.Errorf("%w: %s", ErrTunnelNotFound, id)
 		}
 		return err
 	}
@@ -234,10 +674,17 @@ func (m *Manager) DeleteTunnel(id string) error {
 func (m *Manager) GetSettings() (*Settings, error) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
+	return m.loadSettings()
+}
 
+// loadSettings reads the settings, with defaults for those never saved.
+// Caller must hold m.mu.
+func (m *Manager) loadSettings() (*Settings, error) {
 	settings := &Settings{
-		AutoStart: false,
-		LogLevel:  "info",
+		AutoStart:       false,
+		LogLevel:        "info",
+		TrustedProxies:  []string{},
+		RestartOnUpdate: true,
 	}
 
 	settingsList, err := m.client.Setting.Query().All(context.Background())
@@ -251,6 +698,20 @@ func (m *Manager) GetSettings() (*Settings, error) {
 			settings.AutoStart = s.Value == "true"
 		case "log_level":
 			settings.LogLevel = s.Value
+		case "max_running_tunnels":
+			if n, err := strconv.Atoi(s.Value); err == nil {
+				settings.MaxRunningTunnels = n
+			}
+		case "trusted_proxies":
+			if s.Value != "" {
+				settings.TrustedProxies = strings.Split(s.Value, ",")
+			}
+		case "audit_log":
+			settings.AuditLog = s.Value == "true"
+		case "webhook_url":
+			settings.WebhookURL = s.Value
+		case "restart_on_update":
+			settings.RestartOnUpdate = s.Value == "true"
 		}
 	}
 
@@ -261,56 +722,134 @@ func (m *Manager) GetSettings() (*Settings, error) {
 func (m *Manager) UpdateSettings(settings *Settings) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
+	return m.saveSettings(settings)
+}
 
-	ctx := context.Background()
+// PatchSettings applies the settings present in a JSON object to the
+// current ones and saves the result; settings left out keep their values.
+// Reading and saving happen under one lock, so concurrent patches of
+// different settings do not undo each other.
+func (m *Manager) PatchSettings(patch []byte) (*Settings, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 
-	autoStart := "false"
-	if settings.AutoStart {
-		autoStart = "true"
+	settings, err := m.loadSettings()
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(patch, settings); err != nil {
+		return nil, err
 	}
+	if err := m.saveSettings(settings); err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
 
-	// Update or create auto_start
-	existing, err := m.client.Setting.Query().Where(setting.KeyEQ("auto_start")).First(ctx)
-	if err != nil && !ent.IsNotFound(err) {
+// saveSettings validates and writes all settings. Caller must hold m.mu.
+func (m *Manager) saveSettings(settings *Settings) error {
+	if settings.LogLevel == "" {
+		settings.LogLevel = "info"
+	}
+	if err := validateEnumSetting("log_level", settings.LogLevel); err != nil {
 		return err
 	}
-	if existing != nil {
-		_, err = m.client.Setting.UpdateOne(existing).SetValue(autoStart).Save(ctx)
+	if settings.MaxRunningTunnels < 0 {
+		return fmt.Errorf("max_running_tunnels must not be negative")
+	}
+	if _, err := ParseTrustedProxies(settings.TrustedProxies); err != nil {
+		return err
+	}
+	settings.WebhookURL = strings.TrimSpace(settings.WebhookURL)
+	if err := validateWebhookURL(settings.WebhookURL); err != nil {
+		return err
+	}
+
+	values := []struct{ key, value string }{
+		{"auto_start", strconv.FormatBool(settings.AutoStart)},
+		{"log_level", settings.LogLevel},
+		{"max_running_tunnels", strconv.Itoa(settings.MaxRunningTunnels)},
+		{"trusted_proxies", strings.Join(settings.TrustedProxies, ",")},
+		{"audit_log", strconv.FormatBool(settings.AuditLog)},
+		{"webhook_url", settings.WebhookURL},
+		{"restart_on_update", strconv.FormatBool(settings.RestartOnUpdate)},
+	}
+
+	// Write all settings in one transaction so concurrent updates never
+	// leave a mix of both; the upsert avoids racing on the unique key
+	return retryLocked(func() error {
+		ctx := context.Background()
+		tx, err := m.client.Tx(ctx)
 		if err != nil {
 			return err
 		}
-	} else {
-		_, err = m.client.Setting.Create().SetKey("auto_start").SetValue(autoStart).Save(ctx)
-		if err != nil {
-			return err
+		for _, v := range values {
+			err := tx.Setting.Create().
+				SetKey(v.key).
+				SetValue(v.value).
+				OnConflictColumns(setting.FieldKey).
+				UpdateValue().
+				Exec(ctx)
+			if err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to save setting %s: %w", v.key, err)
+			}
 		}
-	}
+		return tx.Commit()
+	})
+}
 
-	// Update or create log_level
-	existing, err = m.client.Setting.Query().Where(setting.KeyEQ("log_level")).First(ctx)
-	if err != nil && !ent.IsNotFound(err) {
-		return err
+// validateWebhookURL accepts an empty URL (notifications off) or an absolute
+// http(s) URL
+func validateWebhookURL(raw string) error {
+	if raw == "" {
+		return nil
 	}
-	if existing != nil {
-		_, err = m.client.Setting.UpdateOne(existing).SetValue(settings.LogLevel).Save(ctx)
-		if err != nil {
-			return err
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid webhook_url %q: must be an http or https URL", raw)
+	}
+	return nil
+}
+
+// ParseTrustedProxies parses trusted proxy entries, accepting CIDRs and bare IPs
+func ParseTrustedProxies(entries []string) ([]*net.IPNet, error) {
+	nets := make([]*net.IPNet, 0, len(entries))
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
 		}
-	} else {
-		_, err = m.client.Setting.Create().SetKey("log_level").SetValue(settings.LogLevel).Save(ctx)
+		if !strings.Contains(entry, "/") {
+			ip := net.ParseIP(entry)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q: not an IP or CIDR", entry)
+			}
+			bits := 128
+			if ip.To4() != nil {
+				bits = 32
+			}
+			entry = fmt.Sprintf("%s/%d", entry, bits)
+		}
+		_, ipNet, err := net.ParseCIDR(entry)
 		if err != nil {
-			return err
+			return nil, fmt.Errorf("invalid trusted proxy %q: %w", entry, err)
 		}
+		nets = append(nets, ipNet)
 	}
-
-	return nil
+	return nets, nil
 }
 
-// validateTunnel validates a tunnel configuration
-func (m *Manager) validateTunnel(tunnel *TunnelConfig) error {
+// validateTunnel validates a tunnel configuration. stored is the saved
+// tunnel being updated, nil for a new one: a name or target it already has
+// is not held to the length limits, which may have been lowered since.
+func (m *Manager) validateTunnel(tunnel *TunnelConfig, stored *ent.Tunnel) error {
 	if tunnel.Name == "" {
 		return fmt.Errorf("tunnel name is required")
 	}
+	if len(tunnel.Name) > m.nameMaxLen && (stored == nil || tunnel.Name != stored.Name) {
+		return fmt.Errorf("tunnel name is too long: %d bytes, at most %d allowed", len(tunnel.Name), m.nameMaxLen)
+	}
 
 	if tunnel.Type != TunnelTypeCloudflare && tunnel.Type != TunnelTypeNgrok {
 		return fmt.Errorf("invalid tunnel type: %s", tunnel.Type)
@@ -319,10 +858,283 @@ func (m *Manager) validateTunnel(tunnel *TunnelConfig) error {
 	if tunnel.Target == "" {
 		return fmt.Errorf("tunnel target is required")
 	}
+	if len(tunnel.Target) > m.targetMaxLen && (stored == nil || tunnel.Target != stored.Target) {
+		return fmt.Errorf("tunnel target is too long: %d bytes, at most %d allowed", len(tunnel.Target), m.targetMaxLen)
+	}
+
+	if strings.ContainsAny(tunnel.Group, "/?#") {
+		return fmt.Errorf("group name must not contain '/', '?' or '#'")
+	}
+
+	// Target checks run against the resolved target; the template is stored
+	target, err := ResolveTarget(tunnel.Target)
+	if err != nil {
+		return err
+	}
+	resolved := *tunnel
+	resolved.Target = target
+
+	if err := m.allowlist.Check(resolved.Target); err != nil {
+		return err
+	}
+
+	if tunnel.Type == TunnelTypeCloudflare {
+		if err := CheckCloudflareTarget(resolved.Target); err != nil {
+			return err
+		}
+		if _, err := CloudflareTargetURL(resolved.Target); err != nil {
+			return err
+		}
+	}
+
+	if err := validateSecrets(tunnel); err != nil {
+		return err
+	}
+
+	if err := validateWebhookVerification(&resolved); err != nil {
+		return err
+	}
+
+	if err := validateNgrokInternal(&resolved); err != nil {
+		return err
+	}
+
+	if err := validateNgrokRateLimit(&resolved); err != nil {
+		return err
+	}
+
+	if err := m.validateNgrokCredential(&resolved); err != nil {
+		return err
+	}
+
+	if err := CheckCloudflareOptions(&resolved); err != nil {
+		return err
+	}
+
+	if err := validateProbe(&resolved); err != nil {
+		return err
+	}
+
+	if err := validateIdleTimeout(&resolved); err != nil {
+		return err
+	}
+
+	if err := m.validateHooks(tunnel); err != nil {
+		return err
+	}
+
+	if tunnel.ErrorGrace != "" {
+		d, err := time.ParseDuration(tunnel.ErrorGrace)
+		if err != nil || d < 0 || d > MaxErrorGrace {
+			return fmt.Errorf("error_grace must be a duration between 0s and %s", MaxErrorGrace)
+		}
+	}
 
 	return nil
 }
 
+// MaxErrorGrace bounds how long a tunnel error may be hidden
+const MaxErrorGrace = 10 * time.Minute
+
+// Idle timeout bounds
+const (
+	MinIdleTimeout = time.Minute
+	MaxIdleTimeout = 7 * 24 * time.Hour
+)
+
+// validateIdleTimeout checks the idle timeout. Only ngrok tunnels report
+// their traffic; cloudflared's request counters are shared by all tunnels.
+// Reachability probes would keep a tunnel from ever idling if they came more
+// often than the timeout.
+func validateIdleTimeout(tunnel *TunnelConfig) error {
+	if tunnel.IdleTimeout == "" {
+		return nil
+	}
+	if tunnel.Type != TunnelTypeNgrok {
+		return fmt.Errorf("idle_timeout is only supported for ngrok tunnels")
+	}
+	d, err := time.ParseDuration(tunnel.IdleTimeout)
+	if err != nil || d < MinIdleTimeout || d > MaxIdleTimeout {
+		return fmt.Errorf("idle_timeout must be a duration between %s and %s", MinIdleTimeout, MaxIdleTimeout)
+	}
+	// Probe requests reach the target like any other traffic
+	if interval := tunnel.ProbeIntervalDuration(); tunnel.ProbeEnabled && interval <= d {
+		return fmt.Errorf("idle_timeout must be shorter than probe_interval (%s) while probe_enabled is set, as probe requests count as traffic", interval)
+	}
+	return nil
+}
+
+// IdleTimeoutDuration returns the parsed idle timeout, 0 when unset
+func (t *TunnelConfig) IdleTimeoutDuration() time.Duration {
+	d, _ := time.ParseDuration(t.IdleTimeout)
+	return d
+}
+
+// ErrorGraceDuration returns the parsed error grace period, 0 when unset
+func (t *TunnelConfig) ErrorGraceDuration() time.Duration {
+	d, _ := time.ParseDuration(t.ErrorGrace)
+	return d
+}
+
+// CheckCloudflareTarget rejects targets that cloudflare quick tunnels cannot forward.
+// Targets without a scheme are accepted since cloudflared treats them as http.
+func CheckCloudflareTarget(target string) error {
+	scheme, _, found := strings.Cut(target, "://")
+	if !found {
+		return nil
+	}
+
+	switch strings.ToLower(scheme) {
+	case "http", "https":
+		return nil
+	case "tcp", "tls":
+		return fmt.Errorf("cloudflare quick tunnels only support http:// and https:// targets; use an ngrok tunnel to forward %s:// traffic", scheme)
+	default:
+		return fmt.Errorf("unsupported cloudflare target scheme %q: only http:// and https:// are supported", scheme)
+	}
+}
+
+// CloudflareTargetURL parses a cloudflare target into the origin URL
+// cloudflared forwards to. Targets without a scheme are http, so
+// "192.168.1.10:8080" and "nas.lan:8080" reach that host, and a bare port
+// means localhost. Userinfo such as "user@host:80" is kept.
+func CloudflareTargetURL(target string) (*url.URL, error) {
+	if !strings.Contains(target, "://") {
+		hostPort, rest := target, ""
+		if i := strings.IndexAny(target, "/?#"); i >= 0 {
+			hostPort, rest = target[:i], target[i:]
+		}
+		userinfo := ""
+		if i := strings.LastIndex(hostPort, "@"); i >= 0 {
+			userinfo, hostPort = hostPort[:i+1], hostPort[i+1:]
+		}
+		host, port := targetHostPort(hostPort)
+		target = "http://" + userinfo + net.JoinHostPort(host, port) + rest
+	}
+	u, err := url.Parse(target)
+	if err != nil {
+		return nil, fmt.Errorf("invalid target URL: %w", err)
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("invalid target URL %q: missing host", target)
+	}
+	return u, nil
+}
+
+// TunnelsWithSameTarget returns the names of other non-archived tunnels that
+// forward to the same host and port as t, so callers can warn about what is
+// usually a copy-paste mistake. Targets are compared after resolving ${VAR}
+// placeholders, so "localhost:3000" and "http://localhost:3000" match.
+func (m *Manager) TunnelsWithSameTarget(t *TunnelConfig) ([]string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	query := m.client.Tunnel.Query().Where(tunnel.ArchivedEQ(false))
+	if id, err := uuid.Parse(t.ID); err == nil {
+		query = query.Where(tunnel.IDNEQ(id))
+	}
+	others, err := query.Select(tunnel.FieldName, tunnel.FieldTarget).All(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	key := sameTargetKey(t.Target)
+	var names []string
+	for _, other := range others {
+		if sameTargetKey(other.Target) == key {
+			names = append(names, m.displayName(other.Name))
+		}
+	}
+	return names, nil
+}
+
+// sameTargetKey is the host:port a target forwards to, for comparing targets
+func sameTargetKey(target string) string {
+	if resolved, err := ResolveTarget(target); err == nil {
+		target = resolved
+	}
+	host, port := targetHostPort(target)
+	return strings.ToLower(net.JoinHostPort(host, port))
+}
+
+// checkDuplicateName rejects a name already used by another non-archived tunnel.
+// Caller must hold m.mu.
+func (m *Manager) checkDuplicateName(name string, excludeID uuid.UUID) error {
+	exists, err := m.client.Tunnel.Query().
+		Where(
+			tunnel.NameEQ(name),
+			tunnel.ArchivedEQ(false),
+			tunnel.IDNEQ(excludeID),
+		).
+		Exist(context.Background())
+	if err != nil {
+		return err
+	}
+	if exists {
+		return fmt.Errorf("a tunnel named %q already exists", m.displayName(name))
+	}
+	return nil
+}
+
+// displayName returns the tunnel name as shown to clients, including the namespace
+func (m *Manager) displayName(name string) string {
+	if m.namespace == "" {
+		return name
+	}
+	return m.namespace + "/" + name
+}
+
+// stripNamespace removes this instance's namespace prefix from a client-supplied name
+func (m *Manager) stripNamespace(name string) string {
+	if m.namespace == "" {
+		return name
+	}
+	return strings.TrimPrefix(name, m.namespace+"/")
+}
+
+// toTunnelConfig converts an ent tunnel entity into a TunnelConfig
+func (m *Manager) toTunnelConfig(t *ent.Tunnel) *TunnelConfig {
+	return &TunnelConfig{
+		ID:             t.ID.String(),
+		Name:           m.displayName(t.Name),
+		Type:           TunnelType(t.Type),
+		Target:         t.Target,
+		Group:          stringPtrToString(t.Group),
+		Enabled:        t.Enabled,
+		MCPEnabled:     t.McpEnabled,
+		Favorite:       t.Favorite,
+		Archived:       t.Archived,
+		Paused:         t.Paused,
+		CreatedAt:      t.CreatedAt,
+		UpdatedAt:      t.UpdatedAt,
+		NgrokAuthtoken: stringPtrToString(t.NgrokAuthtoken),
+		NgrokDomain:    stringPtrToString(t.NgrokDomain),
+
+		NgrokWebhookProvider: stringPtrToString(t.NgrokWebhookProvider),
+		NgrokWebhookSecret:   stringPtrToString(t.NgrokWebhookSecret),
+		NgrokRateLimit:       stringPtrToString(t.NgrokRateLimit),
+		NgrokCredential:      stringPtrToString(t.NgrokCredential),
+		NgrokInternal:        t.NgrokInternal,
+
+		CloudflareConnectTimeout: stringPtrToString(t.CloudflareConnectTimeout),
+		CloudflareNoTLSVerify:    t.CloudflareNoTLSVerify,
+		CloudflareHTTPHostHeader: stringPtrToString(t.CloudflareHTTPHostHeader),
+		CloudflareRegion:         stringPtrToString(t.CloudflareRegion),
+		CloudflareEnv:            t.CloudflareEnv,
+		CloudflareErrorPage:      stringPtrToString(t.CloudflareErrorPage),
+		ErrorGrace:               stringPtrToString(t.ErrorGrace),
+
+		ProbeEnabled:  t.ProbeEnabled,
+		ProbeInterval: stringPtrToString(t.ProbeInterval),
+		ProbeTimeout:  stringPtrToString(t.ProbeTimeout),
+		ProbeRetries:  t.ProbeRetries,
+		ProbePath:     stringPtrToString(t.ProbePath),
+		IdleTimeout:   stringPtrToString(t.IdleTimeout),
+		PreStartHook:  stringPtrToString(t.PreStartHook),
+		PostStopHook:  stringPtrToString(t.PostStopHook),
+	}
+}
+
 func stringPtrToString(s *string) string {
 	if s == nil {
 		return ""
@@ -0,0 +1,41 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MaxHookLength bounds the length in bytes of a hook command
+const MaxHookLength = 4096
+
+// SetAllowHooks sets whether tunnels may have pre-start and post-stop hooks.
+// Hooks run arbitrary commands, so they are off unless ALLOW_HOOKS is set.
+func (m *Manager) SetAllowHooks(allow bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.allowHooks = allow
+}
+
+// validateHooks checks the hook commands of a tunnel
+func (m *Manager) validateHooks(tunnel *TunnelConfig) error {
+	hooks := map[string]string{
+		"pre_start_hook": tunnel.PreStartHook,
+		"post_stop_hook": tunnel.PostStopHook,
+	}
+	for _, key := range []string{"pre_start_hook", "post_stop_hook"} {
+		cmd := hooks[key]
+		if cmd == "" {
+			continue
+		}
+		if !m.allowHooks {
+			return fmt.Errorf("%s requires hooks to be enabled with ALLOW_HOOKS=true", key)
+		}
+		if strings.TrimSpace(cmd) == "" {
+			return fmt.Errorf("%s must not be blank", key)
+		}
+		if len(cmd) > MaxHookLength {
+			return fmt.Errorf("%s is too long: %d bytes, at most %d allowed", key, len(cmd), MaxHookLength)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,190 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"pont/ent"
+	"pont/ent/ngrokcredential"
+	"pont/ent/tunnel"
+	"strings"
+	"time"
+)
+
+// NgrokCredential is a named ngrok authtoken. Tunnels reference it by name
+// through ngrok_credential, so a token shared by several tunnels is rotated in
+// one place. The authtoken is accepted when saving but never returned.
+type NgrokCredential struct {
+	Name      string    `json:"name"`
+	Authtoken string    `json:"authtoken,omitempty"`
+	Tunnels   []string  `json:"tunnels"` // names of the tunnels using it
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ListNgrokCredentials returns the stored credentials without their tokens
+func (m *Manager) ListNgrokCredentials() ([]NgrokCredential, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	ctx := context.Background()
+	rows, err := m.client.NgrokCredential.Query().
+		Order(ent.Asc(ngrokcredential.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	creds := make([]NgrokCredential, len(rows))
+	for i, row := range rows {
+		users, err := m.credentialUsers(ctx, row.Name)
+		if err != nil {
+			return nil, err
+		}
+		creds[i] = NgrokCredential{
+			Name:      row.Name,
+			Tunnels:   users,
+			CreatedAt: row.CreatedAt,
+			UpdatedAt: row.UpdatedAt,
+		}
+	}
+	return creds, nil
+}
+
+// SaveNgrokCredential creates a credential or replaces the authtoken of an
+// existing one. Running tunnels keep the old token until they are restarted.
+func (m *Manager) SaveNgrokCredential(cred *NgrokCredential) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	cred.Name = strings.TrimSpace(cred.Name)
+	if cred.Name == "" {
+		return fmt.Errorf("credential name is required")
+	}
+	if strings.ContainsAny(cred.Name, "/?#") {
+		return fmt.Errorf("credential name must not contain '/', '?' or '#'")
+	}
+	if cred.Authtoken == "" {
+		return fmt.Errorf("authtoken is required")
+	}
+
+	ctx := context.Background()
+	var row *ent.NgrokCredential
+	err := retryLocked(func() error {
+		existing, err := m.client.NgrokCredential.Query().
+			Where(ngrokcredential.NameEQ(cred.Name)).
+			Only(ctx)
+		switch {
+		case ent.IsNotFound(err):
+			row, err = m.client.NgrokCredential.Create().
+				SetName(cred.Name).
+				SetAuthtoken(cred.Authtoken).
+				Save(ctx)
+		case err == nil:
+			row, err = existing.Update().SetAuthtoken(cred.Authtoken).Save(ctx)
+		}
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	users, err := m.credentialUsers(ctx, row.Name)
+	if err != nil {
+		return err
+	}
+	cred.Authtoken = ""
+	cred.Tunnels = users
+	cred.CreatedAt = row.CreatedAt
+	cred.UpdatedAt = row.UpdatedAt
+	return nil
+}
+
+// DeleteNgrokCredential removes a credential no tunnel refers to
+func (m *Manager) DeleteNgrokCredential(name string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	ctx := context.Background()
+	users, err := m.credentialUsers(ctx, name)
+	if err != nil {
+		return err
+	}
+	if len(users) > 0 {
+		return fmt.Errorf("credential %q is used by %s", name, strings.Join(users, ", "))
+	}
+
+	var n int
+	err = retryLocked(func() (err error) {
+		n, err = m.client.NgrokCredential.Delete().
+			Where(ngrokcredential.NameEQ(name)).
+			Exec(ctx)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return fmt.Errorf("credential not found: %s", name)
+	}
+	return nil
+}
+
+// NgrokCredentialAuthtoken returns the authtoken of a named credential
+func (m *Manager) NgrokCredentialAuthtoken(name string) (string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	row, err := m.client.NgrokCredential.Query().
+		Where(ngrokcredential.NameEQ(name)).
+		Only(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return "", fmt.Errorf("ngrok credential not found: %s", name)
+		}
+		return "", err
+	}
+	return row.Authtoken, nil
+}
+
+// credentialUsers returns the names of the tunnels referencing a credential,
+// archived ones included. Caller must hold m.mu.
+func (m *Manager) credentialUsers(ctx context.Context, name string) ([]string, error) {
+	names, err := m.client.Tunnel.Query().
+		Where(tunnel.NgrokCredentialEQ(name)).
+		Order(ent.Asc(tunnel.FieldName)).
+		Select(tunnel.FieldName).
+		Strings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	users := make([]string, len(names))
+	for i, n := range names {
+		users[i] = m.displayName(n)
+	}
+	return users, nil
+}
+
+// validateNgrokCredential checks that a referenced credential exists and is
+// not combined with an inline authtoken. Caller must hold m.mu.
+func (m *Manager) validateNgrokCredential(tunnel *TunnelConfig) error {
+	if tunnel.NgrokCredential == "" {
+		return nil
+	}
+	if tunnel.Type != TunnelTypeNgrok {
+		return fmt.Errorf("ngrok_credential is only supported for ngrok tunnels")
+	}
+	if tunnel.NgrokAuthtoken != "" {
+		return fmt.Errorf("set either ngrok_authtoken or ngrok_credential, not both")
+	}
+
+	exists, err := m.client.NgrokCredential.Query().
+		Where(ngrokcredential.NameEQ(tunnel.NgrokCredential)).
+		Exist(context.Background())
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("ngrok credential not found: %s", tunnel.NgrokCredential)
+	}
+	return nil
+}
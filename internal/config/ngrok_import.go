@@ -0,0 +1,217 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ngrokFile covers both the v2 and v3 layouts of an ngrok agent config file
+type ngrokFile struct {
+	Authtoken string `yaml:"authtoken"`
+	Agent     struct {
+		Authtoken string `yaml:"authtoken"`
+	} `yaml:"agent"`
+	Tunnels   map[string]map[string]interface{} `yaml:"tunnels"`
+	Endpoints []map[string]interface{}          `yaml:"endpoints"`
+}
+
+// NgrokImport is the result of parsing an ngrok config file
+type NgrokImport struct {
+	Tunnels  []TunnelConfig
+	Warnings []string
+}
+
+// ngrokTunnelKeys are the legacy tunnel options Pont understands
+var ngrokTunnelKeys = map[string]bool{
+	"proto":     true,
+	"addr":      true,
+	"hostname":  true,
+	"domain":    true,
+	"authtoken": true,
+}
+
+// ParseNgrokConfig converts the tunnel definitions of an ngrok.yml file into
+// Pont tunnel configurations. Options Pont cannot map are reported as warnings.
+func ParseNgrokConfig(data []byte) (*NgrokImport, error) {
+	var file ngrokFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("invalid ngrok config: %w", err)
+	}
+
+	authtoken := file.Authtoken
+	if file.Agent.Authtoken != "" {
+		authtoken = file.Agent.Authtoken
+	}
+
+	result := &NgrokImport{}
+
+	// Map iteration order is random; import tunnels in a stable order
+	names := make([]string, 0, len(file.Tunnels))
+	for name := range file.Tunnels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		t, warnings := parseNgrokTunnel(name, file.Tunnels[name], authtoken)
+		result.Warnings = append(result.Warnings, warnings...)
+		if t != nil {
+			result.Tunnels = append(result.Tunnels, *t)
+		}
+	}
+
+	for i, ep := range file.Endpoints {
+		t, warnings := parseNgrokEndpoint(i, ep, authtoken)
+		result.Warnings = append(result.Warnings, warnings...)
+		if t != nil {
+			result.Tunnels = append(result.Tunnels, *t)
+		}
+	}
+
+	if len(result.Tunnels) == 0 && len(result.Warnings) == 0 {
+		return nil, fmt.Errorf("no tunnels or endpoints found in ngrok config")
+	}
+
+	return result, nil
+}
+
+// parseNgrokTunnel maps a legacy (v2 style) tunnel definition
+func parseNgrokTunnel(name string, def map[string]interface{}, authtoken string) (*TunnelConfig, []string) {
+	var warnings []string
+
+	proto := strings.ToLower(yamlString(def["proto"]))
+	addr := yamlString(def["addr"])
+	if addr == "" {
+		return nil, []string{fmt.Sprintf("tunnel %q: missing addr, skipped", name)}
+	}
+
+	var target string
+	switch proto {
+	case "http", "":
+		target = ngrokTarget("http", addr)
+	case "tcp", "tls":
+		target = ngrokTarget(proto, addr)
+	default:
+		return nil, []string{fmt.Sprintf("tunnel %q: unsupported proto %q, skipped", name, proto)}
+	}
+
+	t := &TunnelConfig{
+		Name:           name,
+		Type:           TunnelTypeNgrok,
+		Target:         target,
+		Enabled:        true,
+		NgrokAuthtoken: authtoken,
+	}
+	if token := yamlString(def["authtoken"]); token != "" {
+		t.NgrokAuthtoken = token
+	}
+
+	domain := yamlString(def["domain"])
+	if domain == "" {
+		domain = yamlString(def["hostname"])
+	}
+	if domain != "" {
+		if proto == "tcp" {
+			warnings = append(warnings, fmt.Sprintf("tunnel %q: hostname is not supported for tcp tunnels and was ignored", name))
+		} else {
+			t.NgrokDomain = domain
+		}
+	}
+
+	for _, key := range sortedKeys(def) {
+		if !ngrokTunnelKeys[key] {
+			warnings = append(warnings, fmt.Sprintf("tunnel %q: option %q is not supported and was ignored", name, key))
+		}
+	}
+
+	return t, warnings
+}
+
+// parseNgrokEndpoint maps a v3 endpoint definition
+func parseNgrokEndpoint(index int, def map[string]interface{}, authtoken string) (*TunnelConfig, []string) {
+	var warnings []string
+
+	name := yamlString(def["name"])
+	if name == "" {
+		name = fmt.Sprintf("endpoint-%d", index+1)
+	}
+
+	upstream, _ := def["upstream"].(map[string]interface{})
+	addr := yamlString(upstream["url"])
+	if addr == "" {
+		return nil, []string{fmt.Sprintf("endpoint %q: missing upstream.url, skipped", name)}
+	}
+
+	endpointURL := yamlString(def["url"])
+	scheme := "http"
+	if s, _, found := strings.Cut(endpointURL, "://"); found {
+		switch strings.ToLower(s) {
+		case "tcp", "tls":
+			scheme = strings.ToLower(s)
+		}
+	}
+
+	t := &TunnelConfig{
+		Name:           name,
+		Type:           TunnelTypeNgrok,
+		Target:         ngrokTarget(scheme, addr),
+		Enabled:        true,
+		NgrokAuthtoken: authtoken,
+	}
+	if scheme == "http" && endpointURL != "" {
+		t.NgrokDomain = endpointURL
+	}
+
+	for _, key := range sortedKeys(def) {
+		switch key {
+		case "name", "url", "upstream":
+		default:
+			warnings = append(warnings, fmt.Sprintf("endpoint %q: option %q is not supported and was ignored", name, key))
+		}
+	}
+	for _, key := range sortedKeys(upstream) {
+		if key != "url" {
+			warnings = append(warnings, fmt.Sprintf("endpoint %q: upstream option %q is not supported and was ignored", name, key))
+		}
+	}
+
+	return t, warnings
+}
+
+// ngrokTarget turns an ngrok addr ("8080", "host:8080" or a full URL) into a Pont target
+func ngrokTarget(scheme, addr string) string {
+	if strings.Contains(addr, "://") {
+		return addr
+	}
+	if _, err := strconv.Atoi(addr); err == nil {
+		addr = "localhost:" + addr
+	}
+	return scheme + "://" + addr
+}
+
+// yamlString renders a scalar YAML value (string or number) as a string
+func yamlString(v interface{}) string {
+	switch val := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return val
+	case int, int64, float64, bool:
+		return fmt.Sprint(val)
+	default:
+		return ""
+	}
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
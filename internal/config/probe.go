@@ -0,0 +1,80 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Reachability probe intervals
+const (
+	DefaultProbeInterval = time.Minute
+	MinProbeInterval     = 10 * time.Second
+	MaxProbeInterval     = time.Hour
+)
+
+// Reachability probe request options
+const (
+	DefaultProbeTimeout = 10 * time.Second
+	MinProbeTimeout     = time.Second
+	MaxProbeTimeout     = time.Minute
+	MaxProbeRetries     = 5
+)
+
+// validateProbe checks the reachability probe options. Only HTTP tunnels have
+// a public URL that can be probed with a GET request.
+func validateProbe(tunnel *TunnelConfig) error {
+	if tunnel.ProbeInterval != "" {
+		d, err := time.ParseDuration(tunnel.ProbeInterval)
+		if err != nil || d < MinProbeInterval || d > MaxProbeInterval {
+			return fmt.Errorf("probe_interval must be a duration between %s and %s", MinProbeInterval, MaxProbeInterval)
+		}
+	}
+
+	if tunnel.ProbeTimeout != "" {
+		d, err := time.ParseDuration(tunnel.ProbeTimeout)
+		if err != nil || d < MinProbeTimeout || d > MaxProbeTimeout {
+			return fmt.Errorf("probe_timeout must be a duration between %s and %s", MinProbeTimeout, MaxProbeTimeout)
+		}
+	}
+
+	if tunnel.ProbeRetries < 0 || tunnel.ProbeRetries > MaxProbeRetries {
+		return fmt.Errorf("probe_retries must be between 0 and %d", MaxProbeRetries)
+	}
+
+	if p := tunnel.ProbePath; p != "" {
+		if u, err := url.ParseRequestURI(p); err != nil || !strings.HasPrefix(p, "/") || u.Host != "" || strings.ContainsAny(p, " \t\r\n") {
+			return fmt.Errorf("probe_path must be a path starting with /, e.g. /healthz")
+		}
+	}
+
+	if !tunnel.ProbeEnabled {
+		return nil
+	}
+	if strings.HasPrefix(tunnel.Target, "tcp://") || strings.HasPrefix(tunnel.Target, "tls://") {
+		return fmt.Errorf("reachability probes are only supported for HTTP tunnels")
+	}
+	if tunnel.NgrokInternal {
+		return fmt.Errorf("reachability probes are not supported for internal endpoints, which have no public URL")
+	}
+	return nil
+}
+
+// ProbeTimeoutDuration returns how long one probe attempt may take
+func (t *TunnelConfig) ProbeTimeoutDuration() time.Duration {
+	d, err := time.ParseDuration(t.ProbeTimeout)
+	if err != nil || d <= 0 {
+		return DefaultProbeTimeout
+	}
+	return d
+}
+
+// ProbeIntervalDuration returns the time between reachability probes
+func (t *TunnelConfig) ProbeIntervalDuration() time.Duration {
+	d, err := time.ParseDuration(t.ProbeInterval)
+	if err != nil || d <= 0 {
+		return DefaultProbeInterval
+	}
+	return d
+}
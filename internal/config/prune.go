@@ -0,0 +1,104 @@
+package config
+
+import (
+	"context"
+	"time"
+
+	"pont/ent/auditlog"
+	"pont/ent/urlhistory"
+	"pont/internal/logger"
+)
+
+// pruneBatchSize is how many rows one delete removes, so the database lock
+// is released between batches
+const pruneBatchSize = 500
+
+// PruneResult counts the rows removed by Prune
+type PruneResult struct {
+	AuditLogs  int `json:"audit_logs"`
+	URLHistory int `json:"url_history"`
+}
+
+// Prune deletes audit entries and URL history recorded before cutoff
+func (m *Manager) Prune(ctx context.Context, cutoff time.Time) (PruneResult, error) {
+	var result PruneResult
+
+	n, err := m.pruneBatches(ctx, func(ctx context.Context) (int, error) {
+		ids, err := m.client.AuditLog.Query().
+			Where(auditlog.CreatedAtLT(cutoff)).
+			Limit(pruneBatchSize).
+			IDs(ctx)
+		if err != nil || len(ids) == 0 {
+			return 0, err
+		}
+		return m.client.AuditLog.Delete().Where(auditlog.IDIn(ids...)).Exec(ctx)
+	})
+	result.AuditLogs = n
+	if err != nil {
+		return result, err
+	}
+
+	n, err = m.pruneBatches(ctx, func(ctx context.Context) (int, error) {
+		ids, err := m.client.URLHistory.Query().
+			Where(urlhistory.CreatedAtLT(cutoff)).
+			Limit(pruneBatchSize).
+			IDs(ctx)
+		if err != nil || len(ids) == 0 {
+			return 0, err
+		}
+		return m.client.URLHistory.Delete().Where(urlhistory.IDIn(ids...)).Exec(ctx)
+	})
+	result.URLHistory = n
+	return result, err
+}
+
+// pruneBatches runs deleteBatch until it deletes nothing, taking the lock
+// for one batch at a time, and returns the total deleted
+func (m *Manager) pruneBatches(ctx context.Context, deleteBatch func(context.Context) (int, error)) (int, error) {
+	total := 0
+	for {
+		if err := ctx.Err(); err != nil {
+			return total, err
+		}
+
+		var n int
+		m.mu.Lock()
+		err := retryLocked(func() (err error) {
+			n, err = deleteBatch(ctx)
+			return err
+		})
+		m.mu.Unlock()
+
+		total += n
+		if err != nil || n == 0 {
+			return total, err
+		}
+	}
+}
+
+// PruneLoop prunes rows older than retention every interval until ctx is
+// done. It returns right away when retention or interval is 0.
+func (m *Manager) PruneLoop(ctx context.Context, interval, retention time.Duration) {
+	if interval <= 0 || retention <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		result, err := m.Prune(ctx, time.Now().Add(-retention))
+		if err != nil && ctx.Err() == nil {
+			logger.Sugar.Warnf("Pruning failed after removing %d audit entries and %d URL history entries: %v",
+				result.AuditLogs, result.URLHistory, err)
+		} else if err == nil {
+			logger.Sugar.Infof("Pruned %d audit entries and %d URL history entries older than %s",
+				result.AuditLogs, result.URLHistory, retention)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
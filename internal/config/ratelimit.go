@@ -0,0 +1,56 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseRateLimit parses a "<requests>/<window>" rate limit such as "100/1m"
+// or "5/s". The window is a Go duration of at least one second, or s, m or h
+// for one of that unit.
+func ParseRateLimit(limit string) (requests int, window time.Duration, err error) {
+	countStr, windowStr, ok := strings.Cut(strings.TrimSpace(limit), "/")
+	if !ok {
+		return 0, 0, fmt.Errorf("invalid rate limit %q, expected <requests>/<window> such as 100/1m", limit)
+	}
+
+	requests, err = strconv.Atoi(strings.TrimSpace(countStr))
+	if err != nil || requests < 1 {
+		return 0, 0, fmt.Errorf("invalid rate limit %q: request count must be a positive integer", limit)
+	}
+
+	windowStr = strings.TrimSpace(windowStr)
+	switch windowStr {
+	case "s", "m", "h":
+		windowStr = "1" + windowStr
+	}
+	window, err = time.ParseDuration(windowStr)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid rate limit %q: window must be a duration such as 30s, 1m or 1h", limit)
+	}
+	if window < time.Second || window%time.Second != 0 {
+		return 0, 0, fmt.Errorf("invalid rate limit %q: window must be a whole number of seconds", limit)
+	}
+
+	return requests, window, nil
+}
+
+// validateNgrokRateLimit checks the ngrok rate limit option. Cloudflare quick
+// tunnels run inside cloudflared, which offers no hook to throttle traffic.
+func validateNgrokRateLimit(tunnel *TunnelConfig) error {
+	if tunnel.NgrokRateLimit == "" {
+		return nil
+	}
+
+	if tunnel.Type != TunnelTypeNgrok {
+		return fmt.Errorf("rate limits are only supported for ngrok tunnels")
+	}
+	if strings.HasPrefix(tunnel.Target, "tcp://") || strings.HasPrefix(tunnel.Target, "tls://") {
+		return fmt.Errorf("rate limits are only supported for HTTP tunnels")
+	}
+
+	_, _, err := ParseRateLimit(tunnel.NgrokRateLimit)
+	return err
+}
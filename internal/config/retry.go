@@ -0,0 +1,35 @@
+package config
+
+import (
+	"pont/internal/logger"
+	"strings"
+	"time"
+)
+
+// lockedRetries is how often a write is attempted while SQLite reports the
+// database as locked; the busy_timeout set in db.Init already waits before
+// each failure, so a few attempts with backoff are enough
+const lockedRetries = 5
+
+// retryLocked runs a database write, retrying with backoff while SQLite
+// reports the database as locked or busy. Other errors are returned as is.
+func retryLocked(op func() error) error {
+	delay := 25 * time.Millisecond
+	for attempt := 1; ; attempt++ {
+		err := op()
+		if err == nil || !isLocked(err) || attempt == lockedRetries {
+			return err
+		}
+		logger.Sugar.Debugf("Database locked, retrying write in %s (attempt %d/%d)", delay, attempt, lockedRetries)
+		time.Sleep(delay)
+		delay *= 2
+	}
+}
+
+// isLocked reports whether err is SQLite's SQLITE_BUSY/SQLITE_LOCKED error
+func isLocked(err error) bool {
+	msg := err.Error()
+	return strings.Contains(msg, "database is locked") ||
+		strings.Contains(msg, "SQLITE_BUSY") ||
+		strings.Contains(msg, "SQLITE_LOCKED")
+}
@@ -0,0 +1,61 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// RedactedSecret stands in for a stored secret in API responses. Sending it
+// back in an update keeps the stored value.
+const RedactedSecret = "********"
+
+// Redacted returns a copy of t with its secrets replaced by RedactedSecret.
+// GET /api/tunnels/{id}/secret is the only way to read them.
+func (t TunnelConfig) Redacted() TunnelConfig {
+	if t.NgrokAuthtoken != "" {
+		t.NgrokAuthtoken = RedactedSecret
+	}
+	if t.NgrokWebhookSecret != "" {
+		t.NgrokWebhookSecret = RedactedSecret
+	}
+	return t
+}
+
+// RedactTunnels returns copies of tunnels with their secrets redacted
+func RedactTunnels(tunnels []TunnelConfig) []TunnelConfig {
+	redacted := make([]TunnelConfig, len(tunnels))
+	for i, t := range tunnels {
+		redacted[i] = t.Redacted()
+	}
+	return redacted
+}
+
+// KeepSecrets carries the stored secrets of old over to updated where the
+// update body left them out or sent RedactedSecret back. An explicit empty
+// value still clears a secret.
+func KeepSecrets(old, updated *TunnelConfig, body []byte) error {
+	var sent map[string]json.RawMessage
+	if err := json.Unmarshal(body, &sent); err != nil {
+		return err
+	}
+	keep := func(field string, stored string, value *string) {
+		if _, ok := sent[field]; !ok || *value == RedactedSecret {
+			*value = stored
+		}
+	}
+	keep("ngrok_authtoken", old.NgrokAuthtoken, &updated.NgrokAuthtoken)
+	keep("ngrok_webhook_secret", old.NgrokWebhookSecret, &updated.NgrokWebhookSecret)
+	return nil
+}
+
+// validateSecrets rejects the redaction placeholder where no stored secret
+// can replace it, e.g. a tunnel created from a copied API response
+func validateSecrets(tunnel *TunnelConfig) error {
+	if tunnel.NgrokAuthtoken == RedactedSecret {
+		return fmt.Errorf("ngrok_authtoken is the redacted placeholder %q, send the actual value", RedactedSecret)
+	}
+	if tunnel.NgrokWebhookSecret == RedactedSecret {
+		return fmt.Errorf("ngrok_webhook_secret is the redacted placeholder %q, send the actual value", RedactedSecret)
+	}
+	return nil
+}
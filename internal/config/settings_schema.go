@@ -0,0 +1,93 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SettingMeta describes a global setting so clients can render and validate it
+type SettingMeta struct {
+	Key           string      `json:"key"`
+	Type          string      `json:"type"` // "bool", "int", "string", "list" or "enum"
+	AllowedValues []string    `json:"allowed_values,omitempty"`
+	Default       interface{} `json:"default"`
+	Description   string      `json:"description"`
+}
+
+// settingsRegistry lists every known setting; keep it in sync with Settings
+var settingsRegistry = []SettingMeta{
+	{
+		Key:         "auto_start",
+		Type:        "bool",
+		Default:     false,
+		Description: "Start enabled tunnels automatically when Pont starts",
+	},
+	{
+		Key:           "log_level",
+		Type:          "enum",
+		AllowedValues: []string{"debug", "info", "warn", "error", "dpanic", "panic", "fatal"},
+		Default:       "info",
+		Description:   "Minimum level of log entries to record",
+	},
+	{
+		Key:         "max_running_tunnels",
+		Type:        "int",
+		Default:     0,
+		Description: "Maximum number of simultaneously running tunnels (0 means unlimited)",
+	},
+	{
+		Key:         "trusted_proxies",
+		Type:        "list",
+		Default:     []string{},
+		Description: "Proxy IPs or CIDRs whose X-Forwarded-For header is trusted when logging client IPs",
+	},
+	{
+		Key:         "audit_log",
+		Type:        "bool",
+		Default:     false,
+		Description: "Record create, update, delete, start and stop operations in the audit log",
+	},
+	{
+		Key:         "webhook_url",
+		Type:        "string",
+		Default:     "",
+		Description: "http(s) URL that receives a JSON tunnel.status_changed event whenever a tunnel starts running, stops, pauses or fails, e.g. a Slack or Discord incoming webhook",
+	},
+	{
+		Key:         "restart_on_update",
+		Type:        "bool",
+		Default:     true,
+		Description: "Restart a running tunnel when an update changes its target or provider options, so the change applies right away",
+	},
+}
+
+// SettingsSchema returns metadata for all known settings
+func SettingsSchema() []SettingMeta {
+	schema := make([]SettingMeta, len(settingsRegistry))
+	copy(schema, settingsRegistry)
+	return schema
+}
+
+// settingMeta looks up the metadata of a setting by key
+func settingMeta(key string) (SettingMeta, bool) {
+	for _, meta := range settingsRegistry {
+		if meta.Key == key {
+			return meta, true
+		}
+	}
+	return SettingMeta{}, false
+}
+
+// validateEnumSetting checks value against the allowed values of an enum setting
+func validateEnumSetting(key, value string) error {
+	meta, ok := settingMeta(key)
+	if !ok || meta.Type != "enum" {
+		return nil
+	}
+	for _, allowed := range meta.AllowedValues {
+		if value == allowed {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid %s %q, must be one of: %s", key, value, strings.Join(meta.AllowedValues, ", "))
+}
@@ -0,0 +1,35 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// targetVarPattern matches ${VAR} placeholders in a tunnel target
+var targetVarPattern = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// ResolveTarget replaces ${VAR} placeholders in a target with values from the
+// process environment. Targets are stored with their placeholders and only
+// resolved when a tunnel is validated or started.
+func ResolveTarget(target string) (string, error) {
+	var missing []string
+	resolved := targetVarPattern.ReplaceAllStringFunc(target, func(match string) string {
+		name := targetVarPattern.FindStringSubmatch(match)[1]
+		value, ok := os.LookupEnv(name)
+		if !ok {
+			missing = append(missing, name)
+		}
+		return value
+	})
+	if len(missing) > 0 {
+		return "", fmt.Errorf("target references undefined environment variable(s): %s", strings.Join(missing, ", "))
+	}
+	return resolved, nil
+}
+
+// IsTargetTemplate reports whether a target contains ${VAR} placeholders
+func IsTargetTemplate(target string) bool {
+	return targetVarPattern.MatchString(target)
+}
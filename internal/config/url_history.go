@@ -0,0 +1,73 @@
+package config
+
+import (
+	"context"
+	"pont/ent"
+	"pont/ent/urlhistory"
+	"time"
+)
+
+// MaxURLHistory is how many public URLs are kept per tunnel
+const MaxURLHistory = 20
+
+// URLHistoryEntry is a public URL a tunnel had and when it got it
+type URLHistoryEntry struct {
+	URL    string    `json:"url"`
+	SeenAt time.Time `json:"seen_at"`
+}
+
+// RecordPublicURL stores a tunnel's new public URL unless it is the same as
+// the last one recorded, then drops entries beyond MaxURLHistory
+func (m *Manager) RecordPublicURL(tunnelID, url string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	ctx := context.Background()
+	return retryLocked(func() error {
+		last, err := m.client.URLHistory.Query().
+			Where(urlhistory.TunnelIDEQ(tunnelID)).
+			Order(ent.Desc(urlhistory.FieldCreatedAt), ent.Desc(urlhistory.FieldID)).
+			First(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return err
+		}
+		if last != nil && last.URL == url {
+			return nil
+		}
+
+		if err := m.client.URLHistory.Create().SetTunnelID(tunnelID).SetURL(url).Exec(ctx); err != nil {
+			return err
+		}
+
+		stale, err := m.client.URLHistory.Query().
+			Where(urlhistory.TunnelIDEQ(tunnelID)).
+			Order(ent.Desc(urlhistory.FieldCreatedAt), ent.Desc(urlhistory.FieldID)).
+			Offset(MaxURLHistory).
+			IDs(ctx)
+		if err != nil || len(stale) == 0 {
+			return err
+		}
+		_, err = m.client.URLHistory.Delete().Where(urlhistory.IDIn(stale...)).Exec(ctx)
+		return err
+	})
+}
+
+// URLHistory returns the recorded public URLs of a tunnel, newest first
+func (m *Manager) URLHistory(tunnelID string) ([]URLHistoryEntry, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	rows, err := m.client.URLHistory.Query().
+		Where(urlhistory.TunnelIDEQ(tunnelID)).
+		Order(ent.Desc(urlhistory.FieldCreatedAt), ent.Desc(urlhistory.FieldID)).
+		All(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]URLHistoryEntry, len(rows))
+	for i, row := range rows {
+		entries[i] = URLHistoryEntry{URL: row.URL, SeenAt: row.CreatedAt}
+	}
+	return entries, nil
+}
@@ -0,0 +1,98 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// NgrokWebhookProviders are the webhook providers ngrok can verify at the edge
+var NgrokWebhookProviders = map[string]bool{
+	"bitbucket": true,
+	"box":       true,
+	"buildkite": true,
+	"calendly":  true,
+	"circleci":  true,
+	"docusign":  true,
+	"dropbox":   true,
+	"github":    true,
+	"gitlab":    true,
+	"hubspot":   true,
+	"intercom":  true,
+	"linear":    true,
+	"mailchimp": true,
+	"mailgun":   true,
+	"pagerduty": true,
+	"sendgrid":  true,
+	"sentry":    true,
+	"shopify":   true,
+	"slack":     true,
+	"sns":       true,
+	"square":    true,
+	"stripe":    true,
+	"svix":      true,
+	"twilio":    true,
+	"twitter":   true,
+	"typeform":  true,
+	"webex":     true,
+	"xero":      true,
+	"zendesk":   true,
+	"zoom":      true,
+}
+
+// validateWebhookVerification checks the ngrok webhook verification options
+func validateWebhookVerification(tunnel *TunnelConfig) error {
+	if tunnel.NgrokWebhookProvider == "" {
+		if tunnel.NgrokWebhookSecret != "" {
+			return fmt.Errorf("ngrok_webhook_secret requires ngrok_webhook_provider")
+		}
+		return nil
+	}
+
+	if tunnel.Type != TunnelTypeNgrok {
+		return fmt.Errorf("webhook verification is only supported for ngrok tunnels")
+	}
+	if strings.HasPrefix(tunnel.Target, "tcp://") || strings.HasPrefix(tunnel.Target, "tls://") {
+		return fmt.Errorf("webhook verification is only supported for HTTP tunnels")
+	}
+
+	if !NgrokWebhookProviders[tunnel.NgrokWebhookProvider] {
+		providers := make([]string, 0, len(NgrokWebhookProviders))
+		for p := range NgrokWebhookProviders {
+			providers = append(providers, p)
+		}
+		sort.Strings(providers)
+		return fmt.Errorf("unknown webhook provider %q, must be one of: %s",
+			tunnel.NgrokWebhookProvider, strings.Join(providers, ", "))
+	}
+
+	if tunnel.NgrokWebhookSecret == "" {
+		return fmt.Errorf("ngrok_webhook_secret is required for webhook verification")
+	}
+
+	return nil
+}
+
+// validateNgrokInternal checks the ngrok internal endpoint option
+func validateNgrokInternal(tunnel *TunnelConfig) error {
+	if !tunnel.NgrokInternal {
+		return nil
+	}
+
+	if tunnel.Type != TunnelTypeNgrok {
+		return fmt.Errorf("internal endpoints are only supported for ngrok tunnels")
+	}
+	if strings.HasPrefix(tunnel.Target, "tcp://") || strings.HasPrefix(tunnel.Target, "tls://") {
+		return fmt.Errorf("internal endpoints are only supported for HTTP tunnels")
+	}
+
+	host := tunnel.NgrokDomain
+	if _, rest, found := strings.Cut(host, "://"); found {
+		host = rest
+	}
+	if !strings.HasSuffix(strings.TrimSuffix(host, "/"), ".internal") {
+		return fmt.Errorf("internal endpoints need an ngrok_domain ending in .internal, e.g. https://api.example.internal")
+	}
+
+	return nil
+}
@@ -3,27 +3,95 @@ package db
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"os"
 	"path/filepath"
 	"pont/ent"
+	"pont/ent/migrate"
+	"pont/internal/logger"
+	"strings"
+	"time"
 
-	_ "modernc.org/sqlite"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
 	"entgo.io/ent/dialect"
 	entsql "entgo.io/ent/dialect/sql"
 )
 
+// busyTimeout is how long SQLite waits for a lock before giving up
+const busyTimeout = 5 * time.Second
+
+// Options configures the database
+type Options struct {
+	// AutoMigrate applies schema changes on startup; when false the schema
+	// is only verified, never altered
+	AutoMigrate bool
+
+	// WAL enables write-ahead logging with synchronous=NORMAL, so reads do
+	// not block on writes. A power loss may drop the last commits, but the
+	// database stays consistent.
+	WAL bool
+
+	// MoveCorrupt renames a corrupt database file to pont.db.corrupt and
+	// starts with a new one instead of failing
+	MoveCorrupt bool
+}
+
 // Init initializes the database and returns an ent client
-func Init(dataDir string) (*ent.Client, error) {
+func Init(dataDir string, opts Options) (*ent.Client, error) {
 	dbPath := filepath.Join(dataDir, "pont.db")
 
-	// Enable foreign key constraints
-	dsn := fmt.Sprintf("%s?_fk=1", dbPath)
+	// Enable foreign key constraints. busy_timeout makes a connection wait
+	// for a concurrent writer instead of failing with "database is locked".
+	dsn := fmt.Sprintf("%s?_fk=1&_pragma=busy_timeout(%d)", dbPath, busyTimeout.Milliseconds())
+
+	// The journal mode is stored in the database file, so switch back
+	// explicitly when WAL is turned off again
+	if opts.WAL {
+		dsn += "&_pragma=journal_mode(WAL)&_pragma=synchronous(NORMAL)"
+	} else {
+		dsn += "&_pragma=journal_mode(DELETE)"
+	}
+
+	// A missing file is a new installation; an existing one must be a sound
+	// database, or migrating it fails with errors that do not say why
+	_, statErr := os.Stat(dbPath)
+	existing := statErr == nil
+	if !existing {
+		logger.Sugar.Infof("No database at %s, creating a new one", dbPath)
+	}
 
 	db, err := sql.Open("sqlite", dsn)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open database: %w", err)
 	}
 
+	if existing {
+		if err := checkIntegrity(db); err != nil {
+			db.Close()
+			// Only a damaged file is moved aside; a locked or unreadable
+			// one is left alone and fails the startup
+			if !errors.Is(err, errCorrupt) {
+				return nil, fmt.Errorf("failed to check database %s: %w", dbPath, err)
+			}
+			if !opts.MoveCorrupt {
+				return nil, fmt.Errorf("database %s is corrupt: %w "+
+					"(restore it from a backup, or set DB_MOVE_CORRUPT=true to move it aside and start with an empty database)",
+					dbPath, err)
+			}
+			moved, moveErr := moveCorrupt(dbPath)
+			if moveErr != nil {
+				return nil, fmt.Errorf("database %s is corrupt (%v) and could not be moved aside: %w", dbPath, err, moveErr)
+			}
+			logger.Sugar.Warnf("Database %s is corrupt (%v), moved it to %s and starting with an empty database", dbPath, err, moved)
+
+			if db, err = sql.Open("sqlite", dsn); err != nil {
+				return nil, fmt.Errorf("failed to open database: %w", err)
+			}
+		}
+	}
+
 	// Ensure foreign keys are enabled
 	if _, err := db.Exec("PRAGMA foreign_keys = ON"); err != nil {
 		return nil, fmt.Errorf("failed to enable foreign keys: %w", err)
@@ -32,10 +100,162 @@ func Init(dataDir string) (*ent.Client, error) {
 	drv := entsql.OpenDB(dialect.SQLite, db)
 	client := ent.NewClient(ent.Driver(drv))
 
+	if !opts.AutoMigrate {
+		if err := verifySchema(db); err != nil {
+			client.Close()
+			return nil, err
+		}
+		return client, nil
+	}
+
 	// Run auto migration
 	if err := client.Schema.Create(context.Background()); err != nil {
-		return nil, fmt.Errorf("failed to create schema: %w", err)
+		client.Close()
+		return nil, fmt.Errorf("failed to migrate database schema: %w "+
+			"(back up %s before retrying; if the schema is managed externally, set DB_AUTO_MIGRATE=false)",
+			err, dbPath)
 	}
 
 	return client, nil
 }
+
+// errCorrupt marks checkIntegrity errors that mean the file is damaged
+var errCorrupt = errors.New("integrity check failed")
+
+// checkIntegrity runs SQLite's quick check. Problems it reports, and files
+// SQLite rejects as corrupt or as not a database, yield errCorrupt; any
+// other error, e.g. a lock held by another process, is returned as is.
+func checkIntegrity(db *sql.DB) error {
+	rows, err := db.Query("PRAGMA quick_check")
+	if err != nil {
+		return corruptionError(err)
+	}
+	defer rows.Close()
+
+	var problems []string
+	for rows.Next() {
+		var result string
+		if err := rows.Scan(&result); err != nil {
+			return corruptionError(err)
+		}
+		if result != "ok" {
+			problems = append(problems, result)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return corruptionError(err)
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("%w: %s", errCorrupt, strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// corruptionError wraps err with errCorrupt if SQLite failed with
+// SQLITE_CORRUPT or SQLITE_NOTADB
+func corruptionError(err error) error {
+	var sqliteErr *sqlite.Error
+	if errors.As(err, &sqliteErr) {
+		switch sqliteErr.Code() & 0xff {
+		case sqlite3.SQLITE_CORRUPT, sqlite3.SQLITE_NOTADB:
+			return fmt.Errorf("%w: %w", errCorrupt, err)
+		}
+	}
+	return err
+}
+
+// moveCorrupt renames the database and its WAL files with a .corrupt suffix,
+// returning the new database path
+func moveCorrupt(dbPath string) (string, error) {
+	dest := dbPath + ".corrupt"
+	if _, err := os.Stat(dest); err == nil {
+		return "", fmt.Errorf("%s already exists, remove it first", dest)
+	}
+	if err := os.Rename(dbPath, dest); err != nil {
+		return "", err
+	}
+	for _, suffix := range []string{"-wal", "-shm"} {
+		if err := os.Rename(dbPath+suffix, dest+suffix); err != nil && !os.IsNotExist(err) {
+			return "", err
+		}
+	}
+	return dest, nil
+}
+
+// verifySchema checks that every table and column known to ent exists in the database
+func verifySchema(db *sql.DB) error {
+	var missing []string
+	for _, table := range migrate.Tables {
+		columns, err := tableColumns(db, table.Name)
+		if err != nil {
+			return fmt.Errorf("failed to inspect table %s: %w", table.Name, err)
+		}
+		if len(columns) == 0 {
+			logger.Sugar.Warnf("Schema verification: table %q is missing", table.Name)
+			missing = append(missing, table.Name)
+			continue
+		}
+		for _, col := range table.Columns {
+			if !columns[col.Name] {
+				logger.Sugar.Warnf("Schema verification: column %q is missing from table %q", col.Name, table.Name)
+				missing = append(missing, table.Name+"."+col.Name)
+			}
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("database schema is not compatible, missing: %s "+
+			"(apply the pending migrations or unset DB_AUTO_MIGRATE=false)", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// tableColumns returns the set of column names of a table, empty if the table does not exist
+func tableColumns(db *sql.DB, table string) (map[string]bool, error) {
+	rows, err := db.Query(fmt.Sprintf("PRAGMA table_info(%q)", table))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	columns := make(map[string]bool)
+	for rows.Next() {
+		var (
+			cid       int
+			name      string
+			colType   string
+			notNull   int
+			dfltValue sql.NullString
+			pk        int
+		)
+		if err := rows.Scan(&cid, &name, &colType, &notNull, &dfltValue, &pk); err != nil {
+			return nil, err
+		}
+		columns[name] = true
+	}
+	return columns, rows.Err()
+}
+
+// Export writes a consistent copy of the database in dataDir to destPath.
+// destPath must not exist yet; the source database is only read.
+func Export(dataDir, destPath string) error {
+	srcPath := filepath.Join(dataDir, "pont.db")
+	if _, err := os.Stat(srcPath); err != nil {
+		return fmt.Errorf("database not found: %w", err)
+	}
+	if _, err := os.Stat(destPath); err == nil {
+		return fmt.Errorf("%s already exists", destPath)
+	}
+
+	db, err := sql.Open("sqlite", srcPath)
+	if err != nil {
+		return fmt.Errorf("failed to open database: %w", err)
+	}
+	defer db.Close()
+
+	// VACUUM INTO produces a compacted snapshot that includes any WAL content
+	if _, err := db.Exec("VACUUM INTO ?", destPath); err != nil {
+		return fmt.Errorf("failed to export database: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package db
+
+import (
+	"database/sql"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+
+	"pont/internal/logger"
+)
+
+func TestMain(m *testing.M) {
+	logger.Sugar = zap.NewNop().Sugar()
+	os.Exit(m.Run())
+}
+
+// A file that is not a database is corrupt: startup fails, or with
+// MoveCorrupt it is moved aside for a new database
+func TestInitCorrupt(t *testing.T) {
+	dir := t.TempDir()
+	dbPath := filepath.Join(dir, "pont.db")
+	garbage := []byte(strings.Repeat("not a database ", 100))
+	if err := os.WriteFile(dbPath, garbage, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := Init(dir, Options{AutoMigrate: true}); err == nil || !strings.Contains(err.Error(), "is corrupt") {
+		t.Fatalf("Init = %v, want a corrupt database error", err)
+	}
+	if _, err := os.Stat(dbPath + ".corrupt"); !os.IsNotExist(err) {
+		t.Fatalf("database moved without MoveCorrupt")
+	}
+
+	client, err := Init(dir, Options{AutoMigrate: true, MoveCorrupt: true})
+	if err != nil {
+		t.Fatalf("Init with MoveCorrupt: %v", err)
+	}
+	client.Close()
+	if moved, err := os.ReadFile(dbPath + ".corrupt"); err != nil || string(moved) != string(garbage) {
+		t.Errorf("corrupt database not kept as pont.db.corrupt: %v", err)
+	}
+}
+
+// A database locked by another connection is not corrupt, so it must never
+// be moved aside
+func TestCheckIntegrityLocked(t *testing.T) {
+	dir := t.TempDir()
+	client, err := Init(dir, Options{AutoMigrate: true})
+	if err != nil {
+		t.Fatalf("Init: %v", err)
+	}
+	client.Close()
+	dbPath := filepath.Join(dir, "pont.db")
+
+	holder, err := sql.Open("sqlite", dbPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer holder.Close()
+	holder.SetMaxOpenConns(1)
+	if _, err := holder.Exec("PRAGMA locking_mode = EXCLUSIVE; BEGIN EXCLUSIVE"); err != nil {
+		t.Fatalf("lock database: %v", err)
+	}
+	defer holder.Exec("ROLLBACK")
+
+	// No busy_timeout, so the check fails right away with SQLITE_BUSY
+	db, err := sql.Open("sqlite", dbPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	err = checkIntegrity(db)
+	if err == nil {
+		t.Fatal("checkIntegrity succeeded on a locked database")
+	}
+	if errors.Is(err, errCorrupt) {
+		t.Errorf("locked database reported as corrupt: %v", err)
+	}
+
+	if err := checkIntegrity(holder); err != nil {
+		t.Errorf("checkIntegrity on a sound database: %v", err)
+	}
+}
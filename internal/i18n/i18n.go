@@ -1,8 +1,13 @@
 package i18n
 
 import (
+	"bytes"
 	"embed"
 	"encoding/json"
+	"fmt"
+	"pont/internal/logger"
+	"strings"
+	"sync"
 
 	"github.com/nicksnyder/go-i18n/v2/i18n"
 	"golang.org/x/text/language"
@@ -13,35 +18,141 @@ var localeFS embed.FS
 
 var bundle *i18n.Bundle
 
+// fallback localizes into the bundle's default language, English
+var fallback *i18n.Localizer
+
+// defaultLang is used when a request names no supported language
+var defaultLang = language.English
+
+// reportedMissing remembers which missing translations were already logged
+var reportedMissing sync.Map
+
 // Init initializes the i18n bundle with embedded locale files
 func Init() error {
 	bundle = i18n.NewBundle(language.English)
 	bundle.RegisterUnmarshalFunc("json", json.Unmarshal)
 
-	// Load all locale files
-	locales := []string{"en", "zh", "ja"}
-	for _, locale := range locales {
-		if _, err := bundle.LoadMessageFileFS(localeFS, "locales/"+locale+".json"); err != nil {
-			return err
+	// English is the fallback for every message, so it must load. A broken
+	// translation only disables that language.
+	if err := loadLocale("en"); err != nil {
+		return fmt.Errorf("load default locale: %w", err)
+	}
+	for _, locale := range []string{"zh", "ja"} {
+		if err := loadLocale(locale); err != nil {
+			logger.Sugar.Warnf("Skipping locale %s: %v", locale, err)
 		}
 	}
+	fallback = i18n.NewLocalizer(bundle, language.English.String())
 
 	return nil
 }
 
-// GetLocalizer returns a localizer for the given language tags
+// loadLocale adds an embedded locale file to the bundle. An empty file is an
+// error too: go-i18n would register the language without any messages.
+func loadLocale(locale string) error {
+	path := "locales/" + locale + ".json"
+	data, err := localeFS.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if len(bytes.TrimSpace(data)) == 0 {
+		return fmt.Errorf("%s is empty", path)
+	}
+	_, err = bundle.ParseMessageFileBytes(data, path)
+	return err
+}
+
+// Supported returns the canonical tag of lang if a locale is loaded for it
+func Supported(lang string) (string, bool) {
+	tag, err := language.Parse(strings.TrimSpace(lang))
+	if err != nil || bundle == nil {
+		return "", false
+	}
+	base, _ := tag.Base()
+	for _, loaded := range bundle.LanguageTags() {
+		if b, _ := loaded.Base(); b == base {
+			return loaded.String(), true
+		}
+	}
+	return "", false
+}
+
+// SetDefaultLanguage sets the language used when a request names no
+// supported language. Init must be called first.
+func SetDefaultLanguage(lang string) error {
+	canonical, ok := Supported(lang)
+	if !ok {
+		return fmt.Errorf("unsupported language %q, available: %s", lang, strings.Join(Languages(), ", "))
+	}
+	defaultLang = language.Make(canonical)
+	return nil
+}
+
+// DefaultLanguage returns the configured default language
+func DefaultLanguage() string {
+	return defaultLang.String()
+}
+
+// Languages lists the loaded locales
+func Languages() []string {
+	if bundle == nil {
+		return nil
+	}
+	tags := bundle.LanguageTags()
+	langs := make([]string, len(tags))
+	for i, tag := range tags {
+		langs[i] = tag.String()
+	}
+	return langs
+}
+
+// GetLocalizer returns a localizer for the given language tags. Each argument
+// may also be a raw Accept-Language header value such as "ja,en;q=0.8".
 func GetLocalizer(langs ...string) *i18n.Localizer {
-	return i18n.NewLocalizer(bundle, langs...)
+	tags := make([]string, 0, len(langs))
+	for _, lang := range langs {
+		if lang = strings.TrimSpace(lang); lang != "" {
+			tags = append(tags, lang)
+		}
+	}
+	return i18n.NewLocalizer(bundle, tags...)
 }
 
-// T translates a message ID with optional template data
+// T translates a message ID with optional template data. A message missing
+// from the requested language falls back to English, and only a message
+// missing everywhere is returned as its raw ID. Missing translations are
+// logged once per language and message.
 func T(localizer *i18n.Localizer, messageID string, templateData map[string]interface{}) string {
-	msg, err := localizer.Localize(&i18n.LocalizeConfig{
+	cfg := &i18n.LocalizeConfig{
 		MessageID:    messageID,
 		TemplateData: templateData,
-	})
-	if err != nil {
-		return messageID
 	}
-	return msg
+
+	msg, tag, err := localizer.LocalizeWithTag(cfg)
+	if err == nil {
+		return msg
+	}
+	reportMissing(tag.String(), messageID, err)
+
+	// go-i18n already returns the default language text alongside a
+	// not-found error; otherwise ask the English localizer directly
+	if msg != "" {
+		return msg
+	}
+	if fallback != nil {
+		if msg, err := fallback.Localize(cfg); err == nil {
+			return msg
+		}
+	}
+	return messageID
+}
+
+// reportMissing logs a localization failure the first time it is seen
+func reportMissing(lang, messageID string, err error) {
+	if _, seen := reportedMissing.LoadOrStore(lang+"/"+messageID, true); seen {
+		return
+	}
+	if logger.Sugar != nil {
+		logger.Sugar.Warnf("Missing translation for %q (%s): %v", messageID, lang, err)
+	}
 }
@@ -0,0 +1,69 @@
+package logger
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// FileHealth describes whether log entries are reaching the log file
+type FileHealth struct {
+	Path        string    `json:"path"`
+	Healthy     bool      `json:"healthy"`
+	LastError   string    `json:"last_error,omitempty"`
+	LastErrorAt time.Time `json:"last_error_at,omitzero"`
+}
+
+// checkedWriter records write failures of the file writer so a full disk or
+// revoked permissions show up as a degraded state instead of silently
+// dropping logs. Console output is unaffected and keeps every entry.
+type checkedWriter struct {
+	w io.Writer
+
+	mu          sync.Mutex
+	failing     bool
+	lastError   string
+	lastErrorAt time.Time
+}
+
+var fileWriterHealth *checkedWriter
+
+func (cw *checkedWriter) Write(p []byte) (int, error) {
+	n, err := cw.w.Write(p)
+
+	cw.mu.Lock()
+	defer cw.mu.Unlock()
+	if err != nil {
+		if !cw.failing {
+			// Not through zap: the failing file writer is part of it
+			fmt.Fprintf(os.Stderr, "WARNING: writing to log file %s failed, logging to console only: %v\n", logPath, err)
+		}
+		cw.failing = true
+		cw.lastError = err.Error()
+		cw.lastErrorAt = time.Now()
+		// Report success so zap does not print an error for every entry
+		return len(p), nil
+	}
+	if cw.failing {
+		fmt.Fprintf(os.Stderr, "Log file %s is writable again\n", logPath)
+		cw.failing = false
+	}
+	return n, nil
+}
+
+// GetFileHealth reports the state of the log file writer
+func GetFileHealth() FileHealth {
+	health := FileHealth{Path: logPath, Healthy: true}
+	if fileWriterHealth == nil {
+		return health
+	}
+
+	fileWriterHealth.mu.Lock()
+	defer fileWriterHealth.mu.Unlock()
+	health.Healthy = !fileWriterHealth.failing
+	health.LastError = fileWriterHealth.lastError
+	health.LastErrorAt = fileWriterHealth.lastErrorAt
+	return health
+}
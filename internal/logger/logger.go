@@ -1,9 +1,14 @@
 package logger
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
 	"os"
+	"sort"
 	"sync"
 	"time"
+	"unicode/utf8"
 
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -11,18 +16,67 @@ import (
 )
 
 var (
-	Sugar  *zap.SugaredLogger
-	logger *zap.Logger
-	mu     sync.RWMutex
-	buffer *CircularBuffer
-	subs   map[string]*Subscriber
+	Sugar   *zap.SugaredLogger
+	logger  *zap.Logger
+	mu      sync.RWMutex
+	buffer  *CircularBuffer
+	subs    map[string]*Subscriber
+	logPath string
+	logLoc  = time.Local
+	logFile *lumberjack.Logger
+
+	broadcastQueue chan LogEntry
+	maxMessageSize int
 )
 
+// defaultBroadcastQueue is the number of entries buffered for live subscribers
+const defaultBroadcastQueue = 1024
+
+// Options configures the logger
+type Options struct {
+	Level    string         // zap level name, defaults to info
+	File     string         // path of the rotated JSON log file
+	Location *time.Location // timezone of log timestamps, nil means local time
+
+	// BroadcastQueue is how many entries may wait for delivery to live
+	// subscribers before new ones are dropped; 0 means the default
+	BroadcastQueue int
+
+	// MaxMessageSize truncates messages kept in memory and streamed to
+	// subscribers to this many bytes; the log file always gets the full
+	// message. 0 means no limit.
+	MaxMessageSize int
+}
+
 // LogEntry represents a single log entry
 type LogEntry struct {
+	// ID increases by one per entry kept in memory, so stream clients can
+	// resume after a reconnect; entries read back from the log file have none
+	ID        uint64    `json:"id,omitempty"`
 	Timestamp time.Time `json:"timestamp"`
 	Level     string    `json:"level"`
 	Message   string    `json:"message"`
+	Category  string    `json:"category,omitempty"`
+
+	// Tunnel is the ID of the tunnel the entry is about, see ForTunnel
+	Tunnel string `json:"tunnel,omitempty"`
+}
+
+// Log entry categories, set with the "category" field
+const (
+	CategoryLifecycle = "lifecycle" // startup and shutdown milestones
+	CategoryAccess    = "access"    // HTTP request log
+)
+
+// Lifecycle logs a startup or shutdown milestone so clients can filter on it
+func Lifecycle(msg string, keysAndValues ...interface{}) {
+	Sugar.WithOptions(zap.AddCallerSkip(1)).Infow(msg, append([]interface{}{"category", CategoryLifecycle}, keysAndValues...)...)
+}
+
+// ForTunnel returns a logger that tags its entries with the tunnel ID, so
+// they can be streamed per tunnel
+func ForTunnel(id string) *zap.SugaredLogger {
+	return Sugar.With("tunnel", id)
 }
 
 // CircularBuffer stores recent log entries
@@ -31,6 +85,7 @@ type CircularBuffer struct {
 	entries []LogEntry
 	size    int
 	index   int
+	lastID  uint64
 }
 
 // NewCircularBuffer creates a new circular buffer
@@ -41,17 +96,33 @@ func NewCircularBuffer(size int) *CircularBuffer {
 	}
 }
 
-// Add adds a log entry to the buffer
-func (cb *CircularBuffer) Add(entry LogEntry) {
+// Add adds a log entry to the buffer, assigning it the next ID
+func (cb *CircularBuffer) Add(entry LogEntry) LogEntry {
 	cb.mu.Lock()
 	defer cb.mu.Unlock()
 
+	cb.lastID++
+	entry.ID = cb.lastID
+
 	if len(cb.entries) < cb.size {
 		cb.entries = append(cb.entries, entry)
 	} else {
 		cb.entries[cb.index] = entry
 		cb.index = (cb.index + 1) % cb.size
 	}
+	return entry
+}
+
+// Since returns the entries after the one with the given ID in chronological
+// order. An ID newer than any entry comes from before a restart, so every
+// entry is returned then.
+func (cb *CircularBuffer) Since(id uint64) []LogEntry {
+	all := cb.GetAll()
+	if len(all) == 0 || id > all[len(all)-1].ID {
+		return all
+	}
+	i := sort.Search(len(all), func(i int) bool { return all[i].ID > id })
+	return all[i:]
 }
 
 // GetAll returns all log entries in chronological order
@@ -76,17 +147,32 @@ type Subscriber struct {
 	ID      string
 	Channel chan LogEntry
 	LastSeen time.Time
+
+	// filter drops entries before they take up room in Channel
+	filter func(LogEntry) bool
 }
 
 // Init initializes the logger
-func Init(logLevel, logFile string) error {
+func Init(opts Options) error {
 	// Create circular buffer for recent logs
 	buffer = NewCircularBuffer(500)
 	subs = make(map[string]*Subscriber)
+	logPath = opts.File
+	if opts.Location != nil {
+		logLoc = opts.Location
+	}
+
+	queueSize := opts.BroadcastQueue
+	if queueSize <= 0 {
+		queueSize = defaultBroadcastQueue
+	}
+	broadcastQueue = make(chan LogEntry, queueSize)
+	maxMessageSize = opts.MaxMessageSize
+	go fanOut(broadcastQueue)
 
 	// Configure log level
 	level := zapcore.InfoLevel
-	if err := level.UnmarshalText([]byte(logLevel)); err != nil {
+	if err := level.UnmarshalText([]byte(opts.Level)); err != nil {
 		level = zapcore.InfoLevel
 	}
 
@@ -100,7 +186,7 @@ func Init(logLevel, logFile string) error {
 		StacktraceKey:  "stacktrace",
 		LineEnding:     zapcore.DefaultLineEnding,
 		EncodeLevel:    zapcore.LowercaseLevelEncoder,
-		EncodeTime:     zapcore.ISO8601TimeEncoder,
+		EncodeTime:     encodeTime,
 		EncodeDuration: zapcore.SecondsDurationEncoder,
 		EncodeCaller:   zapcore.ShortCallerEncoder,
 	}
@@ -115,13 +201,15 @@ func Init(logLevel, logFile string) error {
 	consoleWriter := zapcore.AddSync(os.Stdout)
 
 	// Create file writer with rotation
-	fileWriter := zapcore.AddSync(&lumberjack.Logger{
-		Filename:   logFile,
+	logFile = &lumberjack.Logger{
+		Filename:   opts.File,
 		MaxSize:    100, // MB
 		MaxBackups: 10,
 		MaxAge:     30, // days
 		Compress:   true,
-	})
+	}
+	fileWriterHealth = &checkedWriter{w: logFile}
+	fileWriter := zapcore.AddSync(fileWriterHealth)
 
 	// Create broadcast writer
 	broadcastWriter := zapcore.AddSync(&broadcastWriter{})
@@ -140,37 +228,134 @@ func Init(logLevel, logFile string) error {
 	return nil
 }
 
-// broadcastWriter broadcasts log entries to subscribers
-type broadcastWriter struct{}
+// encodeTime writes ISO8601 timestamps in the configured timezone
+func encodeTime(t time.Time, enc zapcore.PrimitiveArrayEncoder) {
+	zapcore.ISO8601TimeEncoder(t.In(logLoc), enc)
+}
+
+// broadcastWriter broadcasts log entries to subscribers. zap normally hands
+// it one whole line per Write, but a large entry may arrive in pieces, so
+// bytes are collected until a newline completes the line.
+type broadcastWriter struct {
+	mu      sync.Mutex
+	partial []byte
+}
 
 func (bw *broadcastWriter) Write(p []byte) (n int, err error) {
+	bw.mu.Lock()
+	defer bw.mu.Unlock()
+
+	data := p
+	if len(bw.partial) > 0 {
+		bw.partial = append(bw.partial, p...)
+		data = bw.partial
+	}
+	for {
+		i := bytes.IndexByte(data, '\n')
+		if i < 0 {
+			break
+		}
+		broadcastLine(data[:i+1])
+		data = data[i+1:]
+	}
+	// Keep the unterminated remainder for the next Write
+	bw.partial = append(bw.partial[:0], data...)
+
+	return len(p), nil
+}
+
+// Sync emits a pending unterminated line
+func (bw *broadcastWriter) Sync() error {
+	bw.mu.Lock()
+	defer bw.mu.Unlock()
+
+	if len(bw.partial) > 0 {
+		broadcastLine(bw.partial)
+		bw.partial = bw.partial[:0]
+	}
+	return nil
+}
+
+// broadcastLine turns one complete encoded log line into an entry
+func broadcastLine(line []byte) {
 	// Parse log entry
+	level, category, tunnel := entryFields(line)
 	entry := LogEntry{
-		Timestamp: time.Now(),
-		Level:     "info",
-		Message:   string(p),
+		Timestamp: time.Now().In(logLoc),
+		Level:     level,
+		Message:   truncateMessage(string(line), maxMessageSize),
+		Category:  category,
+		Tunnel:    tunnel,
 	}
 
 	// Add to buffer
-	buffer.Add(entry)
+	entry = buffer.Add(entry)
 
-	// Broadcast to subscribers
-	mu.RLock()
-	for _, sub := range subs {
-		select {
-		case sub.Channel <- entry:
-			sub.LastSeen = time.Now()
-		default:
-			// Channel full, skip
-		}
+	// Hand off to the fan-out goroutine so logging never waits on subscribers
+	select {
+	case broadcastQueue <- entry:
+	default:
+		// Queue full, live subscribers miss this entry; it stays in the buffer
 	}
-	mu.RUnlock()
+}
 
-	return len(p), nil
+// entryFields extracts the level, category and tunnel fields from an encoded
+// JSON log line. It reads the full line, so the level survives truncation of
+// the message.
+func entryFields(p []byte) (level, category, tunnel string) {
+	var fields struct {
+		Level    string `json:"level"`
+		Category string `json:"category"`
+		Tunnel   string `json:"tunnel"`
+	}
+	if err := json.Unmarshal(p, &fields); err != nil || fields.Level == "" {
+		fields.Level = "info"
+	}
+	return fields.Level, fields.Category, fields.Tunnel
+}
+
+// truncateMessage shortens msg to at most max bytes plus a marker, cutting on
+// a UTF-8 boundary
+func truncateMessage(msg string, max int) string {
+	if max <= 0 || len(msg) <= max {
+		return msg
+	}
+	cut := max
+	for cut > 0 && !utf8.RuneStart(msg[cut]) {
+		cut--
+	}
+	return msg[:cut] + fmt.Sprintf("… [truncated %d bytes]", len(msg)-cut)
+}
+
+// fanOut delivers queued entries to every subscriber
+func fanOut(queue <-chan LogEntry) {
+	for entry := range queue {
+		mu.RLock()
+		for _, sub := range subs {
+			if sub.filter != nil && !sub.filter(entry) {
+				// Not stuck, just quiet: keep it from being cleaned up
+				sub.LastSeen = time.Now()
+				continue
+			}
+			select {
+			case sub.Channel <- entry:
+				sub.LastSeen = time.Now()
+			default:
+				// Channel full, skip
+			}
+		}
+		mu.RUnlock()
+	}
 }
 
 // Subscribe creates a new log subscriber
 func Subscribe(id string) *Subscriber {
+	return SubscribeFiltered(id, nil)
+}
+
+// SubscribeFiltered creates a log subscriber that only receives the entries
+// filter accepts; a nil filter accepts all
+func SubscribeFiltered(id string, filter func(LogEntry) bool) *Subscriber {
 	mu.Lock()
 	defer mu.Unlock()
 
@@ -178,6 +363,7 @@ func Subscribe(id string) *Subscriber {
 		ID:       id,
 		Channel:  make(chan LogEntry, 100),
 		LastSeen: time.Now(),
+		filter:   filter,
 	}
 
 	subs[id] = sub
@@ -195,11 +381,24 @@ func Unsubscribe(id string) {
 	}
 }
 
+// SubscriberCount returns the number of log subscribers
+func SubscriberCount() int {
+	mu.RLock()
+	defer mu.RUnlock()
+	return len(subs)
+}
+
 // GetRecentLogs returns recent log entries
 func GetRecentLogs() []LogEntry {
 	return buffer.GetAll()
 }
 
+// RecentLogsSince returns the recent entries logged after the entry with the
+// given ID, for replaying what a reconnecting stream client missed
+func RecentLogsSince(id uint64) []LogEntry {
+	return buffer.Since(id)
+}
+
 // CleanupInactiveSubscribers removes inactive subscribers
 func CleanupInactiveSubscribers(timeout time.Duration) {
 	mu.Lock()
@@ -0,0 +1,88 @@
+package logger
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+// initBroadcast sets up the in-memory buffer and live subscribers the way
+// Init does, without the console and file outputs
+func initBroadcast(tb testing.TB, queueSize int) {
+	tb.Helper()
+	buffer = NewCircularBuffer(500)
+	subs = make(map[string]*Subscriber)
+	maxMessageSize = 0
+	queue := make(chan LogEntry, queueSize)
+	broadcastQueue = queue
+	go fanOut(queue)
+	tb.Cleanup(func() { close(queue) })
+}
+
+// A line zap hands over in two Writes must become a single entry holding
+// the whole line
+func TestBroadcastWriterSplitLine(t *testing.T) {
+	initBroadcast(t, defaultBroadcastQueue)
+	sub := Subscribe("split")
+	defer Unsubscribe("split")
+
+	line := `{"level":"warn","msg":"` + strings.Repeat("x", 5000) + `","tunnel":"t1"}` + "\n"
+	bw := &broadcastWriter{}
+	for _, part := range []string{line[:2048], line[2048:]} {
+		if n, err := bw.Write([]byte(part)); err != nil || n != len(part) {
+			t.Fatalf("Write = %d, %v, want %d, nil", n, err, len(part))
+		}
+	}
+
+	select {
+	case entry := <-sub.Channel:
+		if entry.Message != line {
+			t.Errorf("message has %d bytes, want the full line of %d", len(entry.Message), len(line))
+		}
+		if entry.Level != "warn" || entry.Tunnel != "t1" {
+			t.Errorf("level, tunnel = %q, %q, want warn, t1", entry.Level, entry.Tunnel)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("no entry broadcast")
+	}
+	select {
+	case entry := <-sub.Channel:
+		t.Errorf("second entry broadcast: %q", entry.Message)
+	case <-time.After(50 * time.Millisecond):
+	}
+	if got := len(GetRecentLogs()); got != 1 {
+		t.Errorf("buffer holds %d entries, want 1", got)
+	}
+}
+
+// BenchmarkFanOut measures delivering entries to several live subscribers
+// that drain their channels
+func BenchmarkFanOut(b *testing.B) {
+	entry := LogEntry{Timestamp: time.Now(), Level: "info", Message: "request served", Tunnel: "t1"}
+	for _, n := range []int{1, 10, 50} {
+		b.Run(fmt.Sprintf("subscribers=%d", n), func(b *testing.B) {
+			initBroadcast(b, defaultBroadcastQueue)
+			for i := range n {
+				id := fmt.Sprintf("bench-%d", i)
+				sub := Subscribe(id)
+				go func() {
+					for range sub.Channel {
+					}
+				}()
+				b.Cleanup(func() { Unsubscribe(id) })
+			}
+
+			queue := make(chan LogEntry, defaultBroadcastQueue)
+			go func() {
+				defer close(queue)
+				for range b.N {
+					queue <- entry
+				}
+			}()
+			b.ReportAllocs()
+			b.ResetTimer()
+			fanOut(queue)
+		})
+	}
+}
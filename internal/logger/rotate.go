@@ -0,0 +1,41 @@
+package logger
+
+import (
+	"errors"
+	"path/filepath"
+)
+
+// Rotate closes the current log file, moves it to a timestamped backup and
+// starts a new one. It returns the path of the new file and of the backup.
+func Rotate() (newFile, oldFile string, err error) {
+	if logFile == nil {
+		return "", "", errors.New("file logging is not initialized")
+	}
+
+	before, err := logFiles()
+	if err != nil {
+		return "", "", err
+	}
+	known := make(map[string]bool, len(before))
+	for _, f := range before {
+		known[f] = true
+	}
+
+	if err := logFile.Rotate(); err != nil {
+		return "", "", err
+	}
+
+	// lumberjack does not report the backup name, so pick the file that appeared
+	after, err := logFiles()
+	if err != nil {
+		return "", "", err
+	}
+	for _, f := range after {
+		if f != logPath && !known[f] {
+			oldFile = f
+			break
+		}
+	}
+
+	return filepath.Clean(logPath), oldFile, nil
+}
@@ -0,0 +1,150 @@
+package logger
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// MaxTailLines bounds how many lines TailFile will return
+const MaxTailLines = 5000
+
+// fileEntry mirrors the JSON encoder output written to the log file
+type fileEntry struct {
+	Time    string `json:"time"`
+	Level   string `json:"level"`
+	Message string `json:"msg"`
+}
+
+// TailFile returns the last n entries from the log file on disk, continuing into
+// rotated backups (newest first) when the current file holds fewer than n lines
+func TailFile(n int) ([]LogEntry, error) {
+	if logPath == "" {
+		return nil, fmt.Errorf("file logging is not initialized")
+	}
+	if n <= 0 {
+		return []LogEntry{}, nil
+	}
+	if n > MaxTailLines {
+		n = MaxTailLines
+	}
+
+	files, err := logFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	var lines []string
+	for _, file := range files {
+		fileLines, err := tailLines(file, n-len(lines))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", filepath.Base(file), err)
+		}
+		lines = append(fileLines, lines...)
+		if len(lines) >= n {
+			break
+		}
+	}
+
+	entries := make([]LogEntry, 0, len(lines))
+	for _, line := range lines {
+		entries = append(entries, parseFileLine(line))
+	}
+	return entries, nil
+}
+
+// logFiles returns the current log file followed by its rotated backups, newest first
+func logFiles() ([]string, error) {
+	dir := filepath.Dir(logPath)
+	ext := filepath.Ext(logPath)
+	prefix := strings.TrimSuffix(filepath.Base(logPath), ext) + "-"
+
+	dirEntries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	// lumberjack names backups <name>-<timestamp><ext>[.gz], so the timestamp sorts lexically
+	var backups []string
+	for _, e := range dirEntries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		if strings.HasSuffix(name, ext) || strings.HasSuffix(name, ext+".gz") {
+			backups = append(backups, name)
+		}
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(backups)))
+
+	files := make([]string, 0, len(backups)+1)
+	if _, err := os.Stat(logPath); err == nil {
+		files = append(files, logPath)
+	}
+	for _, name := range backups {
+		files = append(files, filepath.Join(dir, name))
+	}
+	return files, nil
+}
+
+// tailLines returns up to the last n lines of a (possibly gzip-compressed) file
+func tailLines(path string, n int) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var r io.Reader = f
+	if strings.HasSuffix(path, ".gz") {
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			return nil, err
+		}
+		defer gz.Close()
+		r = gz
+	}
+
+	ring := make([]string, n)
+	count := 0
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 10*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			continue
+		}
+		ring[count%n] = line
+		count++
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if count <= n {
+		return ring[:count], nil
+	}
+	start := count % n
+	return append(ring[start:], ring[:start]...), nil
+}
+
+// parseFileLine converts a JSON log line into a LogEntry, keeping the raw line on failure
+func parseFileLine(line string) LogEntry {
+	var fe fileEntry
+	if err := json.Unmarshal([]byte(line), &fe); err != nil {
+		return LogEntry{Level: "info", Message: line}
+	}
+
+	entry := LogEntry{Level: fe.Level, Message: fe.Message}
+	if ts, err := time.Parse("2006-01-02T15:04:05.000Z0700", fe.Time); err == nil {
+		entry.Timestamp = ts
+	}
+	return entry
+}
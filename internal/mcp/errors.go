@@ -0,0 +1,134 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"pont/internal/logger"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// Bounds of the entries getTunnelErrors returns
+const (
+	defaultErrorLimit = 20
+	maxErrorLimit     = 100
+)
+
+// GetTunnelErrorsParams defines parameters for getTunnelErrors
+type GetTunnelErrorsParams struct {
+	TunnelID string `json:"tunnel_id" jsonschema:"required,The ID of the tunnel"`
+	Limit    *int   `json:"limit,omitempty" jsonschema:"Maximum number of entries, newest last (default 20, max 100)"`
+}
+
+// TunnelErrorEntry is one warning or error logged for a tunnel
+type TunnelErrorEntry struct {
+	Time    string `json:"time"`
+	Level   string `json:"level"`
+	Message string `json:"message"`
+}
+
+// TunnelErrorsResponse represents the response for getTunnelErrors
+type TunnelErrorsResponse struct {
+	Name    string             `json:"name"`
+	Status  string             `json:"status"`
+	Error   string             `json:"error,omitempty"` // current error of the tunnel
+	Entries []TunnelErrorEntry `json:"entries"`
+}
+
+// getTunnelErrors returns the recent warnings and errors logged for a tunnel,
+// so an agent can find out why it failed without reading the whole log
+func (s *Server) getTunnelErrors(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	params *GetTunnelErrorsParams,
+) (*mcp.CallToolResult, any, error) {
+	if params.TunnelID == "" {
+		return nil, nil, fmt.Errorf("tunnel_id is required")
+	}
+
+	tunnelCfg, err := s.cfgMgr.GetTunnel(params.TunnelID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("tunnel not found: %w", err)
+	}
+	if !tunnelCfg.MCPEnabled {
+		logger.Sugar.Warnf("MCP: Tunnel %s (%s) is not MCP-enabled", tunnelCfg.Name, params.TunnelID)
+		return nil, nil, fmt.Errorf("tunnel is not MCP-enabled")
+	}
+
+	limit := defaultErrorLimit
+	if params.Limit != nil {
+		limit = min(max(*params.Limit, 1), maxErrorLimit)
+	}
+
+	response := TunnelErrorsResponse{
+		Name:    tunnelCfg.Name,
+		Entries: tunnelErrorEntries(params.TunnelID, limit),
+	}
+	if status, err := s.svcMgr.GetStatus(params.TunnelID); err == nil {
+		response.Status = status.Status
+		response.Error = status.Error
+	}
+
+	textResponse := fmt.Sprintf("Tunnel '%s' is %s.\n", response.Name, response.Status)
+	if response.Error != "" {
+		textResponse += fmt.Sprintf("Current error: %s\n", response.Error)
+	}
+	if len(response.Entries) == 0 {
+		textResponse += "\nNo recent warnings or errors were logged for this tunnel."
+	} else {
+		textResponse += fmt.Sprintf("\nRecent warnings and errors (%d):\n", len(response.Entries))
+		for _, e := range response.Entries {
+			textResponse += fmt.Sprintf("%s %s %s\n", e.Time, e.Level, e.Message)
+		}
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: textResponse},
+		},
+	}, response, nil
+}
+
+// tunnelErrorEntries returns the last limit warn and error entries tagged
+// with the tunnel among the recent logs
+func tunnelErrorEntries(id string, limit int) []TunnelErrorEntry {
+	entries := make([]TunnelErrorEntry, 0)
+	for _, entry := range logger.GetRecentLogs() {
+		if entry.Tunnel != id {
+			continue
+		}
+		// The message is the JSON line written to the log file
+		var line struct {
+			Level string `json:"level"`
+			Time  string `json:"time"`
+			Msg   string `json:"msg"`
+		}
+		if err := json.Unmarshal([]byte(entry.Message), &line); err != nil {
+			// A truncated message is no longer valid JSON; the entry keeps
+			// the level of the full line
+			line.Level = entry.Level
+			line.Time = entry.Timestamp.Format("2006-01-02T15:04:05.000Z0700")
+			line.Msg = truncatedMsg(entry.Message)
+		}
+		switch line.Level {
+		case "warn", "error", "dpanic", "panic", "fatal":
+			entries = append(entries, TunnelErrorEntry{Time: line.Time, Level: line.Level, Message: line.Msg})
+		}
+	}
+	if len(entries) > limit {
+		entries = entries[len(entries)-limit:]
+	}
+	return entries
+}
+
+// truncatedMsg is what is left of the msg field of a truncated JSON log line,
+// or the whole line when the field was cut off
+func truncatedMsg(raw string) string {
+	_, msg, found := strings.Cut(raw, `"msg":"`)
+	if !found {
+		return raw
+	}
+	return msg
+}
@@ -0,0 +1,107 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"pont/internal/logger"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// tunnelURIPrefix is the URI scheme under which tunnel configs are exposed
+const tunnelURIPrefix = "pont://tunnels/"
+
+// TunnelResource is the content of a tunnel resource. Secrets are left out.
+type TunnelResource struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Target      string `json:"target"`
+	Enabled     bool   `json:"enabled"`
+	NgrokDomain string `json:"ngrok_domain,omitempty"`
+	Status      string `json:"status"`
+	PublicURL   string `json:"public_url,omitempty"`
+	Error       string `json:"error,omitempty"`
+}
+
+// registerResources exposes each MCP-enabled tunnel as pont://tunnels/{id}
+func (s *Server) registerResources() {
+	s.server.AddResourceTemplate(&mcp.ResourceTemplate{
+		Name:        "tunnel",
+		Description: "Configuration and current status of an MCP-enabled tunnel",
+		URITemplate: tunnelURIPrefix + "{id}",
+		MIMEType:    "application/json",
+	}, s.readTunnel)
+
+	// Tunnels change at runtime, so the resource list is built per request
+	// instead of registering a static resource for each tunnel
+	s.server.AddReceivingMiddleware(func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			if method == "resources/list" {
+				return s.listTunnelResources()
+			}
+			return next(ctx, method, req)
+		}
+	})
+}
+
+// listTunnelResources enumerates the MCP-enabled tunnels as resources
+func (s *Server) listTunnelResources() (*mcp.ListResourcesResult, error) {
+	tunnels, err := s.cfgMgr.GetAllTunnels()
+	if err != nil {
+		logger.Sugar.Errorf("MCP: Failed to list tunnel resources: %v", err)
+		return nil, err
+	}
+
+	result := &mcp.ListResourcesResult{Resources: []*mcp.Resource{}}
+	for _, t := range tunnels {
+		if !t.MCPEnabled {
+			continue
+		}
+		result.Resources = append(result.Resources, &mcp.Resource{
+			URI:         tunnelURIPrefix + t.ID,
+			Name:        t.Name,
+			Description: string(t.Type) + " tunnel to " + t.Target,
+			MIMEType:    "application/json",
+		})
+	}
+	return result, nil
+}
+
+// readTunnel returns a tunnel resource
+func (s *Server) readTunnel(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+	uri := req.Params.URI
+	id := strings.TrimPrefix(uri, tunnelURIPrefix)
+
+	t, err := s.cfgMgr.GetTunnel(id)
+	if err != nil || !t.MCPEnabled || t.Archived {
+		return nil, mcp.ResourceNotFoundError(uri)
+	}
+
+	status, err := s.svcMgr.GetStatus(t.ID)
+	if err != nil {
+		return nil, mcp.ResourceNotFoundError(uri)
+	}
+	data, err := json.MarshalIndent(TunnelResource{
+		ID:          t.ID,
+		Name:        t.Name,
+		Type:        string(t.Type),
+		Target:      t.Target,
+		Enabled:     t.Enabled,
+		NgrokDomain: t.NgrokDomain,
+		Status:      status.Status,
+		PublicURL:   status.PublicURL,
+		Error:       status.Error,
+	}, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return &mcp.ReadResourceResult{
+		Contents: []*mcp.ResourceContents{
+			{URI: uri, MIMEType: "application/json", Text: string(data)},
+		},
+	}, nil
+}
@@ -6,6 +6,8 @@ import (
 	"pont/internal/config"
 	"pont/internal/logger"
 	"pont/internal/service"
+	"sort"
+	"time"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
@@ -15,8 +17,22 @@ type Server struct {
 	cfgMgr *config.Manager
 	svcMgr *service.Manager
 	server *mcp.Server
+	opts   Options
 }
 
+// Options configures the MCP server
+type Options struct {
+	// StartWait is how long startTunnel waits for the public URL by default
+	// before answering; 0 answers right away
+	StartWait time.Duration
+}
+
+// MaxStartWait bounds the wait a client may request from startTunnel
+const MaxStartWait = 60 * time.Second
+
+// startPollInterval is how often startTunnel checks for the public URL
+const startPollInterval = 250 * time.Millisecond
+
 // TunnelInfo represents tunnel information for MCP responses
 type TunnelInfo struct {
 	Index     int    `json:"index"`
@@ -26,6 +42,7 @@ type TunnelInfo struct {
 	Target    string `json:"target"`
 	Status    string `json:"status"`
 	PublicURL string `json:"public_url,omitempty"`
+	Favorite  bool   `json:"favorite"`
 }
 
 // TunnelListResponse represents the response for listing tunnels
@@ -43,10 +60,22 @@ type TunnelStartResponse struct {
 	Status    string `json:"status"`
 	PublicURL string `json:"public_url,omitempty"`
 	Message   string `json:"message"`
+
+	// URLPending is set when the tunnel did not report its URL within the wait
+	URLPending bool   `json:"url_pending"`
+	Error      string `json:"error,omitempty"`
 }
 
-// NewServer creates a new MCP server instance
-func NewServer(cfgMgr *config.Manager, svcMgr *service.Manager) *Server {
+// NewServer creates a new MCP server instance. The SDK panics on invalid
+// tool or resource definitions; that is returned as an error so the rest of
+// Pont can run without MCP.
+func NewServer(cfgMgr *config.Manager, svcMgr *service.Manager, opts Options) (s *Server, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			s, err = nil, fmt.Errorf("MCP initialization failed: %v", r)
+		}
+	}()
+
 	impl := &mcp.Implementation{
 		Name:    "pont-tunnel-manager",
 		Version: "1.0.0",
@@ -54,16 +83,18 @@ func NewServer(cfgMgr *config.Manager, svcMgr *service.Manager) *Server {
 
 	mcpServer := mcp.NewServer(impl, nil)
 
-	s := &Server{
+	s = &Server{
 		cfgMgr: cfgMgr,
 		svcMgr: svcMgr,
 		server: mcpServer,
+		opts:   opts,
 	}
 
-	// Register tools
+	// Register tools and resources
 	s.registerTools()
+	s.registerResources()
 
-	return s
+	return s, nil
 }
 
 // registerTools registers all MCP tools
@@ -71,14 +102,20 @@ func (s *Server) registerTools() {
 	// Tool 1: List available tunnels
 	mcp.AddTool(s.server, &mcp.Tool{
 		Name:        "listTunnels",
-		Description: "List all available tunnel configurations with their details",
+		Description: "List all available tunnel configurations with their details. Favorite tunnels are listed first and are usually the ones the user means",
 	}, s.listTunnels)
 
 	// Tool 2: Start a tunnel and get public URL
 	mcp.AddTool(s.server, &mcp.Tool{
 		Name:        "startTunnel",
-		Description: "Start a specific tunnel by ID and return the public URL for external access",
+		Description: "Start a specific tunnel by ID and return the public URL for external access. Waits briefly for the URL; if url_pending is true, check again with listTunnels shortly",
 	}, s.startTunnel)
+
+	// Tool 3: Recent warnings and errors of a tunnel
+	mcp.AddTool(s.server, &mcp.Tool{
+		Name:        "getTunnelErrors",
+		Description: "Get the recent warnings and errors logged for a tunnel by ID, with its current status and error. Use it to find out why a tunnel failed to start or stopped working",
+	}, s.getTunnelErrors)
 }
 
 // GetServer returns the underlying MCP server
@@ -107,25 +144,37 @@ func (s *Server) listTunnels(
 		Tunnels: make([]TunnelInfo, 0),
 	}
 
-	for i, t := range tunnels {
+	for _, t := range tunnels {
 		// Skip tunnels that are not MCP-enabled
 		if !t.MCPEnabled {
 			continue
 		}
 
-		status, _ := s.svcMgr.GetStatus(t.ID)
+		// Deleted since the list was read
+		status, err := s.svcMgr.GetStatus(t.ID)
+		if err != nil {
+			continue
+		}
 		tunnelInfo := TunnelInfo{
-			Index:     i + 1,
 			Name:      t.Name,
 			ID:        t.ID,
 			Type:      string(t.Type),
 			Target:    t.Target,
 			Status:    status.Status,
 			PublicURL: status.PublicURL,
+			Favorite:  t.Favorite,
 		}
 		response.Tunnels = append(response.Tunnels, tunnelInfo)
 	}
 
+	// Favorites first, otherwise keep the configured order
+	sort.SliceStable(response.Tunnels, func(i, j int) bool {
+		return response.Tunnels[i].Favorite && !response.Tunnels[j].Favorite
+	})
+	for i := range response.Tunnels {
+		response.Tunnels[i].Index = i + 1
+	}
+
 	response.Count = len(response.Tunnels)
 
 	// Format as readable text
@@ -135,7 +184,11 @@ func (s *Server) listTunnels(
 	} else {
 		textResponse = fmt.Sprintf("Found %d tunnel(s):\n\n", response.Count)
 		for _, t := range response.Tunnels {
-			textResponse += fmt.Sprintf("%d. %s (ID: %s)\n", t.Index, t.Name, t.ID)
+			marker := ""
+			if t.Favorite {
+				marker = " [favorite]"
+			}
+			textResponse += fmt.Sprintf("%d. %s%s (ID: %s)\n", t.Index, t.Name, marker, t.ID)
 			textResponse += fmt.Sprintf("   Type: %s\n", t.Type)
 			textResponse += fmt.Sprintf("   Target: %s\n", t.Target)
 			textResponse += fmt.Sprintf("   Status: %s\n", t.Status)
@@ -158,6 +211,9 @@ func (s *Server) listTunnels(
 // StartTunnelParams defines parameters for starting a tunnel
 type StartTunnelParams struct {
 	TunnelID string `json:"tunnel_id" jsonschema:"required,The ID of the tunnel to start"`
+
+	// WaitSeconds overrides Options.StartWait, up to MaxStartWait
+	WaitSeconds *int `json:"wait_seconds,omitempty" jsonschema:"Seconds to wait for the public URL before answering (0 answers immediately, max 60)"`
 }
 
 // startTunnel implements the tool to start a tunnel and return its public URL
@@ -190,7 +246,7 @@ func (s *Server) startTunnel(
 	}
 
 	// Start the tunnel
-	if err := s.svcMgr.Start(params.TunnelID); err != nil {
+	if err := s.svcMgr.StartContext(ctx, params.TunnelID); err != nil {
 		logger.Sugar.Errorf("MCP: Failed to start tunnel %s: %v", params.TunnelID, err)
 		return nil, TunnelStartResponse{
 			Success: false,
@@ -202,36 +258,54 @@ func (s *Server) startTunnel(
 	}
 
 	logger.Sugar.Infof("MCP: Started tunnel %s (%s)", tunnelCfg.Name, params.TunnelID)
+	s.cfgMgr.RecordAudit("mcp", "tunnel.start", params.TunnelID, "")
 
-	// Get the status with public URL
-	status, err := s.svcMgr.GetStatus(params.TunnelID)
+	// The public URL arrives asynchronously, wait a little so the agent
+	// usually gets it in this response
+	wait := s.opts.StartWait
+	if params.WaitSeconds != nil {
+		wait = time.Duration(*params.WaitSeconds) * time.Second
+	}
+	status, err := s.waitForURL(ctx, params.TunnelID, min(max(wait, 0), MaxStartWait))
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to get tunnel status: %w", err)
 	}
 
 	// Build structured response
 	response := TunnelStartResponse{
-		Success:   true,
-		Name:      tunnelCfg.Name,
-		Type:      string(tunnelCfg.Type),
-		Target:    tunnelCfg.Target,
-		Status:    status.Status,
-		PublicURL: status.PublicURL,
+		Success:    status.Status != "error",
+		Name:       tunnelCfg.Name,
+		Type:       string(tunnelCfg.Type),
+		Target:     tunnelCfg.Target,
+		Status:     status.Status,
+		PublicURL:  status.PublicURL,
+		URLPending: status.PublicURL == "" && status.InternalURL == "" && status.Status != "error",
+		Error:      status.Error,
+	}
+	if response.PublicURL == "" {
+		response.PublicURL = status.InternalURL
 	}
 
 	// Format as readable text
 	textResponse := fmt.Sprintf("Tunnel '%s' started successfully!\n\n", response.Name)
+	if !response.Success {
+		textResponse = fmt.Sprintf("Tunnel '%s' could not be started.\n\n", response.Name)
+	}
 	textResponse += fmt.Sprintf("Type: %s\n", response.Type)
 	textResponse += fmt.Sprintf("Target: %s\n", response.Target)
 	textResponse += fmt.Sprintf("Status: %s\n", response.Status)
 
-	if response.PublicURL != "" {
+	switch {
+	case response.Status == "error":
+		textResponse += fmt.Sprintf("\nThe tunnel failed to start: %s\n", response.Error)
+		response.Message = "Tunnel failed to start"
+	case response.PublicURL != "":
 		textResponse += fmt.Sprintf("\nPublic URL: %s\n", response.PublicURL)
 		textResponse += "\nYou can now access your local service through this public URL."
 		response.Message = "Tunnel started and public URL is available"
-	} else {
-		textResponse += "\nNote: Public URL will be available once the tunnel is fully established."
-		response.Message = "Tunnel started, waiting for public URL"
+	default:
+		textResponse += "\nNote: The public URL is still pending. Call listTunnels in a few seconds to get it."
+		response.Message = "Tunnel started, public URL still pending"
 	}
 
 	return &mcp.CallToolResult{
@@ -240,3 +314,26 @@ func (s *Server) startTunnel(
 		},
 	}, response, nil
 }
+
+// waitForURL polls a starting tunnel until it reports a URL or an error, the
+// wait elapses or the request is cancelled, and returns the last status
+func (s *Server) waitForURL(ctx context.Context, id string, wait time.Duration) (*service.TunnelState, error) {
+	deadline := time.Now().Add(wait)
+	for {
+		status, err := s.svcMgr.GetStatus(id)
+		if err != nil {
+			return nil, err
+		}
+		if status.PublicURL != "" || status.InternalURL != "" ||
+			(status.Status != "starting" && status.Status != "running") ||
+			!time.Now().Before(deadline) {
+			return status, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return status, nil
+		case <-time.After(startPollInterval):
+		}
+	}
+}
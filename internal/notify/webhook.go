@@ -0,0 +1,135 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Timeout bounds a single webhook delivery
+const Timeout = 10 * time.Second
+
+// maxResponseBody is how much of the receiver's response is kept for display
+const maxResponseBody = 1024
+
+// Event is the JSON payload posted to the webhook. Text and Content carry a
+// one-line summary, which is what Slack and Discord incoming webhooks display.
+type Event struct {
+	Event          string    `json:"event"`
+	TunnelID       string    `json:"tunnel_id"`
+	TunnelName     string    `json:"tunnel_name"`
+	Status         string    `json:"status"`
+	PreviousStatus string    `json:"previous_status,omitempty"`
+	PublicURL      string    `json:"public_url,omitempty"`
+	Error          string    `json:"error,omitempty"`
+	Test           bool      `json:"test,omitempty"`
+	Timestamp      time.Time `json:"timestamp"`
+	Text           string    `json:"text"`
+	Content        string    `json:"content"`
+}
+
+// Result describes the outcome of a delivery
+type Result struct {
+	Delivered  bool   `json:"delivered"`
+	StatusCode int    `json:"status_code,omitempty"`
+	Response   string `json:"response,omitempty"`
+	Error      string `json:"error,omitempty"`
+	DurationMS int64  `json:"duration_ms"`
+}
+
+// SampleEvent is a state change used to test a webhook
+func SampleEvent() Event {
+	ev := Event{
+		Event:          "tunnel.status_changed",
+		TunnelID:       "00000000-0000-0000-0000-000000000000",
+		TunnelName:     "example",
+		Status:         "running",
+		PreviousStatus: "starting",
+		PublicURL:      "https://example.trycloudflare.com",
+		Test:           true,
+		Timestamp:      time.Now().UTC(),
+	}
+	ev.Text = fmt.Sprintf("[Pont test] Tunnel %s is %s (was %s): %s", ev.TunnelName, ev.Status, ev.PreviousStatus, ev.PublicURL)
+	ev.Content = ev.Text
+	return ev
+}
+
+// StatusEvent is the notification for a tunnel whose status changed
+func StatusEvent(id, name, status, previous, publicURL, errMsg string) Event {
+	ev := Event{
+		Event:          "tunnel.status_changed",
+		TunnelID:       id,
+		TunnelName:     name,
+		Status:         status,
+		PreviousStatus: previous,
+		PublicURL:      publicURL,
+		Error:          errMsg,
+		Timestamp:      time.Now().UTC(),
+	}
+	if status == "error" {
+		ev.Text = fmt.Sprintf("[Pont] Tunnel %s failed (was %s)", name, previous)
+	} else {
+		ev.Text = fmt.Sprintf("[Pont] Tunnel %s is %s (was %s)", name, status, previous)
+	}
+	switch {
+	case errMsg != "":
+		ev.Text += ": " + errMsg
+	case status == "running" && publicURL != "":
+		ev.Text += ": " + publicURL
+	}
+	ev.Content = ev.Text
+	return ev
+}
+
+// Send posts ev to url. Only a 2xx response counts as delivered; timeouts,
+// connection failures and other status codes are reported in the result.
+func Send(ctx context.Context, url string, ev Event) Result {
+	start := time.Now()
+	res := deliver(ctx, url, ev)
+	res.DurationMS = time.Since(start).Milliseconds()
+	return res
+}
+
+func deliver(ctx context.Context, url string, ev Event) Result {
+	body, err := json.Marshal(ev)
+	if err != nil {
+		return Result{Error: err.Error()}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return Result{Error: fmt.Sprintf("invalid webhook URL: %v", err)}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Pont")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return Result{Error: fmt.Sprintf("webhook did not respond within %s", Timeout)}
+		}
+		return Result{Error: fmt.Sprintf("webhook request failed: %v", err)}
+	}
+	defer resp.Body.Close()
+
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBody))
+	res := Result{
+		StatusCode: resp.StatusCode,
+		Response:   strings.TrimSpace(string(respBody)),
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		res.Error = fmt.Sprintf("webhook responded with %s", resp.Status)
+		return res
+	}
+	res.Delivered = true
+	return res
+}
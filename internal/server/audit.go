@@ -0,0 +1,54 @@
+package server
+
+import (
+	"net/http"
+	"pont/internal/config"
+	"strconv"
+	"time"
+)
+
+// audit records a mutating operation performed through the REST API
+func (s *Server) audit(r *http.Request, action, tunnelID, details string) {
+	s.cfgMgr.RecordAudit(s.clientIP(r), action, tunnelID, details)
+}
+
+// handleAudit lists audit entries, filtered by actor, action, tunnel_id, since (RFC 3339) and limit
+func (s *Server) handleAudit(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	q := r.URL.Query()
+	filter := config.AuditFilter{
+		Actor:    q.Get("actor"),
+		Action:   q.Get("action"),
+		TunnelID: q.Get("tunnel_id"),
+		Limit:    100,
+	}
+
+	if v := q.Get("since"); v != "" {
+		since, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			http.Error(w, "since must be an RFC 3339 timestamp", http.StatusBadRequest)
+			return
+		}
+		filter.Since = since
+	}
+	if v := q.Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil || limit < 1 {
+			http.Error(w, "limit must be a positive integer", http.StatusBadRequest)
+			return
+		}
+		filter.Limit = limit
+	}
+
+	entries, err := s.cfgMgr.ListAudit(filter)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	s.jsonResponse(w, r, entries)
+}
@@ -0,0 +1,126 @@
+package server
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+)
+
+// authMiddleware requires AUTH_TOKEN on the API, metrics and MCP endpoints,
+// as a bearer token or, for EventSource clients that cannot set headers, as
+// ?token=. The web UI assets stay public. With AUTH_LOCALHOST_BYPASS set,
+// requests from a loopback address skip the check.
+func (s *Server) authMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if s.app.AuthToken == "" || !s.requiresAuth(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if s.app.AuthLocalhostBypass && isLoopbackRequest(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if !s.validToken(r) {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="pont"`)
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// requiresAuth reports whether a path is behind the auth token
+func (s *Server) requiresAuth(r *http.Request) bool {
+	p := r.URL.Path
+	for _, prefix := range []string{"/api/", "/mcp", "/metrics"} {
+		if p == s.basePath+strings.TrimSuffix(prefix, "/") || strings.HasPrefix(p, s.basePath+prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) validToken(r *http.Request) bool {
+	token := r.URL.Query().Get("token")
+	if h := r.Header.Get("Authorization"); h != "" {
+		scheme, value, ok := strings.Cut(h, " ")
+		if !ok || !strings.EqualFold(scheme, "Bearer") {
+			return false
+		}
+		token = strings.TrimSpace(value)
+	}
+	return token != "" && subtle.ConstantTimeCompare([]byte(token), []byte(s.app.AuthToken)) == 1
+}
+
+// mcpQueryToken carries the ?token= an MCP SSE stream was opened with into
+// the session endpoint announced on it. The SDK builds that endpoint from
+// ?sessionid= alone, so clients that cannot send an Authorization header
+// would otherwise be refused on every message they POST.
+func mcpQueryToken(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := r.URL.Query().Get("token")
+		if r.Method != http.MethodGet || token == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		next.ServeHTTP(&endpointTokenWriter{ResponseWriter: w, token: token}, r)
+	})
+}
+
+// endpointTokenWriter appends the token to the data of the endpoint event,
+// the first event of the stream, written in one Write. Unwrap keeps flushing
+// available to the SDK.
+type endpointTokenWriter struct {
+	http.ResponseWriter
+	token   string
+	started bool
+}
+
+func (w *endpointTokenWriter) Write(p []byte) (int, error) {
+	if w.started {
+		return w.ResponseWriter.Write(p)
+	}
+	w.started = true
+	event, rest, ok := bytes.Cut(p, []byte("\n\n"))
+	if !ok || !bytes.HasPrefix(event, []byte("event: endpoint\n")) {
+		return w.ResponseWriter.Write(p)
+	}
+	var b bytes.Buffer
+	b.Write(event)
+	b.WriteString("&token=" + url.QueryEscape(w.token) + "\n\n")
+	b.Write(rest)
+	if _, err := w.ResponseWriter.Write(b.Bytes()); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (w *endpointTokenWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// isLoopbackRequest reports whether the connection comes from 127.0.0.0/8 or
+// ::1. Only RemoteAddr counts, never client-supplied headers, and a request
+// carrying X-Forwarded-For was relayed by a proxy on this host on behalf of
+// someone else, so it does not count as local.
+func isLoopbackRequest(r *http.Request) bool {
+	if r.Header.Get("X-Forwarded-For") != "" {
+		return false
+	}
+	if isUnixRequest(r) {
+		return true
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return false
+	}
+	return addr.Unmap().IsLoopback()
+}
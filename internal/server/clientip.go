@@ -0,0 +1,73 @@
+package server
+
+import (
+	"net"
+	"net/http"
+	"pont/internal/config"
+	"pont/internal/logger"
+	"strings"
+)
+
+// loadTrustedProxies refreshes the trusted proxy list from settings
+func (s *Server) loadTrustedProxies() {
+	settings, err := s.cfgMgr.GetSettings()
+	if err != nil {
+		logger.Sugar.Warnf("Failed to load trusted proxies: %v", err)
+		return
+	}
+
+	nets, err := config.ParseTrustedProxies(settings.TrustedProxies)
+	if err != nil {
+		logger.Sugar.Warnf("Ignoring invalid trusted proxies: %v", err)
+		return
+	}
+
+	s.proxyMu.Lock()
+	s.trustedProxies = nets
+	s.proxyMu.Unlock()
+}
+
+// isTrustedProxy reports whether ip belongs to a configured trusted proxy
+func (s *Server) isTrustedProxy(ip net.IP) bool {
+	s.proxyMu.RLock()
+	defer s.proxyMu.RUnlock()
+
+	for _, n := range s.trustedProxies {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// clientIP returns the address of the client that made the request. X-Forwarded-For
+// is only honored when the direct peer is a trusted proxy, and is walked from the
+// right so that entries prepended by the client itself cannot spoof the result.
+func (s *Server) clientIP(r *http.Request) string {
+	if isUnixRequest(r) {
+		return "unix"
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil || !s.isTrustedProxy(ip) {
+		return host
+	}
+
+	forwarded := strings.Split(r.Header.Get("X-Forwarded-For"), ",")
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(forwarded[i])
+		hopIP := net.ParseIP(hop)
+		if hopIP == nil {
+			break
+		}
+		host = hop
+		if !s.isTrustedProxy(hopIP) {
+			break
+		}
+	}
+	return host
+}
@@ -0,0 +1,127 @@
+package server
+
+import (
+	"net/http"
+	"net/url"
+	"pont/internal/config"
+	"sort"
+	"strings"
+)
+
+// GroupSummary describes a tunnel group and the statuses of its tunnels
+type GroupSummary struct {
+	Name     string         `json:"name"`
+	Tunnels  int            `json:"tunnels"`
+	Statuses map[string]int `json:"statuses"`
+}
+
+// GroupActionResult is the outcome of a group action for one tunnel
+type GroupActionResult struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Error string `json:"error,omitempty"`
+}
+
+// handleGroups lists tunnel groups with aggregate status
+func (s *Server) handleGroups(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	tunnels, err := s.cfgMgr.GetAllTunnels()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	statuses := s.svcMgr.GetAllStatuses()
+
+	groups := make(map[string]*GroupSummary)
+	for _, t := range tunnels {
+		if t.Group == "" {
+			continue
+		}
+		g, ok := groups[t.Group]
+		if !ok {
+			g = &GroupSummary{Name: t.Group, Statuses: make(map[string]int)}
+			groups[t.Group] = g
+		}
+
+		status := "stopped"
+		if state, ok := statuses[t.ID]; ok {
+			status = state.Status
+		}
+		g.Tunnels++
+		g.Statuses[status]++
+	}
+
+	result := make([]GroupSummary, 0, len(groups))
+	for _, g := range groups {
+		result = append(result, *g)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+
+	s.jsonResponse(w, r, result)
+}
+
+// handleGroupAction handles POST /api/groups/{group}/start|stop
+func (s *Server) handleGroupAction(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	path := strings.TrimPrefix(r.URL.Path, s.basePath+"/api/groups/")
+	slash := strings.LastIndex(path, "/")
+	if slash <= 0 {
+		http.Error(w, "Not found", http.StatusNotFound)
+		return
+	}
+	group, err := url.PathUnescape(path[:slash])
+	if err != nil {
+		http.Error(w, "Invalid group name", http.StatusBadRequest)
+		return
+	}
+	action := path[slash+1:]
+	if action != "start" && action != "stop" {
+		http.Error(w, "Not found", http.StatusNotFound)
+		return
+	}
+
+	tunnels, err := s.cfgMgr.GetAllTunnels()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var members []config.TunnelConfig
+	for _, t := range tunnels {
+		if t.Group == group {
+			members = append(members, t)
+		}
+	}
+	if len(members) == 0 {
+		http.Error(w, "group not found", http.StatusNotFound)
+		return
+	}
+
+	results := make([]GroupActionResult, 0, len(members))
+	statuses := s.svcMgr.GetAllStatuses()
+	for _, t := range members {
+		res := GroupActionResult{ID: t.ID, Name: t.Name}
+		if action == "start" {
+			if err := s.svcMgr.StartContext(r.Context(), t.ID); err != nil {
+				res.Error = err.Error()
+			}
+		} else if _, known := statuses[t.ID]; known {
+			// Tunnels that were never started have nothing to stop
+			if err := s.svcMgr.StopContext(r.Context(), t.ID); err != nil {
+				res.Error = err.Error()
+			}
+		}
+		results = append(results, res)
+	}
+	s.audit(r, "group."+action, "", group)
+
+	s.jsonResponse(w, r, results)
+}
@@ -0,0 +1,19 @@
+package server
+
+import (
+	"net/http"
+	"pont/internal/i18n"
+
+	goi18n "github.com/nicksnyder/go-i18n/v2/i18n"
+)
+
+// localizer picks the response language: an explicit ?lang= wins if a locale
+// is loaded for it, then the Accept-Language header, then DEFAULT_LANG
+func (s *Server) localizer(r *http.Request) *goi18n.Localizer {
+	langs := make([]string, 0, 3)
+	if lang, ok := i18n.Supported(r.URL.Query().Get("lang")); ok {
+		langs = append(langs, lang)
+	}
+	langs = append(langs, r.Header.Get("Accept-Language"), i18n.DefaultLanguage())
+	return i18n.GetLocalizer(langs...)
+}
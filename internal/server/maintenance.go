@@ -0,0 +1,52 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"pont/internal/config"
+	"pont/internal/logger"
+	"time"
+)
+
+// PruneResponse reports what a manual prune removed
+type PruneResponse struct {
+	config.PruneResult
+	Cutoff time.Time `json:"cutoff"`
+}
+
+// handlePrune handles POST /api/maintenance/prune: it deletes audit entries
+// and URL history older than ?older_than=, or PRUNE_RETENTION without it
+func (s *Server) handlePrune(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	retention := s.app.PruneRetention
+	if v := r.URL.Query().Get("older_than"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			http.Error(w, fmt.Sprintf("invalid older_than %q, must be a positive duration such as 720h", v), http.StatusBadRequest)
+			return
+		}
+		retention = d
+	}
+	if retention <= 0 {
+		http.Error(w, "No retention configured, set PRUNE_RETENTION or pass ?older_than=", http.StatusBadRequest)
+		return
+	}
+
+	cutoff := time.Now().Add(-retention)
+	result, err := s.cfgMgr.Prune(r.Context(), cutoff)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	logger.Sugar.Infof("Pruned %d audit entries and %d URL history entries older than %s on request",
+		result.AuditLogs, result.URLHistory, retention)
+	s.audit(r, "maintenance.prune", "", fmt.Sprintf("older than %s: %d audit entries, %d URL history entries",
+		retention, result.AuditLogs, result.URLHistory))
+
+	s.jsonResponse(w, r, PruneResponse{PruneResult: result, Cutoff: cutoff})
+}
@@ -0,0 +1,135 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// mcpClientConfig builds the configuration snippet one MCP client expects
+type mcpClientConfig struct {
+	name   string
+	file   string // where the snippet goes
+	config func(endpoint string) map[string]interface{}
+}
+
+// mcpClients are the clients GET /api/mcp/config knows, keyed by ?client=
+var mcpClients = map[string]mcpClientConfig{
+	"generic": {
+		name: "Generic MCP client",
+		file: "the client's MCP server configuration",
+		config: func(endpoint string) map[string]interface{} {
+			return map[string]interface{}{
+				"mcpServers": map[string]interface{}{
+					"pont": map[string]interface{}{"url": endpoint},
+				},
+			}
+		},
+	},
+	// Claude Desktop only launches local servers, mcp-remote bridges to SSE
+	"claude": {
+		name: "Claude Desktop",
+		file: "claude_desktop_config.json (macOS: ~/Library/Application Support/Claude/, Windows: %APPDATA%\\Claude\\)",
+		config: func(endpoint string) map[string]interface{} {
+			return map[string]interface{}{
+				"mcpServers": map[string]interface{}{
+					"pont": map[string]interface{}{
+						"command": "npx",
+						"args":    []string{"-y", "mcp-remote", endpoint},
+					},
+				},
+			}
+		},
+	},
+	"cursor": {
+		name: "Cursor",
+		file: "~/.cursor/mcp.json, or .cursor/mcp.json in a project",
+		config: func(endpoint string) map[string]interface{} {
+			return map[string]interface{}{
+				"mcpServers": map[string]interface{}{
+					"pont": map[string]interface{}{"url": endpoint},
+				},
+			}
+		},
+	},
+	"cline": {
+		name: "Cline",
+		file: "cline_mcp_settings.json (MCP Servers > Configure in Cline)",
+		config: func(endpoint string) map[string]interface{} {
+			return map[string]interface{}{
+				"mcpServers": map[string]interface{}{
+					"pont": map[string]interface{}{
+						"url":           endpoint,
+						"transportType": "sse",
+						"disabled":      false,
+						"autoApprove":   []string{},
+					},
+				},
+			}
+		},
+	},
+	"vscode": {
+		name: "VS Code",
+		file: ".vscode/mcp.json in the workspace",
+		config: func(endpoint string) map[string]interface{} {
+			return map[string]interface{}{
+				"servers": map[string]interface{}{
+					"pont": map[string]interface{}{"type": "sse", "url": endpoint},
+				},
+			}
+		},
+	},
+	"windsurf": {
+		name: "Windsurf",
+		file: "~/.codeium/windsurf/mcp_config.json",
+		config: func(endpoint string) map[string]interface{} {
+			return map[string]interface{}{
+				"mcpServers": map[string]interface{}{
+					"pont": map[string]interface{}{"serverUrl": endpoint},
+				},
+			}
+		},
+	},
+}
+
+// handleMCPConfig returns a ready-to-paste MCP configuration for the client
+// named by ?client=, defaulting to a generic one
+func (s *Server) handleMCPConfig(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if s.mcpServer == nil {
+		s.handleMCPUnavailable(w, r)
+		return
+	}
+
+	key := strings.ToLower(r.URL.Query().Get("client"))
+	if key == "" {
+		key = "generic"
+	}
+	client, ok := mcpClients[key]
+	if !ok {
+		names := make([]string, 0, len(mcpClients))
+		for name := range mcpClients {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		http.Error(w, fmt.Sprintf("unknown client %q, supported: %s", key, strings.Join(names, ", ")), http.StatusBadRequest)
+		return
+	}
+
+	endpoint := s.mcpEndpoint(r)
+	resp := map[string]interface{}{
+		"client":   key,
+		"name":     client.name,
+		"file":     client.file,
+		"endpoint": endpoint,
+		"config":   client.config(endpoint),
+	}
+	if s.app.AuthToken != "" {
+		resp["note"] = "AUTH_TOKEN is set: append ?token=<token> to the URL or send an Authorization: Bearer header"
+	}
+	s.jsonResponse(w, r, resp)
+}
@@ -0,0 +1,132 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	mcpsdk "github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"pont/internal/config"
+	"pont/internal/mcp"
+)
+
+// The SSE handler shares one MCP server across connections; two clients
+// calling listTunnels at the same time must each get complete answers
+func TestMCPConcurrentClients(t *testing.T) {
+	s := newTestServer(t)
+
+	const tunnels = 5
+	for i := range tunnels {
+		err := s.cfgMgr.AddTunnel(&config.TunnelConfig{
+			Name:       fmt.Sprintf("tunnel-%d", i),
+			Type:       config.TunnelTypeCloudflare,
+			Target:     fmt.Sprintf("http://localhost:%d", 3000+i),
+			MCPEnabled: true,
+		})
+		if err != nil {
+			t.Fatalf("add tunnel: %v", err)
+		}
+	}
+	if s.mcpServer == nil {
+		t.Fatalf("MCP server unavailable: %v", s.mcpErr)
+	}
+	mux := http.NewServeMux()
+	s.registerMCP(mux)
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	const clients, calls = 2, 20
+	var wg sync.WaitGroup
+	errs := make(chan error, clients*calls)
+	for c := range clients {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			mcpClient := mcpsdk.NewClient(&mcpsdk.Implementation{Name: fmt.Sprintf("client-%d", c), Version: "1.0.0"}, nil)
+			session, err := mcpClient.Connect(ctx, &mcpsdk.SSEClientTransport{Endpoint: ts.URL + "/mcp"}, nil)
+			if err != nil {
+				errs <- fmt.Errorf("client %d: connect: %w", c, err)
+				return
+			}
+			defer session.Close()
+
+			for range calls {
+				res, err := session.CallTool(ctx, &mcpsdk.CallToolParams{Name: "listTunnels"})
+				if err != nil {
+					errs <- fmt.Errorf("client %d: listTunnels: %w", c, err)
+					return
+				}
+				if res.IsError {
+					errs <- fmt.Errorf("client %d: listTunnels returned an error result", c)
+					return
+				}
+				raw, err := json.Marshal(res.StructuredContent)
+				if err != nil {
+					errs <- fmt.Errorf("client %d: %w", c, err)
+					return
+				}
+				var list mcp.TunnelListResponse
+				if err := json.Unmarshal(raw, &list); err != nil {
+					errs <- fmt.Errorf("client %d: decode listTunnels: %w", c, err)
+					return
+				}
+				if list.Count != tunnels || len(list.Tunnels) != tunnels {
+					errs <- fmt.Errorf("client %d: listTunnels returned %d tunnels (count %d), want %d", c, len(list.Tunnels), list.Count, tunnels)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Error(err)
+	}
+}
+
+// A client that can only pass AUTH_TOKEN as ?token= must be able to post
+// to the session endpoint announced on the SSE stream, too
+func TestMCPQueryToken(t *testing.T) {
+	s := newTestServer(t)
+	s.app.AuthToken = "s3cret/+"
+	if s.mcpServer == nil {
+		t.Fatalf("MCP server unavailable: %v", s.mcpErr)
+	}
+	mux := http.NewServeMux()
+	s.registerMCP(mux)
+	ts := httptest.NewServer(s.authMiddleware(mux))
+	defer ts.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	mcpClient := mcpsdk.NewClient(&mcpsdk.Implementation{Name: "query-token", Version: "1.0.0"}, nil)
+
+	if session, err := mcpClient.Connect(ctx, &mcpsdk.SSEClientTransport{Endpoint: ts.URL + "/mcp"}, nil); err == nil {
+		session.Close()
+		t.Fatal("connected without the token")
+	}
+
+	endpoint := ts.URL + "/mcp?token=" + url.QueryEscape(s.app.AuthToken)
+	session, err := mcpClient.Connect(ctx, &mcpsdk.SSEClientTransport{Endpoint: endpoint}, nil)
+	if err != nil {
+		t.Fatalf("connect with ?token=: %v", err)
+	}
+	defer session.Close()
+	res, err := session.CallTool(ctx, &mcpsdk.CallToolParams{Name: "listTunnels"})
+	if err != nil {
+		t.Fatalf("listTunnels: %v", err)
+	}
+	if res.IsError {
+		t.Error("listTunnels returned an error result")
+	}
+}
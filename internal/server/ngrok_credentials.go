@@ -0,0 +1,59 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"pont/internal/config"
+	"strings"
+)
+
+// handleNgrokCredentials lists the stored ngrok credentials or saves one.
+// Saving an existing name replaces its authtoken.
+func (s *Server) handleNgrokCredentials(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		creds, err := s.cfgMgr.ListNgrokCredentials()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		s.jsonResponse(w, r, creds)
+	case http.MethodPost:
+		var cred config.NgrokCredential
+		if err := json.NewDecoder(r.Body).Decode(&cred); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if err := s.cfgMgr.SaveNgrokCredential(&cred); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		s.audit(r, "ngrok_credential.save", "", cred.Name)
+		s.jsonResponse(w, r, cred)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// handleNgrokCredentialByName deletes a credential that no tunnel uses
+func (s *Server) handleNgrokCredentialByName(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodDelete {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	name, err := url.PathUnescape(strings.TrimPrefix(r.URL.Path, s.basePath+"/api/ngrok/credentials/"))
+	if err != nil || name == "" {
+		http.Error(w, "Invalid credential name", http.StatusBadRequest)
+		return
+	}
+
+	if err := s.cfgMgr.DeleteNgrokCredential(name); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	s.audit(r, "ngrok_credential.delete", "", name)
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,36 @@
+package server
+
+import (
+	"net/http"
+	"pont/internal/logger"
+	"pont/internal/notify"
+)
+
+// handleNotificationTest posts a sample state-change notification to the
+// configured webhook. The outcome, including the receiver's status code, is
+// reported in the response body; only configuration problems are HTTP errors.
+func (s *Server) handleNotificationTest(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	settings, err := s.cfgMgr.GetSettings()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if settings.WebhookURL == "" {
+		http.Error(w, "No webhook configured, set webhook_url in the settings first", http.StatusBadRequest)
+		return
+	}
+
+	result := notify.Send(r.Context(), settings.WebhookURL, notify.SampleEvent())
+	details := "delivered"
+	if !result.Delivered {
+		logger.Sugar.Warnf("Test notification failed: %s", result.Error)
+		details = "failed: " + result.Error
+	}
+	s.audit(r, "notification.test", "", details)
+	s.jsonResponse(w, r, result)
+}
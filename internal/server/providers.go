@@ -0,0 +1,25 @@
+package server
+
+import (
+	"net/http"
+	"pont/internal/service"
+)
+
+// handleTunnelTypes lists the supported tunnel types and their fields
+func (s *Server) handleTunnelTypes(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	s.jsonResponse(w, r, service.Providers())
+}
+
+// handleProviders reports the embedded client version and the capabilities
+// of each tunnel provider
+func (s *Server) handleProviders(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	s.jsonResponse(w, r, service.ProviderDetails())
+}
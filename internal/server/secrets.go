@@ -0,0 +1,109 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"pont/internal/config"
+	"pont/internal/logger"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// revealableSecrets are the tunnel fields GET /api/tunnels/{id}/secret returns
+var revealableSecrets = map[string]func(*config.TunnelConfig) string{
+	"ngrok_authtoken":      func(t *config.TunnelConfig) string { return t.NgrokAuthtoken },
+	"ngrok_webhook_secret": func(t *config.TunnelConfig) string { return t.NgrokWebhookSecret },
+}
+
+// Secret reveals allowed per client within revealWindow
+const (
+	revealLimit  = 5
+	revealWindow = time.Minute
+)
+
+// revealLimiter counts secret reveals per client over a sliding window
+type revealLimiter struct {
+	mu      sync.Mutex
+	reveals map[string][]time.Time
+}
+
+// allow records a reveal by client, or returns how long until it may reveal again
+func (l *revealLimiter) allow(client string) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.reveals == nil {
+		l.reveals = make(map[string][]time.Time)
+	}
+	now := time.Now()
+	recent := l.reveals[client][:0]
+	for _, t := range l.reveals[client] {
+		if now.Sub(t) < revealWindow {
+			recent = append(recent, t)
+		}
+	}
+	if len(recent) >= revealLimit {
+		l.reveals[client] = recent
+		return false, revealWindow - now.Sub(recent[0])
+	}
+	l.reveals[client] = append(recent, now)
+	return true, 0
+}
+
+// SecretResponse is the body of a secret reveal
+type SecretResponse struct {
+	Field string `json:"field"`
+	Value string `json:"value"`
+}
+
+// revealSecret returns one secret field of a tunnel. It always needs the
+// auth token, even from loopback with AUTH_LOCALHOST_BYPASS, and every
+// reveal is audited.
+func (s *Server) revealSecret(w http.ResponseWriter, r *http.Request, id string) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if s.app.AuthToken == "" {
+		http.Error(w, "Revealing secrets requires AUTH_TOKEN to be set", http.StatusForbidden)
+		return
+	}
+	if !s.validToken(r) {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="pont"`)
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	field := r.URL.Query().Get("field")
+	get, ok := revealableSecrets[field]
+	if !ok {
+		http.Error(w, fmt.Sprintf("unsupported field %q, must be ngrok_authtoken or ngrok_webhook_secret", field), http.StatusBadRequest)
+		return
+	}
+
+	client := s.clientIP(r)
+	if ok, wait := s.reveals.allow(client); !ok {
+		logger.Sugar.Warnf("Secret reveal of %s for tunnel %s by %s rate limited", field, id, client)
+		w.Header().Set("Retry-After", strconv.Itoa(int(wait.Seconds())+1))
+		http.Error(w, "Too many secret reveals, try again later", http.StatusTooManyRequests)
+		return
+	}
+
+	tunnel, err := s.cfgMgr.GetTunnel(id)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	value := get(tunnel)
+	if value == "" {
+		http.Error(w, fmt.Sprintf("%s is not set", field), http.StatusNotFound)
+		return
+	}
+
+	logger.Sugar.Warnf("Secret %s of tunnel %s revealed to %s", field, tunnel.Name, client)
+	s.audit(r, "tunnel.secret_reveal", id, field)
+
+	w.Header().Set("Cache-Control", "no-store")
+	s.jsonResponse(w, r, SecretResponse{Field: field, Value: value})
+}
@@ -3,40 +3,85 @@ package server
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"io/fs"
+	"net"
 	"net/http"
 	"pont/internal/config"
 	"pont/internal/logger"
 	"pont/internal/mcp"
 	"pont/internal/service"
-	"pont/internal/web"
+	"pont/internal/tracing"
 	"pont/version"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
 	"time"
 
 	"github.com/google/uuid"
 	mcpsdk "github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
 // Server represents the HTTP server
 type Server struct {
 	addr       string
+	basePath   string
 	cfgMgr     *config.Manager
 	svcMgr     *service.Manager
 	mcpServer  *mcp.Server
+	mcpErr     error // why MCP is disabled, nil when mcpServer is set
 	httpServer *http.Server
+
+	mcpAddr       string // dedicated MCP listener, empty to serve MCP on addr
+	mcpHTTPServer *http.Server
+	mcpReady      chan struct{}
+
+	webFS       fs.FS
+	uiAvailable bool
+
+	app   *config.AppConfig
+	ready chan struct{}
+
+	// closing is closed by Shutdown to end long-lived log streams
+	closing   chan struct{}
+	closeOnce sync.Once
+
+	proxyMu        sync.RWMutex
+	trustedProxies []*net.IPNet
+
+	reveals revealLimiter
 }
 
-// NewServer creates a new HTTP server
-func NewServer(addr string, cfgMgr *config.Manager, svcMgr *service.Manager) *Server {
-	// Create MCP server
-	mcpServer := mcp.NewServer(cfgMgr, svcMgr)
+// NewServer creates a new HTTP server. app.BasePath mounts every route under a
+// path prefix (e.g. "/pont") for use behind a reverse proxy; empty means root.
+func NewServer(app *config.AppConfig, cfgMgr *config.Manager, svcMgr *service.Manager) *Server {
+	// Create MCP server; without it the REST API and tunnels still work
+	mcpServer, mcpErr := mcp.NewServer(cfgMgr, svcMgr, mcp.Options{StartWait: app.MCPStartWait})
+	if mcpErr != nil {
+		logger.Sugar.Warnf("MCP disabled: %v", mcpErr)
+	}
+
+	webFS, uiAvailable := loadWebAssets()
 
 	return &Server{
-		addr:      addr,
-		cfgMgr:    cfgMgr,
-		svcMgr:    svcMgr,
-		mcpServer: mcpServer,
+		addr:        app.Addr(),
+		basePath:    normalizeBasePath(app.BasePath),
+		app:         app,
+		cfgMgr:      cfgMgr,
+		svcMgr:      svcMgr,
+		mcpServer:   mcpServer,
+		mcpErr:      mcpErr,
+		webFS:       webFS,
+		uiAvailable: uiAvailable,
+		ready:       make(chan struct{}),
+		closing:     make(chan struct{}),
+		mcpAddr:     app.MCPAddr(),
+		mcpReady:    make(chan struct{}),
 	}
 }
 
@@ -44,44 +89,179 @@ func NewServer(addr string, cfgMgr *config.Manager, svcMgr *service.Manager) *Se
 func (s *Server) Start() error {
 	mux := http.NewServeMux()
 
+	s.loadTrustedProxies()
+
 	// API routes
-	mux.HandleFunc("/api/tunnels", s.handleTunnels)
-	mux.HandleFunc("/api/tunnels/", s.handleTunnelByID)
-	mux.HandleFunc("/api/status", s.handleStatus)
-	mux.HandleFunc("/api/settings", s.handleSettings)
-	mux.HandleFunc("/api/logs/stream", s.handleLogsStream)
-	mux.HandleFunc("/api/logs/recent", s.handleLogsRecent)
-	mux.HandleFunc("/api/version", s.handleVersion)
-	mux.HandleFunc("/api/mcp/info", s.handleMCPInfo)
-
-	// MCP endpoint (SSE)
-	mcpHandler := mcpsdk.NewSSEHandler(func(r *http.Request) *mcpsdk.Server {
-		return s.mcpServer.GetServer()
-	}, nil)
-	mux.Handle("/mcp", mcpHandler)
+	mux.HandleFunc(s.basePath+"/api/tunnels", s.handleTunnels)
+	mux.HandleFunc(s.basePath+"/api/tunnels/", s.handleTunnelByID)
+	mux.HandleFunc(s.basePath+"/api/tunnels/summary", s.handleTunnelSummary)
+	mux.HandleFunc(s.basePath+"/api/tunnels/autostart-plan", s.handleAutoStartPlan)
+	mux.HandleFunc(s.basePath+"/api/metrics/summary", s.handleMetricsSummary)
+	mux.HandleFunc(s.basePath+"/api/tunnel-types", s.handleTunnelTypes)
+	mux.HandleFunc(s.basePath+"/api/providers", s.handleProviders)
+	mux.HandleFunc(s.basePath+"/api/status", s.handleStatus)
+	mux.HandleFunc(s.basePath+"/api/groups", s.handleGroups)
+	mux.HandleFunc(s.basePath+"/api/groups/", s.handleGroupAction)
+	mux.HandleFunc(s.basePath+"/api/settings", s.handleSettings)
+	mux.HandleFunc(s.basePath+"/api/settings/schema", s.handleSettingsSchema)
+	mux.HandleFunc(s.basePath+"/api/notifications/test", s.handleNotificationTest)
+	mux.HandleFunc(s.basePath+"/api/ngrok/credentials", s.handleNgrokCredentials)
+	mux.HandleFunc(s.basePath+"/api/ngrok/credentials/", s.handleNgrokCredentialByName)
+	mux.HandleFunc(s.basePath+"/api/config/import/ngrok", s.handleImportNgrok)
+	mux.HandleFunc(s.basePath+"/api/config/effective", s.handleEffectiveConfig)
+	mux.HandleFunc(s.basePath+"/api/audit", s.handleAudit)
+	mux.HandleFunc(s.basePath+"/api/logs/stream", s.handleLogsStream)
+	mux.HandleFunc(s.basePath+"/api/logs/recent", s.handleLogsRecent)
+	mux.HandleFunc(s.basePath+"/api/logs/tail", s.handleLogsTail)
+	mux.HandleFunc(s.basePath+"/api/logs/rotate", s.handleLogsRotate)
+	mux.HandleFunc(s.basePath+"/api/maintenance/prune", s.handlePrune)
+	mux.HandleFunc(s.basePath+"/api/version", s.handleVersion)
+	mux.HandleFunc(s.basePath+"/api/diagnostics", s.handleDiagnostics)
+
+	// Prometheus metrics use a dedicated registry: cloudflared swaps
+	// prometheus.DefaultRegisterer for every tunnel it starts
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(service.NewMetricsCollector(s.svcMgr))
+	mux.Handle(s.basePath+"/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+
+	// MCP gets its own listener when MCP_PORT is set, see StartMCP
+	if s.mcpAddr == "" {
+		s.registerMCP(mux)
+	}
 
 	// Static files
-	distFS, _ := fs.Sub(web.DistFS, "dist")
-	mux.Handle("/", http.FileServer(http.FS(distFS)))
+	if s.uiAvailable {
+		mux.Handle(s.basePath+"/", http.StripPrefix(s.basePath, staticHandler(s.webFS)))
+	} else {
+		mux.HandleFunc(s.basePath+"/", s.handleUIMissing)
+	}
 
-	// Wrap with middleware
-	handler := s.loggingMiddleware(s.corsMiddleware(mux))
+	s.httpServer = s.newHTTPServer(s.addr, mux)
 
-	s.httpServer = &http.Server{
-		Addr:    s.addr,
-		Handler: handler,
+	// Bind before signalling readiness so callers only proceed once requests can be served
+	var listeners []net.Listener
+	if !s.app.UnixSocketOnly {
+		logger.Sugar.Infof("Starting HTTP server on %s", s.addr)
+		ln, err := net.Listen("tcp", s.addr)
+		if err != nil {
+			if errors.Is(err, syscall.EADDRINUSE) {
+				return fmt.Errorf("address %s is already in use, is another Pont instance running? Set PORT to use a different port", s.addr)
+			}
+			return err
+		}
+		listeners = append(listeners, ln)
+	}
+	if s.app.UnixSocket != "" {
+		logger.Sugar.Infof("Starting HTTP server on unix socket %s", s.app.UnixSocket)
+		ln, err := listenUnix(s.app.UnixSocket)
+		if err != nil {
+			for _, l := range listeners {
+				l.Close()
+			}
+			return err
+		}
+		listeners = append(listeners, ln)
 	}
+	close(s.ready)
 
-	logger.Sugar.Infof("Starting HTTP server on %s", s.addr)
-	return s.httpServer.ListenAndServe()
+	// Shutdown closes every listener, which also removes the socket file
+	serveErr := make(chan error, len(listeners))
+	for _, ln := range listeners {
+		go func() { serveErr <- s.httpServer.Serve(ln) }()
+	}
+	return <-serveErr
+}
+
+// registerMCP adds the MCP endpoint and its discovery routes to mux
+func (s *Server) registerMCP(mux *http.ServeMux) {
+	mux.HandleFunc(s.basePath+"/api/mcp/info", s.handleMCPInfo)
+	mux.HandleFunc(s.basePath+"/api/mcp/config", s.handleMCPConfig)
+
+	// SSE endpoint. Registered with the full prefix rather than behind
+	// StripPrefix so the session endpoint the SDK derives from the URL keeps it.
+	// One server is shared by all connections: the SDK keeps per-session state
+	// in the session it creates for each SSE connection, and the tool handlers
+	// only read from cfgMgr/svcMgr, which are safe for concurrent use.
+	if s.mcpServer != nil {
+		mcpHandler := mcpsdk.NewSSEHandler(func(r *http.Request) *mcpsdk.Server {
+			return s.mcpServer.GetServer()
+		}, nil)
+		mux.Handle(s.basePath+"/mcp", mcpQueryToken(mcpHandler))
+	} else {
+		mux.HandleFunc(s.basePath+"/mcp", s.handleMCPUnavailable)
+	}
+}
+
+// newHTTPServer wraps mux with the middleware chain and the configured timeouts
+func (s *Server) newHTTPServer(addr string, mux *http.ServeMux) *http.Server {
+	handler := tracing.Middleware(s.loggingMiddleware(s.corsMiddleware(s.authMiddleware(s.streamingMiddleware(mux)))))
+
+	// Serve HTTP/2 without TLS (h2c) next to HTTP/1.1
+	protocols := new(http.Protocols)
+	protocols.SetHTTP1(true)
+	protocols.SetUnencryptedHTTP2(true)
+
+	return &http.Server{
+		Addr:              addr,
+		Handler:           handler,
+		Protocols:         protocols,
+		ConnContext:       markUnixConn,
+		ReadHeaderTimeout: readHeaderTimeout,
+		ReadTimeout:       s.app.HTTPReadTimeout,
+		WriteTimeout:      s.app.HTTPWriteTimeout,
+		IdleTimeout:       s.app.HTTPIdleTimeout,
+	}
 }
 
-// Shutdown gracefully shuts down the server
+// StartMCP serves MCP on the dedicated MCP_PORT listener. It returns
+// immediately when MCP shares the main server.
+func (s *Server) StartMCP() error {
+	if s.mcpAddr == "" {
+		close(s.mcpReady)
+		return nil
+	}
+
+	mux := http.NewServeMux()
+	s.registerMCP(mux)
+	s.mcpHTTPServer = s.newHTTPServer(s.mcpAddr, mux)
+
+	logger.Sugar.Infof("Starting MCP server on %s", s.mcpAddr)
+
+	ln, err := net.Listen("tcp", s.mcpAddr)
+	if err != nil {
+		if errors.Is(err, syscall.EADDRINUSE) {
+			return fmt.Errorf("address %s is already in use, set MCP_PORT to use a different port", s.mcpAddr)
+		}
+		return err
+	}
+	close(s.mcpReady)
+
+	return s.mcpHTTPServer.Serve(ln)
+}
+
+// MCPReady is closed once the MCP listener is bound, or right away when MCP
+// shares the main server
+func (s *Server) MCPReady() <-chan struct{} {
+	return s.mcpReady
+}
+
+// Ready is closed once the server is bound and accepting connections
+func (s *Server) Ready() <-chan struct{} {
+	return s.ready
+}
+
+// Shutdown gracefully shuts down the server and the MCP listener
 func (s *Server) Shutdown(ctx context.Context) error {
+	s.closeOnce.Do(func() { close(s.closing) })
+
+	var errs []error
+	if s.mcpHTTPServer != nil {
+		errs = append(errs, s.mcpHTTPServer.Shutdown(ctx))
+	}
 	if s.httpServer != nil {
-		return s.httpServer.Shutdown(ctx)
+		errs = append(errs, s.httpServer.Shutdown(ctx))
 	}
-	return nil
+	return errors.Join(errs...)
 }
 
 // Middleware
@@ -89,15 +269,47 @@ func (s *Server) loggingMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		start := time.Now()
 		next.ServeHTTP(w, r)
-		logger.Sugar.Infof("%s %s %v", r.Method, r.URL.Path, time.Since(start))
+		logger.Sugar.With("category", logger.CategoryAccess).Infof("%s %s %v client=%s ua=%q", r.Method, r.URL.Path, time.Since(start), s.clientIP(r), r.UserAgent())
 	})
 }
 
+// readHeaderTimeout bounds how long a client may take to send request
+// headers, so slow clients cannot hold connections open
+const readHeaderTimeout = 10 * time.Second
+
+// streamingMiddleware lifts the read and write deadlines for long-lived
+// streaming responses, which would otherwise be cut off by the server timeouts
+func (s *Server) streamingMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if s.isStreamingRequest(r) {
+			rc := http.NewResponseController(w)
+			if err := rc.SetReadDeadline(time.Time{}); err != nil {
+				logger.Sugar.Debugf("Failed to clear read deadline for %s: %v", r.URL.Path, err)
+			}
+			if err := rc.SetWriteDeadline(time.Time{}); err != nil {
+				logger.Sugar.Debugf("Failed to clear write deadline for %s: %v", r.URL.Path, err)
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// isStreamingRequest reports whether a request opens a server-sent event stream
+func (s *Server) isStreamingRequest(r *http.Request) bool {
+	switch r.URL.Path {
+	case s.basePath + "/api/logs/stream":
+		return true
+	case s.basePath + "/mcp":
+		return r.Method == http.MethodGet
+	}
+	return strings.HasPrefix(r.URL.Path, s.basePath+"/api/tunnels/") && strings.HasSuffix(r.URL.Path, "/logs/stream")
+}
+
 func (s *Server) corsMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Access-Control-Allow-Origin", "*")
 		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
 
 		if r.Method == "OPTIONS" {
 			w.WriteHeader(http.StatusOK)
@@ -121,7 +333,7 @@ func (s *Server) handleTunnels(w http.ResponseWriter, r *http.Request) {
 }
 
 func (s *Server) handleTunnelByID(w http.ResponseWriter, r *http.Request) {
-	id := r.URL.Path[len("/api/tunnels/"):]
+	id := strings.TrimPrefix(r.URL.Path, s.basePath+"/api/tunnels/")
 	if id == "" {
 		http.Error(w, "Tunnel ID required", http.StatusBadRequest)
 		return
@@ -140,6 +352,30 @@ func (s *Server) handleTunnelByID(w http.ResponseWriter, r *http.Request) {
 		s.getTunnelStatus(w, r, id[:len(id)-7])
 		return
 	}
+	if len(id) > 8 && id[len(id)-8:] == "/restore" {
+		s.restoreTunnel(w, r, id[:len(id)-8])
+		return
+	}
+	if len(id) > 6 && id[len(id)-6:] == "/pause" {
+		s.pauseTunnel(w, r, id[:len(id)-6])
+		return
+	}
+	if len(id) > 7 && id[len(id)-7:] == "/resume" {
+		s.resumeTunnel(w, r, id[:len(id)-7])
+		return
+	}
+	if len(id) > 12 && id[len(id)-12:] == "/url-history" {
+		s.getURLHistory(w, r, id[:len(id)-12])
+		return
+	}
+	if len(id) > 7 && id[len(id)-7:] == "/secret" {
+		s.revealSecret(w, r, id[:len(id)-7])
+		return
+	}
+	if len(id) > 12 && id[len(id)-12:] == "/logs/stream" {
+		s.streamTunnelLogs(w, r, id[:len(id)-12])
+		return
+	}
 
 	switch r.Method {
 	case http.MethodGet:
@@ -154,13 +390,49 @@ func (s *Server) handleTunnelByID(w http.ResponseWriter, r *http.Request) {
 }
 
 func (s *Server) getTunnels(w http.ResponseWriter, r *http.Request) {
-	tunnels, err := s.cfgMgr.GetAllTunnels()
+	includeArchived := r.URL.Query().Get("archived") == "true"
+	tunnels, err := s.cfgMgr.ListTunnels(includeArchived)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
 
-	s.jsonResponse(w, tunnels)
+	// Status is runtime state, so the filter is applied against the service manager
+	if v := r.URL.Query().Get("status"); v != "" {
+		wanted := make(map[string]bool)
+		for _, status := range strings.Split(v, ",") {
+			status = strings.TrimSpace(status)
+			if !tunnelStatuses[status] {
+				http.Error(w, fmt.Sprintf("invalid status %q", status), http.StatusBadRequest)
+				return
+			}
+			wanted[status] = true
+		}
+
+		statuses := s.svcMgr.GetAllStatuses()
+		filtered := make([]config.TunnelConfig, 0, len(tunnels))
+		for _, t := range tunnels {
+			status := "stopped"
+			if state, ok := statuses[t.ID]; ok {
+				status = state.Status
+			}
+			if wanted[status] {
+				filtered = append(filtered, t)
+			}
+		}
+		tunnels = filtered
+	}
+
+	s.configResponse(w, r, config.RedactTunnels(tunnels))
+}
+
+// tunnelStatuses are the runtime statuses a tunnel can report
+var tunnelStatuses = map[string]bool{
+	"stopped":  true,
+	"starting": true,
+	"running":  true,
+	"paused":   true,
+	"error":    true,
 }
 
 func (s *Server) getTunnel(w http.ResponseWriter, r *http.Request, id string) {
@@ -170,7 +442,7 @@ func (s *Server) getTunnel(w http.ResponseWriter, r *http.Request, id string) {
 		return
 	}
 
-	s.jsonResponse(w, tunnel)
+	s.configResponse(w, r, tunnel.Redacted())
 }
 
 func (s *Server) createTunnel(w http.ResponseWriter, r *http.Request) {
@@ -184,13 +456,50 @@ func (s *Server) createTunnel(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
+	s.audit(r, "tunnel.create", tunnel.ID, "name: "+tunnel.Name)
 
-	s.jsonResponse(w, tunnel)
+	s.jsonResponse(w, r, s.tunnelResponse(tunnel))
+}
+
+// TunnelResponse is a saved tunnel with non-fatal warnings about it
+type TunnelResponse struct {
+	config.TunnelConfig
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// tunnelResponse adds warnings to a tunnel that was just saved
+func (s *Server) tunnelResponse(tunnel config.TunnelConfig) TunnelResponse {
+	resp := TunnelResponse{TunnelConfig: tunnel.Redacted()}
+	names, err := s.cfgMgr.TunnelsWithSameTarget(&tunnel)
+	if err != nil {
+		logger.Sugar.Warnf("Failed to check for tunnels with the same target: %v", err)
+	} else if len(names) > 0 {
+		resp.Warnings = append(resp.Warnings, fmt.Sprintf("a tunnel already exists for target %s: %s", tunnel.Target, strings.Join(names, ", ")))
+	}
+	return resp
 }
 
 func (s *Server) updateTunnel(w http.ResponseWriter, r *http.Request, id string) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
 	var tunnel config.TunnelConfig
-	if err := json.NewDecoder(r.Body).Decode(&tunnel); err != nil {
+	if err := json.Unmarshal(body, &tunnel); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	old, err := s.cfgMgr.GetTunnel(id)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	// Responses redact secrets, so an update that omits them or sends the
+	// placeholder back keeps the stored values
+	if err := config.KeepSecrets(old, &tunnel, body); err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
@@ -199,31 +508,93 @@ func (s *Server) updateTunnel(w http.ResponseWriter, r *http.Request, id string)
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
+	changed := config.ChangedFields(old, &tunnel)
+	s.audit(r, "tunnel.update", id, "changed: "+strings.Join(changed, ", "))
 
-	s.jsonResponse(w, tunnel)
+	// A running tunnel keeps its old connection settings until restarted
+	if fields := config.ConnectionFields(changed); len(fields) > 0 {
+		if settings, err := s.cfgMgr.GetSettings(); err == nil && settings.RestartOnUpdate {
+			restarted, err := s.svcMgr.Restart(r.Context(), id)
+			if err != nil {
+				logger.Sugar.Warnf("Failed to restart tunnel %s after update of %s: %v", id, strings.Join(fields, ", "), err)
+			} else if restarted {
+				s.audit(r, "tunnel.restart", id, "applied: "+strings.Join(fields, ", "))
+			}
+		}
+	}
+
+	tunnel.ID = id
+	s.jsonResponse(w, r, s.tunnelResponse(tunnel))
 }
 
+// deleteTunnel archives a tunnel by default; ?hard=true deletes it permanently
 func (s *Server) deleteTunnel(w http.ResponseWriter, r *http.Request, id string) {
-	if err := s.cfgMgr.DeleteTunnel(id); err != nil {
-		http.Error(w, err.Error(), http.StatusNotFound)
+	var err error
+	action := "tunnel.archive"
+	if r.URL.Query().Get("hard") == "true" {
+		action = "tunnel.delete"
+		err = s.cfgMgr.DeleteTunnel(id)
+	} else {
+		err = s.cfgMgr.ArchiveTunnel(id)
+	}
+	if err != nil {
+		code := http.StatusInternalServerError
+		if errors.Is(err, config.ErrTunnelNotFound) {
+			code = http.StatusNotFound
+		}
+		http.Error(w, err.Error(), code)
 		return
 	}
 
+	// A tunnel that is going away should not keep forwarding traffic. It is
+	// stopped only now: a failed delete leaves it running, and an archived
+	// or deleted tunnel cannot be started again in between.
+	s.svcMgr.Stop(id)
+	s.audit(r, action, id, "")
+
 	w.WriteHeader(http.StatusNoContent)
 }
 
+func (s *Server) restoreTunnel(w http.ResponseWriter, r *http.Request, id string) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if err := s.cfgMgr.RestoreTunnel(id); err != nil {
+		code := http.StatusNotFound
+		if errors.Is(err, config.ErrRestoreConflict) {
+			code = http.StatusConflict
+		}
+		http.Error(w, err.Error(), code)
+		return
+	}
+	s.audit(r, "tunnel.restore", id, "")
+
+	tunnel, err := s.cfgMgr.GetTunnel(id)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	s.jsonResponse(w, r, tunnel.Redacted())
+}
+
 func (s *Server) startTunnel(w http.ResponseWriter, r *http.Request, id string) {
 	if r.Method != http.MethodPost {
 		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 		return
 	}
 
-	if err := s.svcMgr.Start(id); err != nil {
+	// ?fresh=true drops the restart count and other state of earlier runs
+	opts := service.StartOptions{Fresh: r.URL.Query().Get("fresh") == "true"}
+	if err := s.svcMgr.StartWithOptions(r.Context(), id, opts); err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
+	s.audit(r, "tunnel.start", id, "")
 
-	s.jsonResponse(w, map[string]string{"status": "started"})
+	s.tunnelActionResponse(w, r, id, "started")
 }
 
 func (s *Server) stopTunnel(w http.ResponseWriter, r *http.Request, id string) {
@@ -232,27 +603,97 @@ func (s *Server) stopTunnel(w http.ResponseWriter, r *http.Request, id string) {
 		return
 	}
 
-	if err := s.svcMgr.Stop(id); err != nil {
+	if err := s.svcMgr.StopContext(r.Context(), id); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	s.audit(r, "tunnel.stop", id, "")
+
+	s.tunnelActionResponse(w, r, id, "stopped")
+}
+
+// TunnelActionResponse answers start and stop. Status is the outcome of the
+// action as returned before the runtime state was included; Tunnel is the
+// state right after it, usually still "starting" for a start.
+type TunnelActionResponse struct {
+	Status string               `json:"status"`
+	Tunnel *service.TunnelState `json:"tunnel"`
+}
+
+func (s *Server) tunnelActionResponse(w http.ResponseWriter, r *http.Request, id, status string) {
+	state, err := s.svcMgr.GetStatus(id)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	s.jsonResponse(w, r, TunnelActionResponse{Status: status, Tunnel: state})
+}
+
+func (s *Server) pauseTunnel(w http.ResponseWriter, r *http.Request, id string) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if err := s.svcMgr.Pause(id); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	s.audit(r, "tunnel.pause", id, "")
+
+	s.jsonResponse(w, r, map[string]string{"status": "paused"})
+}
+
+func (s *Server) resumeTunnel(w http.ResponseWriter, r *http.Request, id string) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if err := s.svcMgr.Resume(id); err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
+	s.audit(r, "tunnel.resume", id, "")
+
+	s.jsonResponse(w, r, map[string]string{"status": "started"})
+}
+
+func (s *Server) getURLHistory(w http.ResponseWriter, r *http.Request, id string) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if _, err := s.cfgMgr.GetTunnel(id); err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
 
-	s.jsonResponse(w, map[string]string{"status": "stopped"})
+	history, err := s.cfgMgr.URLHistory(id)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	s.jsonResponse(w, r, history)
 }
 
 func (s *Server) getTunnelStatus(w http.ResponseWriter, r *http.Request, id string) {
 	status, err := s.svcMgr.GetStatus(id)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusNotFound)
+		code := http.StatusInternalServerError
+		if errors.Is(err, config.ErrTunnelNotFound) {
+			code = http.StatusNotFound
+		}
+		http.Error(w, err.Error(), code)
 		return
 	}
 
-	s.jsonResponse(w, status)
+	s.jsonResponse(w, r, status)
 }
 
 func (s *Server) handleStatus(w http.ResponseWriter, r *http.Request) {
 	statuses := s.svcMgr.GetAllStatuses()
-	s.jsonResponse(w, statuses)
+	s.jsonResponse(w, r, statuses)
 }
 
 func (s *Server) handleSettings(w http.ResponseWriter, r *http.Request) {
@@ -263,28 +704,158 @@ func (s *Server) handleSettings(w http.ResponseWriter, r *http.Request) {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
 		}
-		s.jsonResponse(w, settings)
+		s.jsonResponse(w, r, settings)
 
 	case http.MethodPut:
-		var settings config.Settings
-		if err := json.NewDecoder(r.Body).Decode(&settings); err != nil {
+		// Settings left out of the body keep their current values
+		var patch json.RawMessage
+		if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
 			http.Error(w, err.Error(), http.StatusBadRequest)
 			return
 		}
 
-		if err := s.cfgMgr.UpdateSettings(&settings); err != nil {
+		settings, err := s.cfgMgr.PatchSettings(patch)
+		if err != nil {
 			http.Error(w, err.Error(), http.StatusBadRequest)
 			return
 		}
+		s.audit(r, "settings.update", "", "")
+		s.loadTrustedProxies()
 
-		s.jsonResponse(w, settings)
+		s.jsonResponse(w, r, settings)
 
 	default:
 		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 	}
 }
 
+func (s *Server) handleSettingsSchema(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	s.jsonResponse(w, r, config.SettingsSchema())
+}
+
+// ImportReportItem is what an import would do with one tunnel of the file
+type ImportReportItem struct {
+	Name   string `json:"name"`
+	Target string `json:"target"`
+	Action string `json:"action"` // "create" or "skip"
+	Error  string `json:"error,omitempty"`
+}
+
+// handleImportNgrok creates ngrok tunnels from an uploaded ngrok.yml, sent either
+// as the raw request body or as the "file" field of a multipart form. With
+// ?dryRun=true it only reports what the import would do.
+func (s *Server) handleImportNgrok(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, 1<<20)
+
+	var data []byte
+	var err error
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+		file, _, ferr := r.FormFile("file")
+		if ferr != nil {
+			http.Error(w, ferr.Error(), http.StatusBadRequest)
+			return
+		}
+		defer file.Close()
+		data, err = io.ReadAll(file)
+	} else {
+		data, err = io.ReadAll(r.Body)
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	parsed, err := config.ParseNgrokConfig(data)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if r.URL.Query().Get("dryRun") == "true" {
+		s.jsonResponse(w, r, map[string]interface{}{
+			"dry_run":  true,
+			"items":    s.importReport(parsed.Tunnels),
+			"warnings": append([]string{}, parsed.Warnings...),
+		})
+		return
+	}
+
+	created := make([]config.TunnelConfig, 0, len(parsed.Tunnels))
+	warnings := append([]string{}, parsed.Warnings...)
+	for i := range parsed.Tunnels {
+		tunnel := parsed.Tunnels[i]
+		if err := s.cfgMgr.AddTunnel(&tunnel); err != nil {
+			warnings = append(warnings, fmt.Sprintf("tunnel %q not imported: %v", tunnel.Name, err))
+			continue
+		}
+		created = append(created, tunnel)
+		s.audit(r, "tunnel.create", tunnel.ID, "imported from ngrok config, name: "+tunnel.Name)
+	}
+
+	logger.Sugar.Infof("Imported %d tunnel(s) from ngrok config (%d warning(s))", len(created), len(warnings))
+
+	s.jsonResponse(w, r, map[string]interface{}{
+		"created":  config.RedactTunnels(created),
+		"warnings": warnings,
+	})
+}
+
+// importReport validates tunnels the way AddTunnel would, treating those
+// earlier in the list as already created
+func (s *Server) importReport(tunnels []config.TunnelConfig) []ImportReportItem {
+	items := make([]ImportReportItem, 0, len(tunnels))
+	seen := make(map[string]bool)
+	for i := range tunnels {
+		tunnel := tunnels[i]
+		item := ImportReportItem{Name: tunnel.Name, Target: tunnel.Target, Action: "create"}
+		if err := s.cfgMgr.CheckNewTunnel(&tunnel); err != nil {
+			item.Error = err.Error()
+		} else if seen[tunnel.Name] {
+			item.Error = fmt.Sprintf("tunnel name %q is used more than once in the file", tunnel.Name)
+		}
+		if item.Error != "" {
+			item.Action = "skip"
+		} else {
+			seen[tunnel.Name] = true
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
 func (s *Server) handleLogsStream(w http.ResponseWriter, r *http.Request) {
+	s.streamLogs(w, r, nil)
+}
+
+// streamTunnelLogs streams the log entries tagged with one tunnel
+func (s *Server) streamTunnelLogs(w http.ResponseWriter, r *http.Request, id string) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if _, err := s.cfgMgr.GetTunnel(id); err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	s.streamLogs(w, r, func(entry logger.LogEntry) bool {
+		return entry.Tunnel == id
+	})
+}
+
+// streamLogs sends log entries as server-sent events until the client goes
+// away. filter, if not nil, selects the entries the subscriber receives.
+func (s *Server) streamLogs(w http.ResponseWriter, r *http.Request, filter func(logger.LogEntry) bool) {
 	w.Header().Set("Content-Type", "text/event-stream")
 	w.Header().Set("Cache-Control", "no-cache")
 	w.Header().Set("Connection", "keep-alive")
@@ -297,9 +868,35 @@ func (s *Server) handleLogsStream(w http.ResponseWriter, r *http.Request) {
 
 	// Subscribe to logs
 	subID := uuid.New().String()
-	sub := logger.Subscribe(subID)
+	sub := logger.SubscribeFiltered(subID, filter)
 	defer logger.Unsubscribe(subID)
 
+	match := logCategoryFilter(r)
+	if filter != nil {
+		category := match
+		match = func(entry logger.LogEntry) bool {
+			return filter(entry) && category(entry)
+		}
+	}
+	send := func(entry logger.LogEntry) {
+		data, _ := json.Marshal(entry)
+		fmt.Fprintf(w, "id: %d\ndata: %s\n\n", entry.ID, data)
+	}
+
+	// A reconnecting EventSource sends the ID of the last entry it got.
+	// Replay what it missed from the recent logs; subscribing first means
+	// nothing logged meanwhile is lost, and sent tracks what to skip.
+	var sent uint64
+	if lastID, err := strconv.ParseUint(r.Header.Get("Last-Event-ID"), 10, 64); err == nil {
+		for _, entry := range logger.RecentLogsSince(lastID) {
+			if match(entry) {
+				send(entry)
+			}
+			sent = entry.ID
+		}
+		flusher.Flush()
+	}
+
 	// Send logs
 	for {
 		select {
@@ -307,31 +904,152 @@ func (s *Server) handleLogsStream(w http.ResponseWriter, r *http.Request) {
 			if !ok {
 				return
 			}
+			if entry.ID <= sent || !match(entry) {
+				continue
+			}
 
-			data, _ := json.Marshal(entry)
-			fmt.Fprintf(w, "data: %s\n\n", data)
+			send(entry)
 			flusher.Flush()
 
 		case <-r.Context().Done():
 			return
+		case <-s.closing:
+			return
 		}
 	}
 }
 
 func (s *Server) handleLogsRecent(w http.ResponseWriter, r *http.Request) {
-	logs := logger.GetRecentLogs()
-	s.jsonResponse(w, logs)
+	match := logCategoryFilter(r)
+	logs := make([]logger.LogEntry, 0)
+	for _, entry := range logger.GetRecentLogs() {
+		if match(entry) {
+			logs = append(logs, entry)
+		}
+	}
+	s.jsonResponse(w, r, logs)
+}
+
+// logCategoryFilter builds a log entry filter from ?category= (keep only
+// these categories) and ?exclude_category= (drop these), both comma-separated
+func logCategoryFilter(r *http.Request) func(logger.LogEntry) bool {
+	split := func(v string) map[string]bool {
+		set := make(map[string]bool)
+		for _, c := range strings.Split(v, ",") {
+			if c = strings.TrimSpace(c); c != "" {
+				set[c] = true
+			}
+		}
+		return set
+	}
+	include := split(r.URL.Query().Get("category"))
+	exclude := split(r.URL.Query().Get("exclude_category"))
+
+	return func(entry logger.LogEntry) bool {
+		if len(include) > 0 && !include[entry.Category] {
+			return false
+		}
+		return !exclude[entry.Category]
+	}
+}
+
+func (s *Server) handleLogsTail(w http.ResponseWriter, r *http.Request) {
+	n := 100
+	if v := r.URL.Query().Get("n"); v != "" {
+		parsed, err := strconv.Atoi(v)
+		if err != nil || parsed < 1 {
+			http.Error(w, "n must be a positive integer", http.StatusBadRequest)
+			return
+		}
+		n = parsed
+	}
+
+	logs, err := logger.TailFile(n)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	s.jsonResponse(w, r, logs)
+}
+
+func (s *Server) handleLogsRotate(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	newFile, oldFile, err := logger.Rotate()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	logger.Sugar.Infof("Log file rotated on request, previous file: %s", oldFile)
+	s.audit(r, "logs.rotate", "", "previous file: "+oldFile)
+	s.jsonResponse(w, r, map[string]string{"new_file": newFile, "old_file": oldFile})
 }
 
 func (s *Server) handleVersion(w http.ResponseWriter, r *http.Request) {
-	s.jsonResponse(w, map[string]string{
+	s.jsonResponse(w, r, map[string]string{
 		"version":    version.GetVersion(),
 		"build_time": version.GetBuildTime(),
 		"git_commit": version.GetGitCommit(),
 	})
 }
 
-func (s *Server) handleMCPInfo(w http.ResponseWriter, r *http.Request) {
+// handleEffectiveConfig returns the configuration this instance is running
+// with: resolved environment variables and the stored settings
+func (s *Server) handleEffectiveConfig(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	settings, err := s.cfgMgr.GetSettings()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	s.jsonResponse(w, r, map[string]interface{}{
+		"environment": s.app.Effective(),
+		"settings":    settings,
+	})
+}
+
+func (s *Server) handleDiagnostics(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	logFile := logger.GetFileHealth()
+	status := "ok"
+	if !logFile.Healthy {
+		status = "degraded"
+	}
+
+	mcpHealth := map[string]interface{}{"enabled": s.mcpServer != nil}
+	if s.mcpErr != nil {
+		mcpHealth["error"] = s.mcpErr.Error()
+		status = "degraded"
+	}
+
+	s.jsonResponse(w, r, map[string]interface{}{
+		"status":   status,
+		"log_file": logFile,
+		"mcp":      mcpHealth,
+	})
+}
+
+// handleMCPUnavailable answers MCP requests when MCP failed to initialize
+func (s *Server) handleMCPUnavailable(w http.ResponseWriter, r *http.Request) {
+	http.Error(w, "MCP is unavailable: "+s.mcpErr.Error(), http.StatusServiceUnavailable)
+}
+
+// mcpEndpoint is the MCP URL as seen by the client making the request
+func (s *Server) mcpEndpoint(r *http.Request) string {
 	// Use the actual request host to construct the endpoint URL
 	// This ensures the endpoint reflects how the client is accessing the server
 	host := r.Host
@@ -349,8 +1067,19 @@ func (s *Server) handleMCPInfo(w http.ResponseWriter, r *http.Request) {
 		scheme = "https"
 	}
 
+	return fmt.Sprintf("%s://%s%s/mcp", scheme, host, s.basePath)
+}
+
+func (s *Server) handleMCPInfo(w http.ResponseWriter, r *http.Request) {
+	if s.mcpServer == nil {
+		s.handleMCPUnavailable(w, r)
+		return
+	}
+
+	endpoint := s.mcpEndpoint(r)
+
 	mcpInfo := map[string]interface{}{
-		"endpoint": fmt.Sprintf("%s://%s/mcp", scheme, host),
+		"endpoint": endpoint,
 		"status":   "active",
 		"tools": []map[string]string{
 			{
@@ -366,16 +1095,29 @@ func (s *Server) handleMCPInfo(w http.ResponseWriter, r *http.Request) {
 		"config_example": map[string]interface{}{
 			"mcpServers": map[string]interface{}{
 				"pont": map[string]interface{}{
-					"url": fmt.Sprintf("%s://%s/mcp", scheme, host),
+					"url": endpoint,
 				},
 			},
 		},
 	}
 
-	s.jsonResponse(w, mcpInfo)
+	s.jsonResponse(w, r, mcpInfo)
 }
 
-func (s *Server) jsonResponse(w http.ResponseWriter, data interface{}) {
+// normalizeBasePath turns "pont/" or "/pont/" into "/pont", and "/" into ""
+func normalizeBasePath(p string) string {
+	p = strings.Trim(p, "/")
+	if p == "" {
+		return ""
+	}
+	return "/" + p
+}
+
+func (s *Server) jsonResponse(w http.ResponseWriter, r *http.Request, data interface{}) {
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(data)
+	enc := json.NewEncoder(w)
+	if s.app.PrettyJSON || r.URL.Query().Get("pretty") == "true" {
+		enc.SetIndent("", "  ")
+	}
+	enc.Encode(data)
 }
@@ -0,0 +1,118 @@
+package server
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"pont/internal/config"
+	"pont/internal/db"
+	"pont/internal/logger"
+	"pont/internal/service"
+)
+
+// newTestServer returns a server backed by a fresh database, without
+// listening; tests call its handlers directly
+func newTestServer(t *testing.T) *Server {
+	t.Helper()
+	logger.Sugar = zap.NewNop().Sugar()
+
+	client, err := db.Init(t.TempDir(), db.Options{AutoMigrate: true})
+	if err != nil {
+		t.Fatalf("init database: %v", err)
+	}
+	t.Cleanup(func() { client.Close() })
+
+	cfgMgr := config.NewManager(client, "")
+	svcMgr := service.NewManager(cfgMgr, service.Options{})
+	return NewServer(&config.AppConfig{}, cfgMgr, svcMgr)
+}
+
+func TestGetTunnelStatus(t *testing.T) {
+	s := newTestServer(t)
+	tunnel := config.TunnelConfig{Name: "web", Type: config.TunnelTypeCloudflare, Target: "http://localhost:3000"}
+	if err := s.cfgMgr.AddTunnel(&tunnel); err != nil {
+		t.Fatalf("add tunnel: %v", err)
+	}
+
+	status := func(id string) int {
+		rec := httptest.NewRecorder()
+		s.handleTunnelByID(rec, httptest.NewRequest(http.MethodGet, "/api/tunnels/"+id+"/status", nil))
+		return rec.Code
+	}
+
+	if code := status(tunnel.ID); code != http.StatusOK {
+		t.Errorf("status of a stopped tunnel: got %d, want 200", code)
+	}
+	if code := status(uuid.NewString()); code != http.StatusNotFound {
+		t.Errorf("status of an unknown tunnel: got %d, want 404", code)
+	}
+
+	if err := s.cfgMgr.ArchiveTunnel(tunnel.ID); err != nil {
+		t.Fatalf("archive: %v", err)
+	}
+	if code := status(tunnel.ID); code != http.StatusNotFound {
+		t.Errorf("status of an archived tunnel: got %d, want 404", code)
+	}
+}
+
+func TestDeleteTunnel(t *testing.T) {
+	s := newTestServer(t)
+	tunnel := config.TunnelConfig{Name: "web", Type: config.TunnelTypeCloudflare, Target: "http://localhost:3000"}
+	if err := s.cfgMgr.AddTunnel(&tunnel); err != nil {
+		t.Fatalf("add tunnel: %v", err)
+	}
+
+	remove := func(id, query string) int {
+		rec := httptest.NewRecorder()
+		s.handleTunnelByID(rec, httptest.NewRequest(http.MethodDelete, "/api/tunnels/"+id+query, nil))
+		return rec.Code
+	}
+
+	for _, id := range []string{uuid.NewString(), "not-a-uuid"} {
+		if code := remove(id, ""); code != http.StatusNotFound {
+			t.Errorf("delete of unknown tunnel %s: got %d, want 404", id, code)
+		}
+	}
+
+	if code := remove(tunnel.ID, ""); code != http.StatusNoContent {
+		t.Fatalf("archive: got %d, want 204", code)
+	}
+	if err := s.svcMgr.Start(tunnel.ID); err == nil {
+		t.Error("archived tunnel could be started")
+	}
+	if code := remove(tunnel.ID, "?hard=true"); code != http.StatusNoContent {
+		t.Fatalf("hard delete: got %d, want 204", code)
+	}
+	if _, err := s.cfgMgr.GetTunnel(tunnel.ID); !errors.Is(err, config.ErrTunnelNotFound) {
+		t.Errorf("tunnel after hard delete: %v, want not found", err)
+	}
+}
+
+// Sending a test notification reaches out to the webhook, so it is audited
+// whether or not the webhook accepts it
+func TestNotificationTestAudited(t *testing.T) {
+	s := newTestServer(t)
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer webhook.Close()
+	if err := s.cfgMgr.UpdateSettings(&config.Settings{AuditLog: true, WebhookURL: webhook.URL}); err != nil {
+		t.Fatalf("update settings: %v", err)
+	}
+
+	rec := httptest.NewRecorder()
+	s.handleNotificationTest(rec, httptest.NewRequest(http.MethodPost, "/api/notifications/test", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("notification test = %d: %s", rec.Code, rec.Body)
+	}
+	entries, err := s.cfgMgr.ListAudit(config.AuditFilter{Action: "notification.test"})
+	if err != nil {
+		t.Fatalf("list audit: %v", err)
+	}
+	if len(entries) != 1 || entries[0].Details != "delivered" {
+		t.Errorf("audit entries = %+v, want one delivered notification.test", entries)
+	}
+}
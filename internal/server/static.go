@@ -0,0 +1,57 @@
+package server
+
+import (
+	"io/fs"
+	"mime"
+	"net/http"
+	"pont/internal/i18n"
+	"pont/internal/logger"
+	"pont/internal/web"
+)
+
+// staticMIMETypes overrides the system MIME table for frontend assets. Some
+// platforms map .js to text/plain, which browsers refuse for ES modules.
+var staticMIMETypes = map[string]string{
+	".js":   "text/javascript; charset=utf-8",
+	".mjs":  "text/javascript; charset=utf-8",
+	".css":  "text/css; charset=utf-8",
+	".wasm": "application/wasm",
+}
+
+func init() {
+	for ext, typ := range staticMIMETypes {
+		if err := mime.AddExtensionType(ext, typ); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// staticHandler serves the frontend assets without content-type sniffing
+func staticHandler(webFS fs.FS) http.Handler {
+	files := http.FileServer(http.FS(webFS))
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		files.ServeHTTP(w, r)
+	})
+}
+
+// loadWebAssets returns the embedded frontend and whether it looks usable.
+// A missing or empty dist directory means the binary was built without the UI.
+func loadWebAssets() (fs.FS, bool) {
+	distFS, err := fs.Sub(web.DistFS, "dist")
+	if err != nil {
+		logger.Sugar.Warnf("Web UI unavailable: embedded assets have no dist directory: %v", err)
+		return nil, false
+	}
+	if _, err := fs.Stat(distFS, "index.html"); err != nil {
+		logger.Sugar.Warnf("Web UI unavailable: dist/index.html is missing from the embedded assets, check the frontend build")
+		return nil, false
+	}
+	return distFS, true
+}
+
+// handleUIMissing answers UI requests when the binary has no frontend assets
+func (s *Server) handleUIMissing(w http.ResponseWriter, r *http.Request) {
+	msg := i18n.T(s.localizer(r), "server.ui_missing", map[string]interface{}{"Path": s.basePath + "/api/"})
+	http.Error(w, msg, http.StatusServiceUnavailable)
+}
@@ -0,0 +1,167 @@
+package server
+
+import (
+	"net/http"
+	"pont/internal/config"
+	"pont/internal/service"
+	"time"
+)
+
+// TunnelSummary counts tunnels by type and by runtime status
+type TunnelSummary struct {
+	Total    int                       `json:"total"`
+	ByType   map[config.TunnelType]int `json:"by_type"`
+	ByStatus map[string]int            `json:"by_status"`
+}
+
+// handleTunnelSummary handles GET /api/tunnels/summary
+func (s *Server) handleTunnelSummary(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	counts, err := s.cfgMgr.CountTunnelsByType()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	summary := TunnelSummary{
+		ByType: map[config.TunnelType]int{
+			config.TunnelTypeCloudflare: 0,
+			config.TunnelTypeNgrok:      0,
+		},
+		ByStatus: make(map[string]int, len(tunnelStatuses)),
+	}
+	for typ, n := range counts {
+		summary.ByType[typ] = n
+		summary.Total += n
+	}
+	for status := range tunnelStatuses {
+		summary.ByStatus[status] = 0
+	}
+
+	// Only tunnels that were started have a runtime state; every other
+	// tunnel is stopped
+	active := 0
+	for _, state := range s.svcMgr.GetAllStatuses() {
+		if state.Status == "stopped" {
+			continue
+		}
+		summary.ByStatus[state.Status]++
+		active++
+	}
+	summary.ByStatus["stopped"] = max(summary.Total-active, 0)
+
+	s.jsonResponse(w, r, summary)
+}
+
+// MetricsSummary aggregates runtime stats for the dashboard overview
+type MetricsSummary struct {
+	TotalTunnels  int `json:"total_tunnels"`
+	Running       int `json:"running"`
+	TotalRestarts int `json:"total_restarts"`
+
+	// BytesTransferred is null: neither provider reports traffic to Pont
+	BytesTransferred *int64         `json:"bytes_transferred"`
+	Tunnels          []TunnelUptime `json:"tunnels"`
+}
+
+// TunnelUptime is the runtime of one tunnel that has been started
+type TunnelUptime struct {
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	Status        string `json:"status"`
+	UptimeSeconds int64  `json:"uptime_seconds"` // 0 unless running
+	RestartCount  int    `json:"restart_count"`
+
+	Concurrency *service.Concurrency `json:"concurrency,omitempty"`
+}
+
+// handleMetricsSummary handles GET /api/metrics/summary. It reads the
+// (cached) status snapshot and the tunnel list, nothing else.
+func (s *Server) handleMetricsSummary(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	tunnels, err := s.cfgMgr.GetAllTunnels()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	statuses := s.svcMgr.GetAllStatuses()
+
+	summary := MetricsSummary{
+		TotalTunnels: len(tunnels),
+		Tunnels:      make([]TunnelUptime, 0, len(statuses)),
+	}
+	now := time.Now()
+	for _, t := range tunnels {
+		state, ok := statuses[t.ID]
+		if !ok {
+			continue
+		}
+		entry := TunnelUptime{
+			ID:           t.ID,
+			Name:         t.Name,
+			Status:       state.Status,
+			RestartCount: state.RestartCount,
+			Concurrency:  state.Concurrency,
+		}
+		if state.Status == "running" {
+			summary.Running++
+			entry.UptimeSeconds = int64(now.Sub(state.StartedAt).Seconds())
+		}
+		summary.TotalRestarts += state.RestartCount
+		summary.Tunnels = append(summary.Tunnels, entry)
+	}
+
+	s.jsonResponse(w, r, summary)
+}
+
+// AutoStartPlanEntry is a tunnel the auto-start routine would launch
+type AutoStartPlanEntry struct {
+	Order  int               `json:"order"`
+	ID     string            `json:"id"`
+	Name   string            `json:"name"`
+	Type   config.TunnelType `json:"type"`
+	Target string            `json:"target"`
+}
+
+// handleAutoStartPlan handles GET /api/tunnels/autostart-plan
+func (s *Server) handleAutoStartPlan(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	settings, err := s.cfgMgr.GetSettings()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	plan, err := s.svcMgr.AutoStartPlan()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	entries := make([]AutoStartPlanEntry, len(plan))
+	for i, t := range plan {
+		entries[i] = AutoStartPlanEntry{
+			Order:  i + 1,
+			ID:     t.ID,
+			Name:   t.Name,
+			Type:   t.Type,
+			Target: t.Target,
+		}
+	}
+
+	s.jsonResponse(w, r, map[string]interface{}{
+		"auto_start": settings.AutoStart,
+		"tunnels":    entries,
+	})
+}
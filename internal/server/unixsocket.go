@@ -0,0 +1,65 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"syscall"
+	"time"
+)
+
+// unixConnKey marks the context of connections accepted on UNIX_SOCKET
+type unixConnKey struct{}
+
+// markUnixConn is the http.Server ConnContext that tags connections accepted
+// on a Unix socket
+func markUnixConn(ctx context.Context, c net.Conn) context.Context {
+	if c.LocalAddr().Network() == "unix" {
+		return context.WithValue(ctx, unixConnKey{}, true)
+	}
+	return ctx
+}
+
+// isUnixRequest reports whether the request came in over the Unix socket.
+// Only local processes allowed by the socket's permissions can connect.
+func isUnixRequest(r *http.Request) bool {
+	unix, _ := r.Context().Value(unixConnKey{}).(bool)
+	return unix
+}
+
+// listenUnix listens on the socket at path, replacing a stale socket left
+// behind by an instance that did not shut down cleanly. Anything else at the
+// path, including a socket another process still serves, is left alone. The
+// socket is only accessible to the user Pont runs as and is removed when the
+// listener is closed.
+func listenUnix(path string) (net.Listener, error) {
+	if info, err := os.Lstat(path); err == nil {
+		if info.Mode()&os.ModeSocket == 0 {
+			return nil, fmt.Errorf("UNIX_SOCKET %s exists and is not a socket", path)
+		}
+		conn, err := net.DialTimeout("unix", path, time.Second)
+		if err == nil {
+			conn.Close()
+			return nil, fmt.Errorf("UNIX_SOCKET %s is in use, is another Pont instance running?", path)
+		}
+		if !errors.Is(err, syscall.ECONNREFUSED) {
+			return nil, fmt.Errorf("UNIX_SOCKET %s: %w", path, err)
+		}
+		if err := os.Remove(path); err != nil {
+			return nil, fmt.Errorf("remove stale socket %s: %w", path, err)
+		}
+	}
+
+	ln, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Chmod(path, 0600); err != nil {
+		ln.Close()
+		return nil, fmt.Errorf("restrict permissions of %s: %w", path, err)
+	}
+	return ln, nil
+}
@@ -0,0 +1,97 @@
+package server
+
+import (
+	"encoding/json"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// yamlMediaTypes are the Accept values that select a YAML response
+var yamlMediaTypes = map[string]bool{
+	"application/yaml":   true,
+	"application/x-yaml": true,
+	"text/yaml":          true,
+	"text/x-yaml":        true,
+}
+
+// wantsYAML reports whether the Accept header prefers YAML over JSON. JSON
+// wins ties, so clients sending */* or nothing keep getting JSON.
+func wantsYAML(r *http.Request) bool {
+	var yamlQ, jsonQ float64
+	for _, part := range strings.Split(r.Header.Get("Accept"), ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		q := 1.0
+		if v, ok := params["q"]; ok {
+			if q, err = strconv.ParseFloat(v, 64); err != nil {
+				continue
+			}
+		}
+		switch {
+		case yamlMediaTypes[mediaType]:
+			yamlQ = max(yamlQ, q)
+		case mediaType == "application/json":
+			jsonQ = max(jsonQ, q)
+		}
+	}
+	return yamlQ > 0 && yamlQ > jsonQ
+}
+
+// configResponse writes tunnel configurations as YAML when the client asks
+// for it with Accept: application/yaml, and as JSON otherwise
+func (s *Server) configResponse(w http.ResponseWriter, r *http.Request, data interface{}) {
+	w.Header().Add("Vary", "Accept")
+	if !wantsYAML(r) {
+		s.jsonResponse(w, r, data)
+		return
+	}
+
+	out, err := toYAML(data)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/yaml")
+	w.Write(out)
+}
+
+// toYAML encodes data as YAML with the same keys, order and omitted fields
+// as its JSON encoding, by going through JSON
+func toYAML(data interface{}) ([]byte, error) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	// JSON is valid YAML, so it decodes into a node tree in document order
+	var doc yaml.Node
+	if err := yaml.Unmarshal(raw, &doc); err != nil {
+		return nil, err
+	}
+	clearStyle(&doc)
+
+	var b strings.Builder
+	enc := yaml.NewEncoder(&b)
+	enc.SetIndent(2)
+	if err := enc.Encode(&doc); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return []byte(b.String()), nil
+}
+
+// clearStyle drops the flow and quoting styles taken over from JSON, so the
+// encoder writes block YAML and quotes strings only where needed
+func clearStyle(n *yaml.Node) {
+	n.Style = 0
+	for _, c := range n.Content {
+		clearStyle(c)
+	}
+}
@@ -1,16 +1,17 @@
 package service
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
-	"net/url"
 	"os"
 	"pont/internal/config"
 	"pont/internal/logger"
 	"regexp"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/cloudflare/cloudflared/cmd/cloudflared/cliutil"
 	"github.com/cloudflare/cloudflared/cmd/cloudflared/tunnel"
@@ -51,11 +52,32 @@ func (s *safeRegisterer) MustRegister(cs ...prometheus.Collector) {
 	}
 }
 
-var urlPattern = regexp.MustCompile(`https://[a-z0-9-]+\.trycloudflare\.com`)
+var urlPattern = regexp.MustCompile(`https://[a-z0-9-]+\.(trycloudflare|cfargotunnel)\.com`)
+
+// DefaultURLCaptureTimeout is how long a quick tunnel may run without a public URL
+const DefaultURLCaptureTimeout = 60 * time.Second
+
+// DefaultStopTimeout is how long Stop waits for cloudflared to exit
+const DefaultStopTimeout = 15 * time.Second
+
+// cloudflared reads process-wide state while a tunnel starts: the logger binds
+// to os.Stderr, metrics go to prometheus.DefaultRegisterer and the graceful
+// shutdown channel is a package variable. Starts are serialized so each tunnel
+// binds its own pipe, registry and channel; once connected, a tunnel keeps
+// using them and the next one may start.
+var (
+	cloudflaredStartMu  sync.Mutex
+	cloudflaredInitOnce sync.Once
+	cloudflaredBuild    *cliutil.BuildInfo
+)
+
+// startWindowTimeout bounds how long one tunnel may hold the start lock
+const startWindowTimeout = 60 * time.Second
 
 type urlCapture struct {
-	cs      *CloudflareService
-	wrapped io.Writer
+	cs          *CloudflareService
+	wrapped     io.Writer
+	onConnected func()
 }
 
 func (u *urlCapture) Write(p []byte) (n int, err error) {
@@ -63,16 +85,24 @@ func (u *urlCapture) Write(p []byte) (n int, err error) {
 		u.wrapped.Write(p)
 	}
 	n = len(p)
+	if u.onConnected != nil && bytes.Contains(p, []byte("Registered tunnel connection")) {
+		u.onConnected()
+	}
 	if u.cs.GetPublicURL() != "" {
 		return
 	}
 	if match := urlPattern.Find(p); match != nil {
+		var notify func(string)
 		u.cs.mu.Lock()
-		if u.cs.publicURL == "" {
+		if u.cs.publicURL == "" && u.cs.status == "starting" {
 			u.cs.publicURL = string(match)
 			u.cs.status = "running"
+			notify = u.cs.onPublicURL
 		}
 		u.cs.mu.Unlock()
+		if notify != nil {
+			go notify(string(match))
+		}
 	}
 	return
 }
@@ -85,30 +115,67 @@ type CloudflareService struct {
 	mu                sync.RWMutex
 	cancel            context.CancelFunc
 	wg                sync.WaitGroup
-	initOnce          sync.Once
 	metricsRegistry   *prometheus.Registry
 	gracefulShutdownC chan struct{}
+	urlTimeout        time.Duration
+	stopTimeout       time.Duration
+	onPublicURL       func(url string)
+
+	// failedAt is when the running tunnel failed, see FailedSince
+	failedAt time.Time
+
+	// requests counts the requests in flight through the error page
+	// proxy, which only runs with cloudflare_error_page
+	requests concurrencyGauge
+}
+
+// Concurrency returns the requests in flight to the target. Only tunnels
+// with an error page route requests through Pont, so it is nil otherwise.
+func (cs *CloudflareService) Concurrency() *Concurrency {
+	if cs.config.CloudflareErrorPage == "" {
+		return nil
+	}
+	return cs.requests.snapshot()
+}
+
+// OnPublicURL registers a callback for when the public URL is captured,
+// which happens after Start has returned
+func (cs *CloudflareService) OnPublicURL(fn func(url string)) {
+	cs.mu.Lock()
+	cs.onPublicURL = fn
+	cs.mu.Unlock()
 }
 
-func NewCloudflareService(cfg *config.TunnelConfig) *CloudflareService {
+// NewCloudflareService creates a cloudflare quick tunnel service. The tunnel is
+// failed if no public URL is captured within urlTimeout, and Stop gives up
+// waiting for cloudflared after stopTimeout (0 means the default for both).
+func NewCloudflareService(cfg *config.TunnelConfig, urlTimeout, stopTimeout time.Duration) *CloudflareService {
+	if urlTimeout <= 0 {
+		urlTimeout = DefaultURLCaptureTimeout
+	}
+	if stopTimeout <= 0 {
+		stopTimeout = DefaultStopTimeout
+	}
 	return &CloudflareService{
 		config:            cfg,
 		status:            "stopped",
 		gracefulShutdownC: make(chan struct{}, 1),
+		urlTimeout:        urlTimeout,
+		stopTimeout:       stopTimeout,
 	}
 }
 
-func (cs *CloudflareService) initTunnel() {
-	cs.initOnce.Do(func() {
+// initCloudflared performs the process-wide cloudflared setup once
+func initCloudflared() {
+	cloudflaredInitOnce.Do(func() {
 		defer func() {
 			if rec := recover(); rec != nil {
 				logger.Sugar.Errorf("Panic during tunnel initialization: %v", rec)
 			}
 		}()
 
-		buildInfo := cliutil.GetBuildInfo("pont", "1.0.0")
-		updater.Init(buildInfo)
-		tunnel.Init(buildInfo, cs.gracefulShutdownC)
+		cloudflaredBuild = cliutil.GetBuildInfo("pont", "1.0.0")
+		updater.Init(cloudflaredBuild)
 		logger.Sugar.Info("Cloudflared tunnel initialized")
 	})
 }
@@ -116,7 +183,7 @@ func (cs *CloudflareService) initTunnel() {
 func (cs *CloudflareService) Start(ctx context.Context) error {
 	defer func() {
 		if rec := recover(); rec != nil {
-			logger.Sugar.Errorf("Panic during tunnel start: %v", rec)
+			logger.ForTunnel(cs.config.ID).Errorf("Panic during tunnel start: %v", rec)
 		}
 	}()
 
@@ -127,15 +194,35 @@ func (cs *CloudflareService) Start(ctx context.Context) error {
 		return fmt.Errorf("tunnel already running")
 	}
 
-	targetURL, err := url.Parse(cs.config.Target)
+	if err := config.CheckCloudflareTarget(cs.config.Target); err != nil {
+		return err
+	}
+	if err := config.CheckCloudflareOptions(cs.config); err != nil {
+		return err
+	}
+
+	targetURL, err := config.CloudflareTargetURL(cs.config.Target)
 	if err != nil {
-		return fmt.Errorf("invalid target URL: %w", err)
+		return err
 	}
 
-	cs.initTunnel()
+	initCloudflared()
 
+	// With an error page cloudflared forwards to Pont's proxy, which knows
+	// when the target is unreachable
+	origin := targetURL.String()
+	var errorPage *errorPageProxy
+	if cs.config.CloudflareErrorPage != "" {
+		errorPage, err = startErrorPageProxy(cs.config, &cs.requests)
+		if err != nil {
+			return err
+		}
+		origin = errorPage.URL()
+	}
+
+	// Fresh per-run state: a signal may have closed the previous channel
 	cs.metricsRegistry = prometheus.NewRegistry()
-	prometheus.DefaultRegisterer = newSafeRegisterer(cs.metricsRegistry)
+	cs.gracefulShutdownC = make(chan struct{}, 1)
 
 	if cs.cancel != nil {
 		cs.cancel()
@@ -145,36 +232,85 @@ func (cs *CloudflareService) Start(ctx context.Context) error {
 	cs.cancel = cancel
 	cs.status = "starting"
 	cs.lastError = nil
+	cs.failedAt = time.Time{}
 
 	cs.wg.Add(1)
-	go cs.runTunnel(tunnelCtx, targetURL.String())
+	go cs.runTunnel(tunnelCtx, origin, errorPage, cs.metricsRegistry, cs.gracefulShutdownC)
 
 	return nil
 }
 
-func (cs *CloudflareService) runTunnel(ctx context.Context, targetURL string) {
+func (cs *CloudflareService) runTunnel(ctx context.Context, targetURL string, errorPage *errorPageProxy, registry *prometheus.Registry, gracefulShutdownC chan struct{}) {
 	defer cs.wg.Done()
+	if errorPage != nil {
+		defer errorPage.Close()
+	}
 	defer func() {
 		if rec := recover(); rec != nil {
-			logger.Sugar.Errorf("Panic in tunnel: %v", rec)
+			logger.ForTunnel(cs.config.ID).Errorf("Panic in tunnel: %v", rec)
 			cs.mu.Lock()
-			cs.lastError = fmt.Errorf("tunnel panic: %v", rec)
-			cs.status = "error"
+			cs.setErrorLocked(fmt.Errorf("tunnel panic: %v", rec))
 			cs.mu.Unlock()
 		}
 	}()
 
 	defer func() {
 		cs.mu.Lock()
-		cs.status = "stopped"
+		if cs.status != "error" {
+			cs.status = "stopped"
+		}
 		cs.publicURL = ""
 		cs.mu.Unlock()
 	}()
 
-	// Redirect stdout/stderr to capture URL
+	// Hold the process-wide cloudflared state until this tunnel is connected
+	cloudflaredStartMu.Lock()
 	oldStdout := os.Stdout
 	oldStderr := os.Stderr
-	r, w, _ := os.Pipe()
+	var releaseOnce sync.Once
+	release := func() {
+		releaseOnce.Do(func() {
+			os.Stdout = oldStdout
+			os.Stderr = oldStderr
+			cloudflaredStartMu.Unlock()
+		})
+	}
+	defer release()
+	releaseTimer := time.AfterFunc(startWindowTimeout, release)
+	defer releaseTimer.Stop()
+
+	tunnel.Init(cloudflaredBuild, gracefulShutdownC)
+	prometheus.DefaultRegisterer = newSafeRegisterer(registry)
+
+	// Fail the tunnel rather than leaving it running without a usable URL
+	urlTimer := time.AfterFunc(cs.urlTimeout, func() {
+		cs.mu.Lock()
+		if cs.publicURL != "" || cs.status != "starting" {
+			cs.mu.Unlock()
+			return
+		}
+		err := fmt.Errorf("no public URL captured within %s, cloudflared output may have changed format", cs.urlTimeout)
+		cs.setErrorLocked(err)
+		cancel := cs.cancel
+		cs.mu.Unlock()
+
+		logger.ForTunnel(cs.config.ID).Warnf("Tunnel %s: %v", cs.config.Name, err)
+		if cancel != nil {
+			cancel()
+		}
+	})
+	defer urlTimer.Stop()
+
+	// Redirect stdout/stderr to a pipe owned by this tunnel to capture the URL;
+	// cloudflared's logger keeps the pipe after the globals are restored
+	r, w, err := os.Pipe()
+	if err != nil {
+		logger.ForTunnel(cs.config.ID).Errorf("Failed to create output pipe: %v", err)
+		cs.mu.Lock()
+		cs.setErrorLocked(err)
+		cs.mu.Unlock()
+		return
+	}
 	os.Stdout = w
 	os.Stderr = w
 
@@ -182,13 +318,12 @@ func (cs *CloudflareService) runTunnel(ctx context.Context, targetURL string) {
 	done := make(chan struct{})
 	go func() {
 		defer close(done)
-		capture := &urlCapture{cs: cs, wrapped: oldStdout}
+		capture := &urlCapture{cs: cs, wrapped: oldStdout, onConnected: release}
 		io.Copy(capture, r)
 	}()
 
 	defer func() {
-		os.Stdout = oldStdout
-		os.Stderr = oldStderr
+		release()
 		w.Close()
 		<-done
 	}()
@@ -198,7 +333,7 @@ func (cs *CloudflareService) runTunnel(ctx context.Context, targetURL string) {
 		Commands: tunnel.Commands(),
 		ExitErrHandler: func(c *cli.Context, err error) {
 			if err != nil {
-				logger.Sugar.Errorf("CLI error: %v", err)
+				logger.ForTunnel(cs.config.ID).Errorf("CLI error: %v", err)
 			}
 		},
 	}
@@ -210,21 +345,25 @@ func (cs *CloudflareService) runTunnel(ctx context.Context, targetURL string) {
 	}
 
 	args := []string{"cloudflared", "tunnel", "--no-autoupdate", "--url", targetURL}
+	args = append(args, config.CloudflaredArgs(cs.config)...)
 
-	logger.Sugar.Infof("Starting cloudflared tunnel: %s", targetURL)
+	if errorPage != nil {
+		logger.ForTunnel(cs.config.ID).Infof("Starting cloudflared tunnel: %s (via error page proxy %s)", cs.config.Target, targetURL)
+	} else {
+		logger.ForTunnel(cs.config.ID).Infof("Starting cloudflared tunnel: %s", targetURL)
+	}
 
-	err := app.RunContext(ctx, args)
+	err = app.RunContext(ctx, args)
 
 	if ctx.Err() != nil {
-		logger.Sugar.Info("Tunnel stopped by user")
+		logger.ForTunnel(cs.config.ID).Info("Tunnel stopped by user")
 		return
 	}
 
 	if err != nil {
-		logger.Sugar.Errorf("Tunnel error: %v", err)
+		logger.ForTunnel(cs.config.ID).Errorf("Tunnel error: %v", err)
 		cs.mu.Lock()
-		cs.lastError = err
-		cs.status = "error"
+		cs.setErrorLocked(err)
 		cs.mu.Unlock()
 	}
 }
@@ -246,10 +385,50 @@ func (cs *CloudflareService) Stop() error {
 	}
 	cs.mu.Unlock()
 
-	cs.wg.Wait()
+	done := make(chan struct{})
+	go func() {
+		cs.wg.Wait()
+		close(done)
+	}()
+
+	timer := time.NewTimer(cs.stopTimeout)
+	defer timer.Stop()
+	select {
+	case <-done:
+	case <-timer.C:
+		// cloudflared ignored the cancellation. Leave its goroutine behind
+		// rather than hang; each start uses a new service, so it cannot
+		// affect a later run of this tunnel.
+		logger.ForTunnel(cs.config.ID).Warnf("cloudflared did not stop within %s, detaching it", cs.stopTimeout)
+		cs.mu.Lock()
+		cs.status = "stopped"
+		cs.publicURL = ""
+		cs.mu.Unlock()
+	}
 	return nil
 }
 
+// setErrorLocked marks the tunnel failed with err, noting the time if it was
+// running. Caller must hold cs.mu.
+func (cs *CloudflareService) setErrorLocked(err error) {
+	if cs.status == "running" {
+		cs.failedAt = time.Now()
+	}
+	cs.lastError = err
+	cs.status = "error"
+}
+
+// FailedSince returns when the tunnel failed after it was running, zero if
+// it did not or it failed while starting
+func (cs *CloudflareService) FailedSince() time.Time {
+	cs.mu.RLock()
+	defer cs.mu.RUnlock()
+	if cs.status != "error" {
+		return time.Time{}
+	}
+	return cs.failedAt
+}
+
 func (cs *CloudflareService) GetPublicURL() string {
 	cs.mu.RLock()
 	defer cs.mu.RUnlock()
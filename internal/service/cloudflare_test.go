@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"pont/internal/config"
+	"pont/internal/logger"
+)
+
+func TestMain(m *testing.M) {
+	logger.Sugar = zap.NewNop().Sugar()
+	os.Exit(m.Run())
+}
+
+// Two tunnels starting at once each read their own pipe, so each must end up
+// with the URL from its own output even when the writes interleave
+func TestURLCapturePerInstance(t *testing.T) {
+	urls := []string{
+		"https://first-quick-tunnel.trycloudflare.com",
+		"https://second-quick-tunnel.trycloudflare.com",
+	}
+
+	services := make([]*CloudflareService, len(urls))
+	notified := make([]chan string, len(urls))
+	connected := make([]chan struct{}, len(urls))
+	start := make(chan struct{})
+	var wg sync.WaitGroup
+	for i, url := range urls {
+		cs := NewCloudflareService(&config.TunnelConfig{ID: fmt.Sprintf("tunnel-%d", i), Name: fmt.Sprintf("tunnel %d", i)}, 0, 0)
+		cs.status = "starting"
+		services[i] = cs
+
+		notified[i] = make(chan string, len(urls))
+		ch := notified[i]
+		cs.OnPublicURL(func(u string) { ch <- u })
+
+		connected[i] = make(chan struct{})
+		var once sync.Once
+		done := connected[i]
+		capture := &urlCapture{cs: cs, onConnected: func() { once.Do(func() { close(done) }) }}
+
+		r, w := io.Pipe()
+		other := urls[(i+1)%len(urls)]
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			io.Copy(capture, r)
+		}()
+		go func() {
+			defer wg.Done()
+			defer w.Close()
+			<-start
+			fmt.Fprintln(w, "INF Requesting new quick Tunnel on trycloudflare.com...")
+			fmt.Fprintf(w, "INF |  %s  |\n", url)
+			fmt.Fprintln(w, "INF Registered tunnel connection connIndex=0 protocol=quic")
+			// A later URL must not replace the one already captured
+			fmt.Fprintf(w, "INF |  %s  |\n", other)
+		}()
+	}
+	close(start)
+	wg.Wait()
+
+	for i, cs := range services {
+		if got := cs.GetPublicURL(); got != urls[i] {
+			t.Errorf("tunnel %d: public URL = %q, want %q", i, got, urls[i])
+		}
+		if got := cs.GetStatus(); got != "running" {
+			t.Errorf("tunnel %d: status = %q, want running", i, got)
+		}
+		select {
+		case <-connected[i]:
+		default:
+			t.Errorf("tunnel %d: connection was not reported", i)
+		}
+		select {
+		case got := <-notified[i]:
+			if got != urls[i] {
+				t.Errorf("tunnel %d: notified of %q, want %q", i, got, urls[i])
+			}
+		case <-time.After(time.Second):
+			t.Errorf("tunnel %d: OnPublicURL was not called", i)
+		}
+		select {
+		case got := <-notified[i]:
+			t.Errorf("tunnel %d: notified again of %q", i, got)
+		case <-time.After(50 * time.Millisecond):
+		}
+	}
+}
+
+// TestQuickTunnelsDistinctURLs starts two real quick tunnels against distinct
+// local servers. It needs access to Cloudflare, so it only runs with
+// PONT_CLOUDFLARE_TEST=1.
+func TestQuickTunnelsDistinctURLs(t *testing.T) {
+	if os.Getenv("PONT_CLOUDFLARE_TEST") != "1" {
+		t.Skip("set PONT_CLOUDFLARE_TEST=1 to start real quick tunnels")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	services := make([]*CloudflareService, 2)
+	for i := range services {
+		body := fmt.Sprintf("upstream %d", i)
+		upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			io.WriteString(w, body)
+		}))
+		defer upstream.Close()
+
+		cs := NewCloudflareService(&config.TunnelConfig{
+			ID:     fmt.Sprintf("quick-%d", i),
+			Name:   fmt.Sprintf("quick %d", i),
+			Type:   config.TunnelTypeCloudflare,
+			Target: upstream.URL,
+		}, 2*time.Minute, 0)
+		if err := cs.Start(ctx); err != nil {
+			t.Fatalf("tunnel %d: start: %v", i, err)
+		}
+		defer cs.Stop()
+		services[i] = cs
+	}
+
+	deadline := time.Now().Add(3 * time.Minute)
+	urls := make([]string, len(services))
+	for i, cs := range services {
+		for urls[i] == "" {
+			if cs.GetStatus() == "error" {
+				t.Fatalf("tunnel %d failed: %s", i, cs.GetError())
+			}
+			if time.Now().After(deadline) {
+				t.Fatalf("tunnel %d: no public URL before the deadline", i)
+			}
+			time.Sleep(500 * time.Millisecond)
+			urls[i] = cs.GetPublicURL()
+		}
+	}
+	if urls[0] == urls[1] {
+		t.Fatalf("both tunnels got public URL %s", urls[0])
+	}
+}
@@ -0,0 +1,55 @@
+package service
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// Concurrency is how much a tunnel's target is being asked to handle at once.
+// ngrok tunnels count open upstream connections, which is the number of
+// clients served concurrently; cloudflare tunnels with an error page count
+// in-flight requests through Pont's proxy.
+type Concurrency struct {
+	Current int64     `json:"current"`
+	Peak    int64     `json:"peak"`             // highest Current since the tunnel started
+	PeakAt  time.Time `json:"peak_at,omitzero"` // when Peak was first reached
+}
+
+// concurrencyReporter is implemented by services that can count what is in
+// flight to their target; Concurrency returns nil when it is not tracked
+type concurrencyReporter interface {
+	Concurrency() *Concurrency
+}
+
+// concurrencyGauge counts what is in flight and remembers the peak
+type concurrencyGauge struct {
+	current atomic.Int64
+	peak    atomic.Int64
+	peakAt  atomic.Int64 // Unix nanoseconds
+}
+
+func (g *concurrencyGauge) inc() {
+	n := g.current.Add(1)
+	for {
+		peak := g.peak.Load()
+		if n <= peak {
+			return
+		}
+		if g.peak.CompareAndSwap(peak, n) {
+			g.peakAt.Store(time.Now().UnixNano())
+			return
+		}
+	}
+}
+
+func (g *concurrencyGauge) dec() {
+	g.current.Add(-1)
+}
+
+func (g *concurrencyGauge) snapshot() *Concurrency {
+	c := &Concurrency{Current: g.current.Load(), Peak: g.peak.Load()}
+	if at := g.peakAt.Load(); at != 0 {
+		c.PeakAt = time.Unix(0, at)
+	}
+	return c
+}
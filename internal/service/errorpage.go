@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"pont/internal/config"
+	"pont/internal/logger"
+	"time"
+)
+
+// defaultOriginConnectTimeout matches cloudflared's default for
+// --proxy-connect-timeout
+const defaultOriginConnectTimeout = 30 * time.Second
+
+// errorPageProxy is a local reverse proxy between cloudflared and the target.
+// It forwards everything and answers with the tunnel's error page when the
+// target cannot be reached.
+type errorPageProxy struct {
+	listener net.Listener
+	server   *http.Server
+}
+
+// startErrorPageProxy listens on a loopback port and proxies to the tunnel
+// target, applying the origin options cloudflared would otherwise apply.
+// inFlight counts the requests being proxied.
+func startErrorPageProxy(cfg *config.TunnelConfig, inFlight *concurrencyGauge) (*errorPageProxy, error) {
+	targetURL, err := config.CloudflareTargetURL(cfg.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	connectTimeout := defaultOriginConnectTimeout
+	if d, err := time.ParseDuration(cfg.CloudflareConnectTimeout); err == nil {
+		connectTimeout = d
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = (&net.Dialer{Timeout: connectTimeout, KeepAlive: 30 * time.Second}).DialContext
+	if cfg.CloudflareNoTLSVerify {
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+
+	page := []byte(cfg.CloudflareErrorPage)
+	id, name := cfg.ID, cfg.Name
+	proxy := httputil.NewSingleHostReverseProxy(targetURL)
+	proxy.Transport = transport
+	proxy.ErrorHandler = func(w http.ResponseWriter, r *http.Request, err error) {
+		if errors.Is(err, context.Canceled) {
+			return
+		}
+		logger.ForTunnel(id).Debugf("Tunnel %s: target unreachable, serving error page: %v", name, err)
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Header().Set("Cache-Control", "no-store")
+		w.WriteHeader(http.StatusBadGateway)
+		w.Write(page)
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, fmt.Errorf("start error page proxy: %w", err)
+	}
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		inFlight.inc()
+		defer inFlight.dec()
+		proxy.ServeHTTP(w, r)
+	})
+	p := &errorPageProxy{
+		listener: listener,
+		server:   &http.Server{Handler: handler, ReadHeaderTimeout: 30 * time.Second},
+	}
+	go func() {
+		if err := p.server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.ForTunnel(id).Warnf("Tunnel %s: error page proxy stopped: %v", name, err)
+		}
+	}()
+	return p, nil
+}
+
+// URL is the origin cloudflared forwards to
+func (p *errorPageProxy) URL() string {
+	return "http://" + p.listener.Addr().String()
+}
+
+func (p *errorPageProxy) Close() {
+	p.server.Close()
+}
@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"pont/internal/config"
+	"pont/internal/logger"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// defaultHookTimeout bounds how long a hook may run unless
+// Options.HookTimeout is set
+const defaultHookTimeout = 30 * time.Second
+
+// maxHookOutput bounds the hook output copied to the tunnel's log
+const maxHookOutput = 64 * 1024
+
+// runHook runs a hook command of a tunnel through the shell and logs its
+// output to the tunnel's log. Nothing runs unless hooks are allowed.
+func (m *Manager) runHook(ctx context.Context, cfg *config.TunnelConfig, key, command string) error {
+	if command == "" {
+		return nil
+	}
+	log := logger.ForTunnel(cfg.ID)
+	if !m.opts.AllowHooks {
+		log.Warnf("Tunnel %s: skipping %s, hooks are disabled (set ALLOW_HOOKS=true)", cfg.Name, key)
+		return nil
+	}
+
+	timeout := m.opts.HookTimeout
+	if timeout <= 0 {
+		timeout = defaultHookTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", command)
+	}
+	cmd.Env = append(os.Environ(),
+		"PONT_TUNNEL_ID="+cfg.ID,
+		"PONT_TUNNEL_NAME="+cfg.Name,
+		"PONT_TUNNEL_TARGET="+cfg.Target,
+	)
+	// Do not wait for background processes the hook leaves holding its output
+	cmd.WaitDelay = time.Second
+	out := &hookOutput{}
+	cmd.Stdout = out
+	cmd.Stderr = out
+
+	log.Infof("Tunnel %s: running %s", cfg.Name, key)
+	err := cmd.Run()
+	for _, line := range strings.Split(strings.TrimRight(out.String(), "\n"), "\n") {
+		if line != "" {
+			log.Infof("Tunnel %s: %s: %s", cfg.Name, key, line)
+		}
+	}
+	if out.truncated {
+		log.Infof("Tunnel %s: %s: output truncated after %d bytes", cfg.Name, key, maxHookOutput)
+	}
+
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("%s timed out after %s", key, timeout)
+	}
+	if err != nil {
+		return fmt.Errorf("%s failed: %w", key, err)
+	}
+	return nil
+}
+
+// runPostStopHook runs the post-stop hook of a tunnel, logging a failure
+func (m *Manager) runPostStopHook(cfg *config.TunnelConfig) {
+	if err := m.runHook(context.Background(), cfg, "post_stop_hook", cfg.PostStopHook); err != nil {
+		logger.ForTunnel(cfg.ID).Warnf("Tunnel %s: %v", cfg.Name, err)
+	}
+}
+
+// hookOutput collects the combined output of a hook up to maxHookOutput
+type hookOutput struct {
+	strings.Builder
+	truncated bool
+}
+
+func (o *hookOutput) Write(p []byte) (int, error) {
+	if room := maxHookOutput - o.Len(); len(p) > room {
+		o.truncated = true
+		if room > 0 {
+			o.Builder.Write(p[:room])
+		}
+		return len(p), nil
+	}
+	return o.Builder.Write(p)
+}
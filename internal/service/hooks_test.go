@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+
+	"pont/internal/config"
+	"pont/internal/logger"
+)
+
+// observeLogs records what is logged until the test ends
+func observeLogs(t *testing.T) *observer.ObservedLogs {
+	t.Helper()
+	core, logs := observer.New(zapcore.InfoLevel)
+	orig := logger.Sugar
+	logger.Sugar = zap.New(core).Sugar()
+	t.Cleanup(func() { logger.Sugar = orig })
+	return logs
+}
+
+func hookManager(t *testing.T, opts Options) *Manager {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("hook commands below are for sh")
+	}
+	return &Manager{tunnels: make(map[string]*TunnelState), opts: opts}
+}
+
+var hookTunnel = &config.TunnelConfig{ID: "hooked", Name: "hooked", Target: "http://localhost:8080"}
+
+func TestRunHookDisabled(t *testing.T) {
+	m := hookManager(t, Options{})
+	logs := observeLogs(t)
+	marker := filepath.Join(t.TempDir(), "ran")
+
+	if err := m.runHook(context.Background(), hookTunnel, "pre_start_hook", "touch "+marker); err != nil {
+		t.Fatalf("runHook: %v", err)
+	}
+	if _, err := os.Stat(marker); !os.IsNotExist(err) {
+		t.Error("hook ran with hooks disabled")
+	}
+	if logs.FilterMessageSnippet("hooks are disabled").Len() != 1 {
+		t.Errorf("skipped hook not logged: %v", logs.All())
+	}
+}
+
+func TestRunHookOutput(t *testing.T) {
+	m := hookManager(t, Options{AllowHooks: true})
+	logs := observeLogs(t)
+
+	err := m.runHook(context.Background(), hookTunnel, "pre_start_hook", `echo "starting $PONT_TUNNEL_NAME"; echo oops >&2; exit 3`)
+	if err == nil || !strings.Contains(err.Error(), "pre_start_hook failed") {
+		t.Errorf("runHook = %v, want a failure", err)
+	}
+	for _, want := range []string{"pre_start_hook: starting hooked", "pre_start_hook: oops"} {
+		if logs.FilterMessageSnippet(want).Len() != 1 {
+			t.Errorf("output %q not logged: %v", want, logs.All())
+		}
+	}
+}
+
+func TestRunHookOutputTruncated(t *testing.T) {
+	m := hookManager(t, Options{AllowHooks: true})
+	logs := observeLogs(t)
+
+	if err := m.runHook(context.Background(), hookTunnel, "post_stop_hook", "head -c 100000 /dev/zero | tr '\\0' x"); err != nil {
+		t.Fatalf("runHook: %v", err)
+	}
+	lines := logs.FilterMessageSnippet("post_stop_hook: xxx").All()
+	if len(lines) != 1 {
+		t.Fatalf("got %d output lines, want 1", len(lines))
+	}
+	if got := strings.Count(lines[0].Message, "x"); got != maxHookOutput {
+		t.Errorf("logged %d bytes of output, want %d", got, maxHookOutput)
+	}
+	if logs.FilterMessageSnippet("output truncated").Len() != 1 {
+		t.Errorf("truncation not logged: %v", logs.All())
+	}
+}
+
+func TestRunHookTimeout(t *testing.T) {
+	m := hookManager(t, Options{AllowHooks: true, HookTimeout: 200 * time.Millisecond})
+	observeLogs(t)
+
+	start := time.Now()
+	err := m.runHook(context.Background(), hookTunnel, "pre_start_hook", "sleep 30")
+	if err == nil || !strings.Contains(err.Error(), "timed out after 200ms") {
+		t.Errorf("runHook = %v, want a timeout", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("hook was not killed, runHook took %s", elapsed)
+	}
+}
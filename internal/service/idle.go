@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"pont/internal/logger"
+)
+
+// activityReporter is implemented by services that can tell when traffic
+// last went through the tunnel
+type activityReporter interface {
+	LastActivity() time.Time
+}
+
+// activityClock records the time of the latest traffic as Unix nanoseconds
+type activityClock struct {
+	last atomic.Int64
+}
+
+func (c *activityClock) touch() {
+	c.last.Store(time.Now().UnixNano())
+}
+
+func (c *activityClock) time() time.Time {
+	return time.Unix(0, c.last.Load())
+}
+
+// activityDialer dials upstream connections that touch clock whenever data
+// flows in either direction and are counted by open while they are open
+type activityDialer struct {
+	clock  *activityClock
+	open   *concurrencyGauge
+	dialer net.Dialer
+}
+
+func (d *activityDialer) Dial(network, address string) (net.Conn, error) {
+	return d.DialContext(context.Background(), network, address)
+}
+
+func (d *activityDialer) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	conn, err := d.dialer.DialContext(ctx, network, address)
+	if err != nil {
+		return nil, err
+	}
+	d.clock.touch()
+	d.open.inc()
+	return &activityConn{Conn: conn, clock: d.clock, open: d.open}, nil
+}
+
+type activityConn struct {
+	net.Conn
+	clock     *activityClock
+	open      *concurrencyGauge
+	closeOnce sync.Once
+}
+
+func (c *activityConn) Close() error {
+	c.closeOnce.Do(c.open.dec)
+	return c.Conn.Close()
+}
+
+func (c *activityConn) Read(p []byte) (int, error) {
+	n, err := c.Conn.Read(p)
+	if n > 0 {
+		c.clock.touch()
+	}
+	return n, err
+}
+
+func (c *activityConn) Write(p []byte) (int, error) {
+	n, err := c.Conn.Write(p)
+	if n > 0 {
+		c.clock.touch()
+	}
+	return n, err
+}
+
+// idleCheckInterval is the longest time between idle checks
+const idleCheckInterval = 30 * time.Second
+
+// idleLoop stops a running tunnel once it has had no traffic for its idle
+// timeout. Like probeLoop it reads the configuration every round, so the
+// timeout can be changed or removed while the tunnel runs.
+func (m *Manager) idleLoop(ctx context.Context, id string, state *TunnelState, run uint64) {
+	m.mu.RLock()
+	reporter, ok := state.service.(activityReporter)
+	m.mu.RUnlock()
+	if !ok {
+		return
+	}
+
+	for {
+		interval := idleCheckInterval
+
+		tunnelCfg, err := m.cfgMgr.GetTunnel(id)
+		if err == nil {
+			if timeout := tunnelCfg.IdleTimeoutDuration(); timeout > 0 {
+				interval = min(interval, timeout/4)
+				if idle := time.Since(reporter.LastActivity()); idle >= timeout {
+					m.stopIdle(id, tunnelCfg.Name, state, run, idle.Truncate(time.Second))
+					return
+				}
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+	}
+}
+
+// stopIdle stops a tunnel that exceeded its idle timeout, unless it was
+// restarted or stopped in the meantime
+func (m *Manager) stopIdle(id, name string, state *TunnelState, run uint64, idle time.Duration) {
+	m.mu.Lock()
+	if state.run != run || state.paused || state.Status == "stopped" {
+		m.mu.Unlock()
+		return
+	}
+	logger.ForTunnel(id).Infof("Stopping tunnel %s: no traffic for %s", name, idle)
+	m.stopService(state)
+	state.Status = "stopped"
+	state.IdleStopped = true
+	m.mu.Unlock()
+	m.invalidateStatusCache()
+
+	m.cfgMgr.RecordAudit("pont", "tunnel.idle_stop", id, fmt.Sprintf("no traffic for %s", idle))
+}
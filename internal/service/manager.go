@@ -1,12 +1,19 @@
 package service
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"pont/internal/config"
 	"pont/internal/logger"
+	"pont/internal/tracing"
+	"sort"
+	"strings"
 	"sync"
 	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // TunnelService interface for different tunnel implementations
@@ -18,16 +25,99 @@ type TunnelService interface {
 	GetError() string
 }
 
-// TunnelState represents the runtime state of a tunnel
+// publicURLNotifier is implemented by services that learn their public URL
+// only after Start has returned
+type publicURLNotifier interface {
+	OnPublicURL(fn func(url string))
+}
+
+// internalURLer is implemented by services that can expose an endpoint
+// reachable only inside the provider's network instead of a public URL
+type internalURLer interface {
+	GetInternalURL() string
+}
+
+// failureTimer is implemented by services that can fail after they were
+// running. FailedSince returns when that happened, zero while the service
+// runs or when it failed during start; the error grace period counts from it.
+type failureTimer interface {
+	FailedSince() time.Time
+}
+
+// TunnelState represents the runtime state of a tunnel. Like the other API
+// types, only the ID, status and counters are always present; fields that
+// are unset, such as the times of a tunnel never started, are omitted.
 type TunnelState struct {
-	ID        string    `json:"id"`
-	Status    string    `json:"status"` // "stopped", "starting", "running", "error"
-	PublicURL string    `json:"public_url"`
-	StartedAt time.Time `json:"started_at"`
-	Error     string    `json:"error,omitempty"`
-	ctx       context.Context `json:"-"`
-	cancel    context.CancelFunc `json:"-"`
-	service   TunnelService `json:"-"`
+	ID        string `json:"id"`
+	Status    string `json:"status"` // "stopped", "starting", "running", "paused", "error"
+	PublicURL string `json:"public_url,omitempty"`
+
+	// InternalURL is set for endpoints without a public URL, such as ngrok
+	// internal endpoints
+	InternalURL string    `json:"internal_url,omitempty"`
+	StartedAt   time.Time `json:"started_at,omitzero"`
+	Error       string    `json:"error,omitempty"`
+
+	// RestartCount and FirstStartedAt carry over when a stopped tunnel is
+	// started again, unless it is started fresh
+	RestartCount   int       `json:"restart_count"`
+	FirstStartedAt time.Time `json:"first_started_at,omitzero"`
+
+	// Target is the configured target, possibly with ${VAR} placeholders;
+	// ResolvedTarget is what the tunnel actually forwards to
+	Target         string `json:"target,omitempty"`
+	ResolvedTarget string `json:"resolved_target,omitempty"`
+
+	// Region is the edge region a cloudflare tunnel connects to, "global"
+	// unless cloudflare_region is set
+	Region string `json:"region,omitempty"`
+
+	// IdleStopped is set when the tunnel was stopped for lack of traffic,
+	// until it is started again
+	IdleStopped bool `json:"idle_stopped,omitempty"`
+
+	// Reachability is set while the tunnel has reachability probes enabled
+	Reachability *Reachability `json:"reachability,omitempty"`
+
+	// Concurrency is set for tunnels whose load on the target Pont can see
+	Concurrency *Concurrency `json:"concurrency,omitempty"`
+
+	name   string
+	domain string // reserved ngrok domain, see reservedDomain
+	paused bool
+	grace  time.Duration
+	run    uint64 // incremented per start, so a previous run's goroutine leaves the state alone
+
+	ctx     context.Context    `json:"-"`
+	cancel  context.CancelFunc `json:"-"`
+	service TunnelService      `json:"-"`
+	done    chan struct{}      // closed when the goroutine of the run exits
+}
+
+// Options configures the service manager
+type Options struct {
+	// StatusCacheTTL is how long GetAllStatuses may serve a cached snapshot
+	// instead of querying every service; 0 disables the cache
+	StatusCacheTTL time.Duration
+
+	// URLCaptureTimeout is how long a cloudflare tunnel may run without a
+	// public URL before it is marked as failed; 0 means the default
+	URLCaptureTimeout time.Duration
+
+	// CloudflareStopTimeout bounds how long stopping a cloudflare tunnel
+	// waits for cloudflared to exit; 0 means the default
+	CloudflareStopTimeout time.Duration
+
+	// NgrokAuthtoken is used by ngrok tunnels without their own authtoken
+	NgrokAuthtoken string
+
+	// AllowHooks runs the pre-start and post-stop hooks of tunnels, each
+	// for at most HookTimeout (0 means the default)
+	AllowHooks  bool
+	HookTimeout time.Duration
+
+	// ngrokCredential looks up the authtoken of a stored ngrok credential
+	ngrokCredential func(name string) (string, error)
 }
 
 // Manager manages multiple tunnel instances
@@ -35,23 +125,84 @@ type Manager struct {
 	mu      sync.RWMutex
 	tunnels map[string]*TunnelState
 	cfgMgr  *config.Manager
+	opts    Options
+
+	cacheMu     sync.Mutex
+	statusCache map[string]*TunnelState
+	cacheAt     time.Time
+	cacheGen    uint64
+
+	// running tracks the tunnel goroutines, which read and write the
+	// database until they exit
+	running sync.WaitGroup
 }
 
 // NewManager creates a new tunnel service manager
-func NewManager(cfgMgr *config.Manager) *Manager {
+func NewManager(cfgMgr *config.Manager, opts Options) *Manager {
+	opts.ngrokCredential = cfgMgr.NgrokCredentialAuthtoken
 	return &Manager{
 		tunnels: make(map[string]*TunnelState),
 		cfgMgr:  cfgMgr,
+		opts:    opts,
 	}
 }
 
 // Start starts a tunnel
 func (m *Manager) Start(id string) error {
+	return m.StartContext(context.Background(), id)
+}
+
+// StartContext starts a tunnel, tracing the start as a child of parent. The
+// span ends once the tunnel is running or has failed, after this returns;
+// parent does not bound the tunnel's lifetime.
+func (m *Manager) StartContext(parent context.Context, id string) error {
+	return m.StartWithOptions(parent, id, StartOptions{})
+}
+
+// StartOptions modify how a tunnel is started
+type StartOptions struct {
+	// Fresh discards the state kept from earlier runs, such as the restart
+	// count, instead of continuing it
+	Fresh bool
+}
+
+// StartWithOptions is StartContext with options
+func (m *Manager) StartWithOptions(parent context.Context, id string, opts StartOptions) error {
+	return m.start(parent, id, opts, false)
+}
+
+// Restart replaces the service of a running or starting tunnel so it picks
+// up its updated configuration, keeping its state like any other restart.
+// Tunnels that are not active are left alone; restarted reports whether a
+// restart happened.
+func (m *Manager) Restart(ctx context.Context, id string) (restarted bool, err error) {
+	m.mu.RLock()
+	state, exists := m.tunnels[id]
+	active := exists && !state.paused && (state.Status == "running" || state.Status == "starting")
+	m.mu.RUnlock()
+	if !active {
+		return false, nil
+	}
+
+	logger.ForTunnel(id).Infof("Restarting tunnel %s to apply its updated configuration", id)
+	return true, m.start(ctx, id, StartOptions{}, true)
+}
+
+// start starts a tunnel; replace allows replacing the service of a running one
+func (m *Manager) start(parent context.Context, id string, opts StartOptions, replace bool) (err error) {
+	_, span := tracing.Tracer().Start(parent, "tunnel.start", trace.WithAttributes(attribute.String("pont.tunnel.id", id)))
+	spanHandedOff := false
+	defer func() {
+		if !spanHandedOff {
+			tracing.End(span, err)
+		}
+	}()
+
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
 	// Check if already running
-	if state, exists := m.tunnels[id]; exists && state.Status == "running" {
+	if state, exists := m.tunnels[id]; exists && state.Status == "running" && !replace {
 		return fmt.Errorf("tunnel already running")
 	}
 
@@ -60,141 +211,610 @@ func (m *Manager) Start(id string) error {
 	if err != nil {
 		return err
 	}
+	if tunnelCfg.Archived {
+		return fmt.Errorf("tunnel is archived, restore it before starting")
+	}
+
+	// Enforce the global cap on simultaneously running tunnels
+	if settings, err := m.cfgMgr.GetSettings(); err == nil && settings.MaxRunningTunnels > 0 {
+		active := m.activeTunnelNames(id)
+		if len(active) >= settings.MaxRunningTunnels {
+			return fmt.Errorf("maximum of %d running tunnels reached, stop one first (running: %s)",
+				settings.MaxRunningTunnels, strings.Join(active, ", "))
+		}
+	}
+
+	// ngrok reports a reserved domain in use only after a slow round trip
+	domain := reservedDomain(tunnelCfg)
+	if other := m.domainHolder(id, domain); other != "" {
+		return fmt.Errorf("ngrok domain %s is already in use by tunnel %q, stop it first", tunnelCfg.NgrokDomain, other)
+	}
+
+	// Resolve ${VAR} placeholders now, so the environment at start time wins
+	target, err := config.ResolveTarget(tunnelCfg.Target)
+	if err != nil {
+		return err
+	}
+	if err := m.cfgMgr.CheckTarget(target); err != nil {
+		return err
+	}
+	runCfg := *tunnelCfg
+	runCfg.Target = target
 
 	// Create tunnel service based on type
-	var service TunnelService
-	switch tunnelCfg.Type {
-	case config.TunnelTypeCloudflare:
-		service = NewCloudflareService(tunnelCfg)
-	case config.TunnelTypeNgrok:
-		service = NewNgrokService(tunnelCfg)
-	default:
+	p, ok := providers[tunnelCfg.Type]
+	if !ok {
 		return fmt.Errorf("unsupported tunnel type: %s", tunnelCfg.Type)
 	}
+	span.SetAttributes(
+		attribute.String("pont.tunnel.name", tunnelCfg.Name),
+		attribute.String("pont.tunnel.type", string(tunnelCfg.Type)),
+	)
+	service := p.newService(&runCfg, m.opts)
+	if n, ok := service.(publicURLNotifier); ok {
+		n.OnPublicURL(func(url string) { m.recordPublicURL(id, url) })
+	}
 
 	// Create context
 	ctx, cancel := context.WithCancel(context.Background())
 
-	// Create state
-	state := &TunnelState{
-		ID:        id,
-		Status:    "starting",
-		StartedAt: time.Now(),
-		ctx:       ctx,
-		cancel:    cancel,
-		service:   service,
+	// Keep the state of an earlier run, resetting only what belongs to the
+	// service, so the restart count and first start survive a restart
+	now := time.Now()
+	state, exists := m.tunnels[id]
+	var prevDone <-chan struct{}
+	if exists {
+		m.stopService(state)
+		prevDone = state.done
+	}
+	if exists && !opts.Fresh {
+		state.RestartCount++
+	} else {
+		state = &TunnelState{ID: id, FirstStartedAt: now}
+		m.tunnels[id] = state
+	}
+	state.run++
+	run := state.run
+
+	state.Status = "starting"
+	state.StartedAt = now
+	state.PublicURL = ""
+	state.InternalURL = ""
+	state.Error = ""
+	state.Target = tunnelCfg.Target
+	state.ResolvedTarget = target
+	state.Region = tunnelRegion(tunnelCfg)
+	state.Reachability = nil
+	state.IdleStopped = false
+	state.name = tunnelCfg.Name
+	state.domain = domain
+	state.paused = false
+	state.grace = tunnelCfg.ErrorGraceDuration()
+	state.ctx = ctx
+	state.cancel = cancel
+	state.service = service
+	state.done = make(chan struct{})
+	done := state.done
+
+	m.invalidateStatusCache()
+
+	// Starting ends a pause recorded before a restart, too
+	if tunnelCfg.Paused {
+		m.persistPaused(id, false)
 	}
 
-	m.tunnels[id] = state
-
-	// Start tunnel in goroutine
+	// Start tunnel in goroutine, which ends the span
+	spanHandedOff = true
+	m.running.Add(1)
 	go func() {
-		logger.Sugar.Infof("Starting tunnel: %s (%s)", tunnelCfg.Name, tunnelCfg.Type)
+		defer m.running.Done()
+		defer close(done)
+		logger.ForTunnel(id).Infof("Starting tunnel: %s (%s)", tunnelCfg.Name, tunnelCfg.Type)
+
+		// Hooks of a restart run after the post-stop hook of the previous run
+		if m.opts.AllowHooks && prevDone != nil {
+			select {
+			case <-prevDone:
+			case <-ctx.Done():
+			}
+		}
 
-		if err := service.Start(ctx); err != nil {
+		err := m.runHook(ctx, &runCfg, "pre_start_hook", runCfg.PreStartHook)
+		if err == nil {
+			defer m.runPostStopHook(&runCfg)
+			err = service.Start(ctx)
+		}
+		if ctx.Err() != nil {
+			span.SetAttributes(attribute.Bool("pont.tunnel.cancelled", true))
+			tracing.End(span, nil)
+		} else {
+			tracing.End(span, err)
+		}
+		if err != nil {
+			// A start cancelled by Stop or Pause is not a failure
+			if ctx.Err() != nil {
+				logger.ForTunnel(id).Infof("Start of tunnel %s was cancelled", tunnelCfg.Name)
+				return
+			}
 			m.mu.Lock()
-			state.Status = "error"
-			state.Error = err.Error()
+			if state.run == run {
+				state.Status = "error"
+				state.Error = err.Error()
+			}
 			m.mu.Unlock()
-			logger.Sugar.Errorf("Tunnel error: %v", err)
+			m.invalidateStatusCache()
+			logger.ForTunnel(id).Errorf("Tunnel error: %v", err)
 			return
 		}
 
+		publicURL := service.GetPublicURL()
 		m.mu.Lock()
-		state.Status = "running"
-		state.PublicURL = service.GetPublicURL()
+		if state.run == run {
+			state.Status = "running"
+			state.PublicURL = publicURL
+		}
 		m.mu.Unlock()
+		m.invalidateStatusCache()
+		if publicURL != "" {
+			m.recordPublicURL(id, publicURL)
+		}
 
-		logger.Sugar.Infof("Tunnel running: %s -> %s", tunnelCfg.Name, state.PublicURL)
+		logger.ForTunnel(id).Infof("Tunnel running: %s -> %s", tunnelCfg.Name, publicURL)
+
+		m.running.Add(2)
+		go func() {
+			defer m.running.Done()
+			m.probeLoop(ctx, id, state, run)
+		}()
+		go func() {
+			defer m.running.Done()
+			m.idleLoop(ctx, id, state, run)
+		}()
 
 		// Wait for context cancellation
 		<-ctx.Done()
 
 		m.mu.Lock()
-		state.Status = "stopped"
+		if state.run == run && !state.paused {
+			state.Status = "stopped"
+		}
 		m.mu.Unlock()
+		m.invalidateStatusCache()
 
-		logger.Sugar.Infof("Tunnel stopped: %s", tunnelCfg.Name)
+		logger.ForTunnel(id).Infof("Tunnel stopped: %s", tunnelCfg.Name)
 	}()
 
 	return nil
 }
 
+// AutoStart starts every enabled, non-archived tunnel when the auto_start
+// setting is on. Failures are logged and do not stop the remaining tunnels.
+func (m *Manager) AutoStart() {
+	settings, err := m.cfgMgr.GetSettings()
+	if err != nil {
+		logger.Sugar.Warnf("Auto start skipped, failed to load settings: %v", err)
+		return
+	}
+	if !settings.AutoStart {
+		return
+	}
+
+	plan, err := m.AutoStartPlan()
+	if err != nil {
+		logger.Sugar.Warnf("Auto start skipped, failed to load tunnels: %v", err)
+		return
+	}
+
+	for _, t := range plan {
+		logger.ForTunnel(t.ID).Infof("Auto starting tunnel: %s", t.Name)
+		if err := m.Start(t.ID); err != nil {
+			logger.ForTunnel(t.ID).Warnf("Auto start of tunnel %s failed: %v", t.Name, err)
+		}
+	}
+}
+
+// AutoStartPlan returns the tunnels AutoStart would launch, in launch order:
+// enabled, non-archived tunnels that are not paused. It does not look at the
+// auto_start setting so the plan can be checked before turning it on.
+func (m *Manager) AutoStartPlan() ([]config.TunnelConfig, error) {
+	tunnels, err := m.cfgMgr.GetAllTunnels()
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	plan := make([]config.TunnelConfig, 0, len(tunnels))
+	for _, t := range tunnels {
+		if !t.Enabled || t.Paused {
+			continue
+		}
+		if state, ok := m.tunnels[t.ID]; ok && state.paused {
+			continue
+		}
+		plan = append(plan, t)
+	}
+	return plan, nil
+}
+
+// recordPublicURL adds a tunnel's public URL to its history
+func (m *Manager) recordPublicURL(id, url string) {
+	if err := m.cfgMgr.RecordPublicURL(id, url); err != nil {
+		logger.ForTunnel(id).Warnf("Failed to record public URL of tunnel %s: %v", id, err)
+	}
+}
+
+// activeTunnelNames returns the names of starting or running tunnels, excluding the given id.
+// Caller must hold m.mu.
+func (m *Manager) activeTunnelNames(excludeID string) []string {
+	var names []string
+	for id, state := range m.tunnels {
+		if id == excludeID {
+			continue
+		}
+		status := state.service.GetStatus()
+		if state.Status == "starting" || status == "starting" || status == "running" {
+			names = append(names, state.name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// reservedDomain is the ngrok domain a tunnel claims, normalized so that
+// "https://app.example.com/" and "app.example.com" compare equal; empty for
+// tunnels without one
+func reservedDomain(tunnelCfg *config.TunnelConfig) string {
+	if tunnelCfg.Type != config.TunnelTypeNgrok || tunnelCfg.NgrokDomain == "" {
+		return ""
+	}
+	domain := strings.ToLower(tunnelCfg.NgrokDomain)
+	for _, scheme := range []string{"https://", "http://"} {
+		domain = strings.TrimPrefix(domain, scheme)
+	}
+	return strings.TrimSuffix(domain, "/")
+}
+
+// domainHolder returns the name of another starting or running tunnel with
+// the reserved domain, or "" when there is none. Caller must hold m.mu.
+func (m *Manager) domainHolder(excludeID, domain string) string {
+	if domain == "" {
+		return ""
+	}
+	for id, state := range m.tunnels {
+		if id == excludeID || state.domain != domain || state.paused {
+			continue
+		}
+		status := state.service.GetStatus()
+		if state.Status == "starting" || status == "starting" || status == "running" {
+			return state.name
+		}
+	}
+	return ""
+}
+
 // Stop stops a tunnel
 func (m *Manager) Stop(id string) error {
+	return m.StopContext(context.Background(), id)
+}
+
+// StopContext stops a tunnel, tracing the stop as a child of ctx
+func (m *Manager) StopContext(ctx context.Context, id string) (err error) {
+	_, span := tracing.Tracer().Start(ctx, "tunnel.stop", trace.WithAttributes(attribute.String("pont.tunnel.id", id)))
+	defer func() { tracing.End(span, err) }()
+
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
 	state, exists := m.tunnels[id]
 	if !exists {
+		// A tunnel paused before a restart has no state, only its pause
+		if tunnelCfg, err := m.cfgMgr.GetTunnel(id); err == nil && tunnelCfg.Paused {
+			defer m.invalidateStatusCache()
+			return m.cfgMgr.SetPaused(id, false)
+		}
 		return fmt.Errorf("tunnel not found")
 	}
+	defer m.invalidateStatusCache()
 
-	// Check actual service status instead of cached status
-	if state.service != nil && state.service.GetStatus() == "stopped" {
+	// Stopping a paused tunnel only clears the pause, the service is already down
+	if state.paused {
+		state.paused = false
+		state.Status = "stopped"
+		m.persistPaused(id, false)
+		return nil
+	}
+
+	m.stopService(state)
+	state.Status = "stopped"
+	return nil
+}
+
+// Pause stops forwarding for a running tunnel while remembering that it was
+// paused rather than stopped, so it is reported distinctly and can be resumed
+func (m *Manager) Pause(id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	state, exists := m.tunnels[id]
+	if !exists {
+		return fmt.Errorf("tunnel not running")
+	}
+	if state.paused {
 		return nil
 	}
 
-	logger.Sugar.Infof("Stopping tunnel: %s", id)
+	status := state.service.GetStatus()
+	if state.Status != "starting" && status != "starting" && status != "running" {
+		return fmt.Errorf("tunnel not running")
+	}
+
+	logger.ForTunnel(id).Infof("Pausing tunnel: %s", id)
+	defer m.invalidateStatusCache()
+
+	state.paused = true
+	state.Status = "paused"
+	m.stopService(state)
+	m.persistPaused(id, true)
+	return nil
+}
+
+// Resume restarts a paused tunnel
+func (m *Manager) Resume(id string) error {
+	m.mu.RLock()
+	state, exists := m.tunnels[id]
+	paused := exists && state.paused
+	m.mu.RUnlock()
+
+	// After a restart only the configuration knows about the pause
+	if !exists {
+		tunnelCfg, err := m.cfgMgr.GetTunnel(id)
+		paused = err == nil && tunnelCfg.Paused
+	}
+	if !paused {
+		return fmt.Errorf("tunnel is not paused")
+	}
+
+	logger.ForTunnel(id).Infof("Resuming tunnel: %s", id)
+	return m.Start(id)
+}
 
-	// Cancel context
+// persistPaused records the pause of a tunnel in its configuration. The
+// pause already took effect, so a failure is only logged.
+func (m *Manager) persistPaused(id string, paused bool) {
+	if err := m.cfgMgr.SetPaused(id, paused); err != nil {
+		logger.ForTunnel(id).Warnf("Failed to save the pause of tunnel %s: %v", id, err)
+	}
+}
+
+// stopService cancels and stops the service behind a tunnel state. A tunnel
+// that is still starting has its connect attempt cancelled. Caller must hold m.mu.
+func (m *Manager) stopService(state *TunnelState) {
+	// Cancel the context first: a service may still report "stopped" while
+	// its start is in progress
 	if state.cancel != nil {
 		state.cancel()
 	}
 
+	// Check actual service status instead of cached status
+	if state.service != nil && state.service.GetStatus() == "stopped" {
+		return
+	}
+
+	if state.Status == "starting" {
+		logger.ForTunnel(state.ID).Infof("Cancelling start of tunnel: %s", state.ID)
+	} else {
+		logger.ForTunnel(state.ID).Infof("Stopping tunnel: %s", state.ID)
+	}
+
 	// Stop service
 	if state.service != nil {
 		if err := state.service.Stop(); err != nil {
-			logger.Sugar.Warnf("Error stopping tunnel service: %v", err)
+			logger.ForTunnel(state.ID).Warnf("Error stopping tunnel service: %v", err)
 		}
 	}
-
-	state.Status = "stopped"
-	return nil
 }
 
 // GetStatus returns the status of a tunnel
 func (m *Manager) GetStatus(id string) (*TunnelState, error) {
+	// Runtime state outlives deleted tunnels, so the configuration decides
+	// whether the ID is known; an archived tunnel counts as deleted
+	tunnelCfg, err := m.cfgMgr.GetTunnel(id)
+	if err != nil {
+		return nil, err
+	}
+	if tunnelCfg.Archived {
+		return nil, fmt.Errorf("%w: %s", config.ErrTunnelNotFound, id)
+	}
+
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
 	state, exists := m.tunnels[id]
 	if !exists {
+		status := "stopped"
+		if tunnelCfg.Paused {
+			status = "paused"
+		}
 		return &TunnelState{
 			ID:     id,
-			Status: "stopped",
+			Status: status,
 		}, nil
 	}
 
-	// Return a copy with current service status
-	return &TunnelState{
-		ID:        state.ID,
-		Status:    state.service.GetStatus(),
-		PublicURL: state.service.GetPublicURL(),
-		StartedAt: state.StartedAt,
-		Error:     state.service.GetError(),
-	}, nil
+	return state.snapshot(), nil
 }
 
-// GetAllStatuses returns the status of all tunnels
+// GetAllStatuses returns the status of all tunnels. With a status cache TTL
+// configured, a recent snapshot is returned without querying the services.
 func (m *Manager) GetAllStatuses() map[string]*TunnelState {
+	if m.opts.StatusCacheTTL <= 0 {
+		return m.collectStatuses()
+	}
+
+	m.cacheMu.Lock()
+	if m.statusCache != nil && time.Since(m.cacheAt) < m.opts.StatusCacheTTL {
+		result := copyStatuses(m.statusCache)
+		m.cacheMu.Unlock()
+		return result
+	}
+	gen := m.cacheGen
+	m.cacheMu.Unlock()
+
+	statuses := m.collectStatuses()
+
+	// Only keep the snapshot if no transition happened while collecting it
+	m.cacheMu.Lock()
+	if gen == m.cacheGen {
+		m.statusCache = statuses
+		m.cacheAt = time.Now()
+	}
+	m.cacheMu.Unlock()
+
+	return copyStatuses(statuses)
+}
+
+// collectStatuses queries every service for its current status
+func (m *Manager) collectStatuses() map[string]*TunnelState {
+	paused, err := m.cfgMgr.PausedTunnelIDs()
+	if err != nil {
+		logger.Sugar.Warnf("Failed to load paused tunnels: %v", err)
+	}
+
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
 	result := make(map[string]*TunnelState)
 	for id, state := range m.tunnels {
+		result[id] = state.snapshot()
+	}
+	// Tunnels paused before a restart have no state yet
+	for _, id := range paused {
+		if _, ok := result[id]; !ok {
+			result[id] = &TunnelState{ID: id, Status: "paused"}
+		}
+	}
+
+	return result
+}
+
+// invalidateStatusCache drops the cached snapshot after a state transition
+func (m *Manager) invalidateStatusCache() {
+	m.cacheMu.Lock()
+	m.statusCache = nil
+	m.cacheGen++
+	m.cacheMu.Unlock()
+}
+
+// copyStatuses copies a status map so callers cannot modify the cache
+func copyStatuses(statuses map[string]*TunnelState) map[string]*TunnelState {
+	result := make(map[string]*TunnelState, len(statuses))
+	for id, state := range statuses {
 		result[id] = &TunnelState{
 			ID:        state.ID,
-			Status:    state.service.GetStatus(),
-			PublicURL: state.service.GetPublicURL(),
+			Status:    state.Status,
+			PublicURL: state.PublicURL,
 			StartedAt: state.StartedAt,
-			Error:     state.service.GetError(),
+			Error:     state.Error,
+
+			RestartCount:   state.RestartCount,
+			FirstStartedAt: state.FirstStartedAt,
+			InternalURL:    state.InternalURL,
+			Target:         state.Target,
+			ResolvedTarget: state.ResolvedTarget,
+			Region:         state.Region,
+			IdleStopped:    state.IdleStopped,
+			Reachability:   state.Reachability,
+			Concurrency:    state.Concurrency,
 		}
 	}
-
 	return result
 }
 
+// snapshot returns a copy of the state with the current service status
+func (state *TunnelState) snapshot() *TunnelState {
+	raw := state.service.GetStatus()
+	// The start goroutine may not have reached the service yet
+	if raw == "stopped" && state.Status == "starting" {
+		raw = "starting"
+	}
+	// A failed pre-start hook leaves the service untouched
+	failedBeforeService := raw == "stopped" && state.Status == "error"
+	if failedBeforeService {
+		raw = "error"
+	}
+	var failedSince time.Time
+	if s, ok := state.service.(failureTimer); ok {
+		failedSince = s.FailedSince()
+	}
+	status := state.debouncedStatus(raw, failedSince)
+	if state.paused {
+		status = "paused"
+	}
+
+	// An error hidden by the grace period is not reported either
+	errMsg := state.service.GetError()
+	if failedBeforeService {
+		errMsg = state.Error
+	}
+	if raw == "error" && status == "running" {
+		errMsg = ""
+	}
+
+	internalURL := ""
+	if s, ok := state.service.(internalURLer); ok {
+		internalURL = s.GetInternalURL()
+	}
+	var concurrency *Concurrency
+	if s, ok := state.service.(concurrencyReporter); ok {
+		concurrency = s.Concurrency()
+	}
+
+	return &TunnelState{
+		ID:        state.ID,
+		Status:    status,
+		PublicURL: state.service.GetPublicURL(),
+		StartedAt: state.StartedAt,
+		Error:     errMsg,
+
+		RestartCount:   state.RestartCount,
+		FirstStartedAt: state.FirstStartedAt,
+		InternalURL:    internalURL,
+
+		Target:         state.Target,
+		ResolvedTarget: state.ResolvedTarget,
+		Region:         state.Region,
+		IdleStopped:    state.IdleStopped,
+		Reachability:   state.Reachability,
+		Concurrency:    concurrency,
+	}
+}
+
+// tunnelRegion is the edge region reported for a tunnel; only cloudflare
+// tunnels can choose one
+func tunnelRegion(tunnelCfg *config.TunnelConfig) string {
+	if tunnelCfg.Type != config.TunnelTypeCloudflare {
+		return ""
+	}
+	return cmp.Or(tunnelCfg.CloudflareRegion, "global")
+}
+
+// debouncedStatus keeps reporting "running" while a running tunnel has been
+// in error for less than its grace period, so transient blips that heal on
+// their own do not show up as failures. failedSince is when the service
+// failed after running, see failureTimer; a failed start, with a zero
+// failedSince, is reported right away.
+func (state *TunnelState) debouncedStatus(status string, failedSince time.Time) string {
+	if status != "error" || state.grace <= 0 || failedSince.IsZero() {
+		return status
+	}
+	if time.Since(failedSince) < state.grace {
+		return "running"
+	}
+	return status
+}
+
 // StopAll stops all running tunnels
 func (m *Manager) StopAll() error {
 	m.mu.RLock()
@@ -206,9 +826,29 @@ func (m *Manager) StopAll() error {
 
 	for _, id := range ids {
 		if err := m.Stop(id); err != nil {
-			logger.Sugar.Warnf("Error stopping tunnel %s: %v", id, err)
+			logger.ForTunnel(id).Warnf("Error stopping tunnel %s: %v", id, err)
 		}
 	}
 
 	return nil
 }
+
+// Shutdown stops all tunnels and waits until their goroutines have exited,
+// so the database can be closed afterwards. It gives up when ctx is done.
+func (m *Manager) Shutdown(ctx context.Context) error {
+	if err := m.StopAll(); err != nil {
+		return err
+	}
+
+	done := make(chan struct{})
+	go func() {
+		m.running.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("waiting for tunnels to exit: %w", ctx.Err())
+	}
+}
@@ -0,0 +1,150 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pont/internal/config"
+	"pont/internal/db"
+)
+
+// newTestManager returns a service manager backed by a fresh database
+func newTestManager(t *testing.T) (*config.Manager, *Manager) {
+	t.Helper()
+	client, err := db.Init(t.TempDir(), db.Options{AutoMigrate: true})
+	if err != nil {
+		t.Fatalf("init database: %v", err)
+	}
+	t.Cleanup(func() { client.Close() })
+
+	cfgMgr := config.NewManager(client, "")
+	return cfgMgr, NewManager(cfgMgr, Options{})
+}
+
+// addTestTunnel saves a tunnel and returns its ID
+func addTestTunnel(t *testing.T, cfgMgr *config.Manager, tunnel config.TunnelConfig) string {
+	t.Helper()
+	if err := cfgMgr.AddTunnel(&tunnel); err != nil {
+		t.Fatalf("add tunnel: %v", err)
+	}
+	return tunnel.ID
+}
+
+func TestGetStatusUnknownTunnel(t *testing.T) {
+	_, svcMgr := newTestManager(t)
+
+	for _, id := range []string{uuid.NewString(), "not-a-uuid"} {
+		state, err := svcMgr.GetStatus(id)
+		if !errors.Is(err, config.ErrTunnelNotFound) {
+			t.Errorf("GetStatus(%q) = %+v, %v, want ErrTunnelNotFound", id, state, err)
+		}
+	}
+}
+
+func TestGetStatusStoppedTunnel(t *testing.T) {
+	cfgMgr, svcMgr := newTestManager(t)
+	id := addTestTunnel(t, cfgMgr, config.TunnelConfig{
+		Name:   "stopped",
+		Type:   config.TunnelTypeCloudflare,
+		Target: "http://localhost:3000",
+	})
+
+	state, err := svcMgr.GetStatus(id)
+	if err != nil {
+		t.Fatalf("GetStatus: %v", err)
+	}
+	if state.ID != id || state.Status != "stopped" {
+		t.Errorf("GetStatus = %+v, want stopped tunnel %s", state, id)
+	}
+
+	// The default DELETE archives, which must read as deleted
+	if err := cfgMgr.ArchiveTunnel(id); err != nil {
+		t.Fatalf("archive: %v", err)
+	}
+	if state, err := svcMgr.GetStatus(id); !errors.Is(err, config.ErrTunnelNotFound) {
+		t.Errorf("GetStatus of archived tunnel = %+v, %v, want ErrTunnelNotFound", state, err)
+	}
+}
+
+// A pause is saved with the tunnel, so a new manager, as after a restart,
+// still reports the tunnel paused, leaves it out of auto start and can
+// resume it
+func TestPauseSurvivesRestart(t *testing.T) {
+	cfgMgr, svcMgr := newTestManager(t)
+	id := addTestTunnel(t, cfgMgr, config.TunnelConfig{
+		Name:    "paused",
+		Type:    config.TunnelTypeCloudflare,
+		Target:  "http://localhost:8080",
+		Enabled: true,
+	})
+	svcMgr.mu.Lock()
+	svcMgr.tunnels[id] = &TunnelState{ID: id, Status: "running", service: &NgrokService{status: "running"}}
+	svcMgr.mu.Unlock()
+	if err := svcMgr.Pause(id); err != nil {
+		t.Fatalf("pause: %v", err)
+	}
+
+	restarted := NewManager(cfgMgr, Options{})
+	if state, err := restarted.GetStatus(id); err != nil || state.Status != "paused" {
+		t.Errorf("status after restart = %+v, %v, want paused", state, err)
+	}
+	if state := restarted.GetAllStatuses()[id]; state == nil || state.Status != "paused" {
+		t.Errorf("GetAllStatuses after restart = %+v, want paused", state)
+	}
+	plan, err := restarted.AutoStartPlan()
+	if err != nil {
+		t.Fatalf("auto start plan: %v", err)
+	}
+	if len(plan) != 0 {
+		t.Errorf("auto start plan = %v, want no tunnels", plan)
+	}
+
+	if err := restarted.Stop(id); err != nil {
+		t.Fatalf("stop: %v", err)
+	}
+	if tunnelCfg, err := cfgMgr.GetTunnel(id); err != nil || tunnelCfg.Paused {
+		t.Errorf("tunnel still paused after stop: %v", err)
+	}
+	if err := restarted.Resume(id); err == nil {
+		t.Error("resumed a stopped tunnel")
+	}
+}
+
+// failingService is a service that failed after it was running
+type failingService struct {
+	NgrokService
+	failedAt time.Time
+}
+
+func (s *failingService) FailedSince() time.Time { return s.failedAt }
+
+// The error grace period counts from when the tunnel failed, so an error
+// older than the grace period is reported on the first status query
+func TestErrorGraceFromFailure(t *testing.T) {
+	tests := []struct {
+		name     string
+		failedAt time.Time
+		want     string
+	}{
+		{"failed during start", time.Time{}, "error"},
+		{"failed within grace", time.Now().Add(-time.Second), "running"},
+		{"failed before grace", time.Now().Add(-time.Minute), "error"},
+	}
+	for _, tt := range tests {
+		service := &failingService{failedAt: tt.failedAt}
+		service.status = "error"
+		service.lastError = "connection lost"
+		state := &TunnelState{ID: "t", Status: "running", grace: 30 * time.Second, service: service}
+
+		got := state.snapshot()
+		if got.Status != tt.want {
+			t.Errorf("%s: status = %q, want %q", tt.name, got.Status, tt.want)
+		}
+		if wantErr := tt.want == "error"; (got.Error != "") != wantErr {
+			t.Errorf("%s: error = %q, want reported %v", tt.name, got.Error, wantErr)
+		}
+	}
+}
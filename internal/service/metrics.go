@@ -0,0 +1,103 @@
+package service
+
+import (
+	"pont/internal/logger"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	tunnelLabels = []string{"id", "name", "type"}
+
+	tunnelUpDesc = prometheus.NewDesc(
+		"pont_tunnel_up",
+		"Whether the tunnel is running (1) or not (0).",
+		tunnelLabels, nil,
+	)
+	tunnelStatusDesc = prometheus.NewDesc(
+		"pont_tunnel_status",
+		"Current tunnel status, 1 for the status the tunnel is in.",
+		append(tunnelLabels, "status"), nil,
+	)
+	tunnelStartedDesc = prometheus.NewDesc(
+		"pont_tunnel_started_timestamp_seconds",
+		"Unix time the tunnel was last started.",
+		tunnelLabels, nil,
+	)
+	tunnelInfoDesc = prometheus.NewDesc(
+		"pont_tunnel_info",
+		"Tunnel metadata, always 1.",
+		append(tunnelLabels, "target", "public_url"), nil,
+	)
+	tunnelConcurrencyDesc = prometheus.NewDesc(
+		"pont_tunnel_concurrency",
+		"Connections (ngrok) or requests (cloudflare with an error page) in flight to the target.",
+		tunnelLabels, nil,
+	)
+	tunnelConcurrencyPeakDesc = prometheus.NewDesc(
+		"pont_tunnel_concurrency_peak",
+		"Highest pont_tunnel_concurrency since the tunnel was last started.",
+		tunnelLabels, nil,
+	)
+)
+
+// metricsCollector exports per-tunnel metrics. Series are built from the
+// current configuration on every scrape, so renamed or deleted tunnels never
+// leave stale label sets behind.
+type metricsCollector struct {
+	m *Manager
+}
+
+// NewMetricsCollector returns a Prometheus collector for the manager's tunnels
+func NewMetricsCollector(m *Manager) prometheus.Collector {
+	return &metricsCollector{m: m}
+}
+
+func (c *metricsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- tunnelUpDesc
+	ch <- tunnelStatusDesc
+	ch <- tunnelStartedDesc
+	ch <- tunnelInfoDesc
+	ch <- tunnelConcurrencyDesc
+	ch <- tunnelConcurrencyPeakDesc
+}
+
+func (c *metricsCollector) Collect(ch chan<- prometheus.Metric) {
+	tunnels, err := c.m.cfgMgr.GetAllTunnels()
+	if err != nil {
+		logger.Sugar.Warnf("Metrics: failed to load tunnels: %v", err)
+		return
+	}
+	statuses := c.m.GetAllStatuses()
+
+	for _, t := range tunnels {
+		labels := []string{t.ID, t.Name, string(t.Type)}
+
+		status := "stopped"
+		var publicURL string
+		if state, ok := statuses[t.ID]; ok {
+			status = state.Status
+			publicURL = state.PublicURL
+			if !state.StartedAt.IsZero() {
+				ch <- prometheus.MustNewConstMetric(tunnelStartedDesc, prometheus.GaugeValue,
+					float64(state.StartedAt.Unix()), labels...)
+			}
+			if c := state.Concurrency; c != nil {
+				ch <- prometheus.MustNewConstMetric(tunnelConcurrencyDesc, prometheus.GaugeValue,
+					float64(c.Current), labels...)
+				ch <- prometheus.MustNewConstMetric(tunnelConcurrencyPeakDesc, prometheus.GaugeValue,
+					float64(c.Peak), labels...)
+			}
+		}
+
+		up := 0.0
+		if status == "running" {
+			up = 1
+		}
+		ch <- prometheus.MustNewConstMetric(tunnelUpDesc, prometheus.GaugeValue, up, labels...)
+		ch <- prometheus.MustNewConstMetric(tunnelStatusDesc, prometheus.GaugeValue, 1,
+			append(labels, status)...)
+		ch <- prometheus.MustNewConstMetric(tunnelInfoDesc, prometheus.GaugeValue, 1,
+			append(labels, t.Target, publicURL)...)
+	}
+}
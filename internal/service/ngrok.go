@@ -1,52 +1,106 @@
 package service
 
 import (
+	"cmp"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net"
 	"pont/internal/config"
 	"pont/internal/logger"
 	"strings"
+	"sync"
 	"time"
 
 	"golang.ngrok.com/ngrok/v2"
 )
 
+// newNgrokAgent creates the agent of each start
+var newNgrokAgent = ngrok.NewAgent
+
+// ngrokConnectTimeout is how long a start waits for the endpoint
+var ngrokConnectTimeout = 30 * time.Second
+
+// forwardResult is the outcome of an agent.Forward call made in the background
+type forwardResult struct {
+	forwarder ngrok.EndpointForwarder
+	err       error
+}
+
 // NgrokService implements ngrok tunnel
 type NgrokService struct {
-	config    *config.TunnelConfig
-	agent     ngrok.Agent
+	config *config.TunnelConfig
+	agent  ngrok.Agent
+	ctx    context.Context
+
+	// mu guards the fields below; Stop and the getters run while a start
+	// is still connecting
+	mu        sync.RWMutex
 	forwarder ngrok.EndpointForwarder
 	publicURL string
 	status    string
 	lastError string
-	ctx       context.Context
 	cancel    context.CancelFunc
+
+	// internalURL is set instead of publicURL for internal endpoints
+	internalURL string
+
+	// authtoken is the tunnel's authtoken or the default one
+	authtoken string
+
+	// credential resolves the tunnel's named credential, if it has one
+	credential func(name string) (string, error)
+
+	// activity is touched by upstream connections, for idle_timeout;
+	// upstreams counts the open ones
+	activity  activityClock
+	upstreams concurrencyGauge
 }
 
-// NewNgrokService creates a new ngrok tunnel service
-func NewNgrokService(cfg *config.TunnelConfig) *NgrokService {
+// NewNgrokService creates a new ngrok tunnel service. defaultAuthtoken is
+// used when the tunnel has neither an authtoken nor a credential of its own;
+// credential resolves a credential name to its authtoken.
+func NewNgrokService(cfg *config.TunnelConfig, defaultAuthtoken string, credential func(name string) (string, error)) *NgrokService {
 	return &NgrokService{
-		config: cfg,
-		status: "stopped",
+		config:     cfg,
+		status:     "stopped",
+		authtoken:  cmp.Or(cfg.NgrokAuthtoken, defaultAuthtoken),
+		credential: credential,
 	}
 }
 
 // Start starts the ngrok tunnel
 func (ns *NgrokService) Start(ctx context.Context) error {
+	ns.mu.Lock()
 	ns.ctx, ns.cancel = context.WithCancel(ctx)
+	ns.status = "starting"
+	ns.mu.Unlock()
+	ns.activity.touch()
 
-	// Create agent with authtoken
-	var agentOpts []ngrok.AgentOption
-	if ns.config.NgrokAuthtoken != "" {
-		agentOpts = append(agentOpts, ngrok.WithAuthtoken(ns.config.NgrokAuthtoken))
+	// A named credential is resolved on every start, so a rotated token is
+	// picked up by the next start of each tunnel using it
+	if name := ns.config.NgrokCredential; name != "" && ns.credential != nil {
+		token, err := ns.credential(name)
+		if err != nil {
+			ns.fail(err.Error())
+			return err
+		}
+		ns.authtoken = token
 	}
 
-	agent, err := ngrok.NewAgent(agentOpts...)
+	// Without an authtoken ngrok only fails after the connection timeout
+	if ns.authtoken == "" {
+		errMsg := "ngrok authtoken required: set ngrok_authtoken on the tunnel or NGROK_AUTHTOKEN for all ngrok tunnels (get one at https://dashboard.ngrok.com/get-started/your-authtoken)"
+		ns.fail(errMsg)
+		return fmt.Errorf("%s", errMsg)
+	}
+
+	// Create agent with authtoken
+	agent, err := newNgrokAgent(ngrok.WithAuthtoken(ns.authtoken))
 	if err != nil {
 		errMsg := fmt.Sprintf("Failed to create agent: %v", err)
-		ns.lastError = errMsg
-		ns.status = "error"
+		ns.fail(errMsg)
 		return fmt.Errorf("%s", errMsg)
 	}
 	ns.agent = agent
@@ -63,165 +117,312 @@ func (ns *NgrokService) Start(ctx context.Context) error {
 	return ns.startHTTP()
 }
 
+// upstreamDialer records traffic to the upstream; it dials like ngrok's
+// default dialer
+func (ns *NgrokService) upstreamDialer() ngrok.UpstreamOption {
+	return ngrok.WithUpstreamDialer(&activityDialer{
+		clock:  &ns.activity,
+		open:   &ns.upstreams,
+		dialer: net.Dialer{Timeout: 3 * time.Second},
+	})
+}
+
+// LastActivity returns when data last went to or came from the upstream, or
+// the start time if nothing has yet
+func (ns *NgrokService) LastActivity() time.Time {
+	return ns.activity.time()
+}
+
+// Concurrency returns the open upstream connections
+func (ns *NgrokService) Concurrency() *Concurrency {
+	return ns.upstreams.snapshot()
+}
+
 func (ns *NgrokService) startHTTP() error {
 	// Build endpoint options
 	var opts []ngrok.EndpointOption
 	if ns.config.NgrokDomain != "" {
 		opts = append(opts, ngrok.WithURL(ns.config.NgrokDomain))
 	}
+	if policy := ns.trafficPolicy(); policy != "" {
+		opts = append(opts, ngrok.WithTrafficPolicy(policy))
+	}
+	if ns.config.NgrokInternal {
+		opts = append(opts, ngrok.WithBindings("internal"))
+	}
 
-	logger.Sugar.Infof("Connecting to ngrok...")
+	logger.ForTunnel(ns.config.ID).Infof("Connecting to ngrok...")
 
 	// Create a channel to receive the result
-	type result struct {
-		forwarder ngrok.EndpointForwarder
-		err       error
-	}
-	resultCh := make(chan result, 1)
+	resultCh := make(chan forwardResult, 1)
 
 	// Start connection in a goroutine with timeout
 	go func() {
-		forwarder, err := ns.agent.Forward(ns.ctx, ngrok.WithUpstream(ns.config.Target), opts...)
-		resultCh <- result{forwarder: forwarder, err: err}
+		forwarder, err := ns.agent.Forward(ns.ctx, ngrok.WithUpstream(ns.config.Target, ns.upstreamDialer()), opts...)
+		resultCh <- forwardResult{forwarder: forwarder, err: err}
 	}()
 
 	// Wait for result or timeout
 	select {
 	case res := <-resultCh:
+		if res.err != nil && ns.ctx.Err() != nil {
+			ns.setStatus("stopped")
+			return ns.ctx.Err()
+		}
 		if res.err != nil {
-			errMsg := fmt.Sprintf("Failed to start tunnel: %v", res.err)
-			// Check if it's ngrok error with code
-			var ngrokErr ngrok.Error
-			if errors.As(res.err, &ngrokErr) && ngrokErr.Code() == "ERR_NGROK_108" {
-				errMsg = "Free ngrok accounts can only run one tunnel at a time. Please stop other tunnels first."
-			}
-			ns.lastError = errMsg
-			ns.status = "error"
-			logger.Sugar.Errorf("Ngrok connection failed: %v", res.err)
+			errMsg := ngrokErrorMessage(res.err, fmt.Sprintf("Failed to start tunnel: %v", res.err))
+			ns.fail(errMsg)
+			logger.ForTunnel(ns.config.ID).Errorf("Ngrok connection failed: %v", res.err)
 			return fmt.Errorf("%s", errMsg)
 		}
+		url := res.forwarder.URL().String()
+		ns.mu.Lock()
 		ns.forwarder = res.forwarder
-		ns.publicURL = res.forwarder.URL().String()
+		if ns.config.NgrokInternal {
+			ns.internalURL = url
+		} else {
+			ns.publicURL = url
+		}
 		ns.status = "running"
-		logger.Sugar.Infof("Ngrok tunnel created: %s -> %s", ns.publicURL, ns.config.Target)
-	case <-time.After(30 * time.Second):
+		ns.mu.Unlock()
+		if ns.config.NgrokInternal {
+			logger.ForTunnel(ns.config.ID).Infof("Ngrok internal endpoint created: %s -> %s", url, ns.config.Target)
+		} else {
+			logger.ForTunnel(ns.config.ID).Infof("Ngrok tunnel created: %s -> %s", url, ns.config.Target)
+		}
+	case <-time.After(ngrokConnectTimeout):
 		errMsg := "Ngrok connection timeout. Possible causes: 1) Network issue 2) Invalid authtoken 3) Free account limit: only 1 endpoint allowed, please stop other tunnels first"
-		ns.lastError = errMsg
-		ns.status = "error"
-		logger.Sugar.Error(errMsg)
+		ns.fail(errMsg)
+		logger.ForTunnel(ns.config.ID).Error(errMsg)
 		if ns.cancel != nil {
 			ns.cancel()
 		}
+		go closeLateForwarder(ns.config.ID, resultCh)
 		return fmt.Errorf("%s", errMsg)
+	case <-ns.ctx.Done():
+		return ns.abortConnect(resultCh)
 	}
 
 	return nil
 }
 
 func (ns *NgrokService) startTCP(target string) error {
-	logger.Sugar.Infof("Connecting to ngrok (TCP)...")
+	logger.ForTunnel(ns.config.ID).Infof("Connecting to ngrok (TCP)...")
 
 	// Create a channel to receive the result
-	type result struct {
-		forwarder ngrok.EndpointForwarder
-		err       error
-	}
-	resultCh := make(chan result, 1)
+	resultCh := make(chan forwardResult, 1)
 
 	// Start connection in a goroutine with timeout
 	go func() {
-		forwarder, err := ns.agent.Forward(ns.ctx, ngrok.WithUpstream("tcp://"+target), ngrok.WithURL("tcp://"))
-		resultCh <- result{forwarder: forwarder, err: err}
+		forwarder, err := ns.agent.Forward(ns.ctx, ngrok.WithUpstream("tcp://"+target, ns.upstreamDialer()), ngrok.WithURL("tcp://"))
+		resultCh <- forwardResult{forwarder: forwarder, err: err}
 	}()
 
 	// Wait for result or timeout
 	select {
 	case res := <-resultCh:
+		if res.err != nil && ns.ctx.Err() != nil {
+			ns.setStatus("stopped")
+			return ns.ctx.Err()
+		}
 		if res.err != nil {
-			errMsg := fmt.Sprintf("Failed to start TCP tunnel: %v", res.err)
-			var ngrokErr ngrok.Error
-			if errors.As(res.err, &ngrokErr) && ngrokErr.Code() == "ERR_NGROK_108" {
-				errMsg = "Free ngrok accounts can only run one tunnel at a time. Please stop other tunnels first."
-			}
-			ns.lastError = errMsg
-			ns.status = "error"
-			logger.Sugar.Errorf("Ngrok TCP connection failed: %v", res.err)
+			errMsg := ngrokErrorMessage(res.err, fmt.Sprintf("Failed to start TCP tunnel: %v", res.err))
+			ns.fail(errMsg)
+			logger.ForTunnel(ns.config.ID).Errorf("Ngrok TCP connection failed: %v", res.err)
 			return fmt.Errorf("%s", errMsg)
 		}
+		url := res.forwarder.URL().String()
+		ns.mu.Lock()
 		ns.forwarder = res.forwarder
-		ns.publicURL = res.forwarder.URL().String()
+		ns.publicURL = url
 		ns.status = "running"
-		logger.Sugar.Infof("Ngrok TCP tunnel created: %s -> %s", ns.publicURL, target)
-	case <-time.After(30 * time.Second):
+		ns.mu.Unlock()
+		logger.ForTunnel(ns.config.ID).Infof("Ngrok TCP tunnel created: %s -> %s", url, target)
+	case <-time.After(ngrokConnectTimeout):
 		errMsg := "Ngrok TCP connection timeout. Possible causes: 1) Network issue 2) Invalid authtoken 3) Free account limit: only 1 endpoint allowed, please stop other tunnels first"
-		ns.lastError = errMsg
-		ns.status = "error"
-		logger.Sugar.Error(errMsg)
+		ns.fail(errMsg)
+		logger.ForTunnel(ns.config.ID).Error(errMsg)
 		if ns.cancel != nil {
 			ns.cancel()
 		}
+		go closeLateForwarder(ns.config.ID, resultCh)
 		return fmt.Errorf("%s", errMsg)
+	case <-ns.ctx.Done():
+		return ns.abortConnect(resultCh)
 	}
 
 	return nil
 }
 
 func (ns *NgrokService) startTLS(target string) error {
-	logger.Sugar.Infof("Connecting to ngrok (TLS)...")
+	logger.ForTunnel(ns.config.ID).Infof("Connecting to ngrok (TLS)...")
 
-	type result struct {
-		forwarder ngrok.EndpointForwarder
-		err       error
-	}
-	resultCh := make(chan result, 1)
+	resultCh := make(chan forwardResult, 1)
 
 	go func() {
-		forwarder, err := ns.agent.Forward(ns.ctx, ngrok.WithUpstream("tls://"+target), ngrok.WithURL("tls://"))
-		resultCh <- result{forwarder: forwarder, err: err}
+		forwarder, err := ns.agent.Forward(ns.ctx, ngrok.WithUpstream("tls://"+target, ns.upstreamDialer()), ngrok.WithURL("tls://"))
+		resultCh <- forwardResult{forwarder: forwarder, err: err}
 	}()
 
 	select {
 	case res := <-resultCh:
+		if res.err != nil && ns.ctx.Err() != nil {
+			ns.setStatus("stopped")
+			return ns.ctx.Err()
+		}
 		if res.err != nil {
-			errMsg := fmt.Sprintf("Failed to start TLS tunnel: %v", res.err)
-			var ngrokErr ngrok.Error
-			if errors.As(res.err, &ngrokErr) && ngrokErr.Code() == "ERR_NGROK_108" {
-				errMsg = "Free ngrok accounts can only run one tunnel at a time. Please stop other tunnels first."
-			}
-			ns.lastError = errMsg
-			ns.status = "error"
-			logger.Sugar.Errorf("Ngrok TLS connection failed: %v", res.err)
+			errMsg := ngrokErrorMessage(res.err, fmt.Sprintf("Failed to start TLS tunnel: %v", res.err))
+			ns.fail(errMsg)
+			logger.ForTunnel(ns.config.ID).Errorf("Ngrok TLS connection failed: %v", res.err)
 			return fmt.Errorf("%s", errMsg)
 		}
+		url := res.forwarder.URL().String()
+		ns.mu.Lock()
 		ns.forwarder = res.forwarder
-		ns.publicURL = res.forwarder.URL().String()
+		ns.publicURL = url
 		ns.status = "running"
-		logger.Sugar.Infof("Ngrok TLS tunnel created: %s -> %s", ns.publicURL, target)
-	case <-time.After(30 * time.Second):
+		ns.mu.Unlock()
+		logger.ForTunnel(ns.config.ID).Infof("Ngrok TLS tunnel created: %s -> %s", url, target)
+	case <-time.After(ngrokConnectTimeout):
 		errMsg := "Ngrok TLS connection timeout. Possible causes: 1) Network issue 2) Invalid authtoken 3) Free account limit: only 1 endpoint allowed, please stop other tunnels first"
-		ns.lastError = errMsg
-		ns.status = "error"
-		logger.Sugar.Error(errMsg)
+		ns.fail(errMsg)
+		logger.ForTunnel(ns.config.ID).Error(errMsg)
 		if ns.cancel != nil {
 			ns.cancel()
 		}
+		go closeLateForwarder(ns.config.ID, resultCh)
 		return fmt.Errorf("%s", errMsg)
+	case <-ns.ctx.Done():
+		return ns.abortConnect(resultCh)
 	}
 
 	return nil
 }
 
+// ngrokErrorMessages maps ngrok error codes to actionable messages
+var ngrokErrorMessages = map[string]string{
+	"ERR_NGROK_105":  "The ngrok authtoken is malformed. Copy it again from the ngrok dashboard.",
+	"ERR_NGROK_107":  "The ngrok authtoken is invalid or has been revoked. Generate a new one in the ngrok dashboard.",
+	"ERR_NGROK_4018": "ngrok requires a verified account and authtoken. Set the tunnel's authtoken.",
+	"ERR_NGROK_108":  "Free ngrok accounts can only run one tunnel at a time. Please stop other tunnels first.",
+	"ERR_NGROK_324":  "The ngrok account's endpoint limit is reached. Stop other endpoints or upgrade the plan.",
+	"ERR_NGROK_334":  "The ngrok domain is already in use by another agent session. Stop the other session or use a different domain.",
+}
+
+// ngrokErrorMessage returns a specific message for known ngrok error codes, fallback otherwise
+func ngrokErrorMessage(err error, fallback string) string {
+	var ngrokErr ngrok.Error
+	if errors.As(err, &ngrokErr) {
+		if msg, ok := ngrokErrorMessages[ngrokErr.Code()]; ok {
+			return msg + " (" + ngrokErr.Code() + ")"
+		}
+	}
+	return fallback
+}
+
+// fail records errMsg as the reason the tunnel is down
+func (ns *NgrokService) fail(errMsg string) {
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
+	ns.lastError = errMsg
+	ns.status = "error"
+}
+
+// setStatus sets the status without touching the last error
+func (ns *NgrokService) setStatus(status string) {
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
+	ns.status = status
+}
+
+// abortConnect gives up on a connect that was cancelled by Stop
+func (ns *NgrokService) abortConnect(resultCh <-chan forwardResult) error {
+	ns.setStatus("stopped")
+	logger.ForTunnel(ns.config.ID).Infof("Ngrok connection cancelled")
+	go closeLateForwarder(ns.config.ID, resultCh)
+	return ns.ctx.Err()
+}
+
+// closeLateForwarder waits for a Forward call that outlived its timeout and
+// closes the endpoint if it was created anyway, so it does not keep counting
+// against the account's endpoint limit.
+func closeLateForwarder(id string, resultCh <-chan forwardResult) {
+	res := <-resultCh
+	if res.err != nil || res.forwarder == nil {
+		return
+	}
+	logger.ForTunnel(id).Warnf("Closing ngrok endpoint %s that came up after the connection timeout", res.forwarder.URL())
+	if err := res.forwarder.Close(); err != nil {
+		logger.ForTunnel(id).Warnf("Failed to close late ngrok endpoint: %v", err)
+	}
+}
+
+// trafficPolicy builds the ngrok traffic policy for the endpoint, empty when none is needed
+func (ns *NgrokService) trafficPolicy() string {
+	var actions []interface{}
+
+	// Rate limit first, so rejected requests are not verified
+	if ns.config.NgrokRateLimit != "" {
+		requests, window, err := config.ParseRateLimit(ns.config.NgrokRateLimit)
+		if err != nil {
+			logger.ForTunnel(ns.config.ID).Errorf("Ignoring ngrok rate limit: %v", err)
+		} else {
+			actions = append(actions, map[string]interface{}{
+				"type": "rate-limit",
+				"config": map[string]interface{}{
+					"name":       "pont",
+					"algorithm":  "sliding_window",
+					"capacity":   requests,
+					"rate":       fmt.Sprintf("%ds", int(window.Seconds())),
+					"bucket_key": []string{"conn.client_ip"},
+				},
+			})
+		}
+	}
+
+	if ns.config.NgrokWebhookProvider != "" {
+		actions = append(actions, map[string]interface{}{
+			"type": "verify-webhook",
+			"config": map[string]string{
+				"provider": ns.config.NgrokWebhookProvider,
+				"secret":   ns.config.NgrokWebhookSecret,
+			},
+		})
+	}
+
+	if len(actions) == 0 {
+		return ""
+	}
+	policy := map[string]interface{}{
+		"on_http_request": []interface{}{
+			map[string]interface{}{"actions": actions},
+		},
+	}
+
+	// Traffic policies accept JSON as well as YAML
+	data, err := json.Marshal(policy)
+	if err != nil {
+		logger.ForTunnel(ns.config.ID).Errorf("Failed to build ngrok traffic policy: %v", err)
+		return ""
+	}
+	return string(data)
+}
+
 // Stop stops the ngrok tunnel
 func (ns *NgrokService) Stop() error {
+	ns.mu.Lock()
 	if ns.cancel != nil {
 		ns.cancel()
 	}
 
 	ns.status = "stopped"
 	ns.publicURL = ""
+	ns.internalURL = ""
+	forwarder := ns.forwarder
+	ns.mu.Unlock()
 
-	if ns.forwarder != nil {
-		ns.forwarder.Close()
+	if forwarder != nil {
+		forwarder.Close()
 	}
 
 	return nil
@@ -229,15 +430,28 @@ func (ns *NgrokService) Stop() error {
 
 // GetPublicURL returns the public URL
 func (ns *NgrokService) GetPublicURL() string {
+	ns.mu.RLock()
+	defer ns.mu.RUnlock()
 	return ns.publicURL
 }
 
+// GetInternalURL returns the URL of an internal endpoint, empty for public ones
+func (ns *NgrokService) GetInternalURL() string {
+	ns.mu.RLock()
+	defer ns.mu.RUnlock()
+	return ns.internalURL
+}
+
 // GetStatus returns the current status
 func (ns *NgrokService) GetStatus() string {
+	ns.mu.RLock()
+	defer ns.mu.RUnlock()
 	return ns.status
 }
 
 // GetError returns the last error message
 func (ns *NgrokService) GetError() string {
+	ns.mu.RLock()
+	defer ns.mu.RUnlock()
 	return ns.lastError
 }
@@ -0,0 +1,189 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"golang.ngrok.com/ngrok/v2"
+
+	"pont/internal/config"
+)
+
+// blackholeNgrok accepts connections and never answers, like an ngrok
+// server that cannot be reached; agents are pointed at it
+func blackholeNgrok(t *testing.T) {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	var (
+		mu    sync.Mutex
+		conns []net.Conn
+	)
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			mu.Lock()
+			conns = append(conns, conn)
+			mu.Unlock()
+		}
+	}()
+	t.Cleanup(func() {
+		ln.Close()
+		mu.Lock()
+		defer mu.Unlock()
+		for _, c := range conns {
+			c.Close()
+		}
+	})
+
+	orig := newNgrokAgent
+	newNgrokAgent = func(opts ...ngrok.AgentOption) (ngrok.Agent, error) {
+		return ngrok.NewAgent(append(opts, ngrok.WithAgentConnectURL(ln.Addr().String()))...)
+	}
+	t.Cleanup(func() { newNgrokAgent = orig })
+}
+
+// Stopping a tunnel that is still connecting must not wait for the connect
+// timeout
+func TestStopWhileConnecting(t *testing.T) {
+	blackholeNgrok(t)
+	cfgMgr, svcMgr := newTestManager(t)
+	id := addTestTunnel(t, cfgMgr, config.TunnelConfig{
+		Name:           "connecting",
+		Type:           config.TunnelTypeNgrok,
+		Target:         "http://127.0.0.1:1",
+		NgrokAuthtoken: "test-token",
+	})
+
+	if err := svcMgr.Start(id); err != nil {
+		t.Fatalf("start: %v", err)
+	}
+	// Give the agent time to reach the black hole
+	time.Sleep(200 * time.Millisecond)
+	if state, err := svcMgr.GetStatus(id); err != nil || state.Status != "starting" {
+		t.Fatalf("status before stop = %+v, %v, want starting", state, err)
+	}
+
+	start := time.Now()
+	if err := svcMgr.Stop(id); err != nil {
+		t.Fatalf("stop: %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	if err := svcMgr.Shutdown(ctx); err != nil {
+		t.Fatalf("tunnel goroutines still running after stop: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("stop took %s", elapsed)
+	}
+	if state, err := svcMgr.GetStatus(id); err != nil || state.Status != "stopped" {
+		t.Errorf("status after stop = %+v, %v, want stopped", state, err)
+	}
+}
+
+// lateAgent is an agent whose Forward only returns once release is closed,
+// then with forwarder
+type lateAgent struct {
+	ngrok.Agent
+	release   chan struct{}
+	forwarder *lateForwarder
+}
+
+func (a *lateAgent) Forward(context.Context, *ngrok.Upstream, ...ngrok.EndpointOption) (ngrok.EndpointForwarder, error) {
+	<-a.release
+	return a.forwarder, nil
+}
+
+// lateForwarder records whether it was closed
+type lateForwarder struct {
+	ngrok.EndpointForwarder
+	closed chan struct{}
+}
+
+func (f *lateForwarder) URL() *url.URL {
+	return &url.URL{Scheme: "https", Host: "late.ngrok.app"}
+}
+
+func (f *lateForwarder) Close() error {
+	close(f.closed)
+	return nil
+}
+
+// useLateAgent makes the next agents a lateAgent and returns it
+func useLateAgent(t *testing.T) *lateAgent {
+	t.Helper()
+	agent := &lateAgent{
+		release:   make(chan struct{}),
+		forwarder: &lateForwarder{closed: make(chan struct{})},
+	}
+	orig := newNgrokAgent
+	newNgrokAgent = func(...ngrok.AgentOption) (ngrok.Agent, error) { return agent, nil }
+	t.Cleanup(func() { newNgrokAgent = orig })
+	return agent
+}
+
+// An endpoint that comes up after its start was given up on must be closed,
+// or it keeps counting against the account's endpoint limit
+func TestLateForwarderClosed(t *testing.T) {
+	tests := []struct {
+		name   string
+		target string
+		// giveUp makes the start give up on the connect
+		giveUp func(ns *NgrokService)
+	}{
+		{name: "stopped http", target: "http://127.0.0.1:1", giveUp: func(ns *NgrokService) { ns.Stop() }},
+		{name: "stopped tcp", target: "tcp://127.0.0.1:1", giveUp: func(ns *NgrokService) { ns.Stop() }},
+		{name: "timeout http", target: "http://127.0.0.1:1"},
+		{name: "timeout tls", target: "tls://127.0.0.1:1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			agent := useLateAgent(t)
+			if tt.giveUp == nil {
+				orig := ngrokConnectTimeout
+				ngrokConnectTimeout = 50 * time.Millisecond
+				t.Cleanup(func() { ngrokConnectTimeout = orig })
+			}
+
+			ns := NewNgrokService(&config.TunnelConfig{ID: "late", Name: "late", Type: config.TunnelTypeNgrok, Target: tt.target}, "test-token", nil)
+			started := make(chan error, 1)
+			go func() { started <- ns.Start(context.Background()) }()
+			if tt.giveUp != nil {
+				time.Sleep(50 * time.Millisecond)
+				tt.giveUp(ns)
+			}
+
+			select {
+			case err := <-started:
+				if err == nil {
+					t.Fatal("start succeeded before the endpoint came up")
+				}
+				if tt.giveUp != nil && !errors.Is(err, context.Canceled) {
+					t.Errorf("start error = %v, want context.Canceled", err)
+				}
+			case <-time.After(2 * time.Second):
+				t.Fatal("start did not give up")
+			}
+
+			close(agent.release)
+			select {
+			case <-agent.forwarder.closed:
+			case <-time.After(2 * time.Second):
+				t.Fatal("late endpoint was not closed")
+			}
+			if got := ns.GetPublicURL(); got != "" {
+				t.Errorf("public URL = %q, want none", got)
+			}
+		})
+	}
+}
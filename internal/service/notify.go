@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"pont/internal/logger"
+	"pont/internal/notify"
+)
+
+const (
+	// notifyInterval is how often tunnel statuses are compared for
+	// webhook notifications
+	notifyInterval = 2 * time.Second
+
+	// notifyQueue is how many notifications may wait for delivery before
+	// new ones are dropped
+	notifyQueue = 64
+)
+
+// NotifyLoop posts a tunnel.status_changed event to the webhook_url setting
+// whenever a tunnel's status changes, until ctx is done. Statuses are
+// compared every notifyInterval, so a change that reverts within one
+// interval is not reported. Changes to "starting" are skipped since the
+// outcome follows shortly.
+func (m *Manager) NotifyLoop(ctx context.Context) {
+	queue := make(chan notify.Event, notifyQueue)
+	sent := make(chan struct{})
+	go func() {
+		defer close(sent)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case ev := <-queue:
+				url := m.webhookURL()
+				if url == "" {
+					continue
+				}
+				res := notify.Send(ctx, url, ev)
+				if !res.Delivered && ctx.Err() == nil {
+					logger.ForTunnel(ev.TunnelID).Warnf("Notification for tunnel %s failed: %s", ev.TunnelName, res.Error)
+				}
+			}
+		}
+	}()
+	defer func() { <-sent }()
+
+	last := make(map[string]string)
+	ticker := time.NewTicker(notifyInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		statuses := m.GetAllStatuses()
+		for id := range last {
+			if _, ok := statuses[id]; !ok {
+				delete(last, id)
+			}
+		}
+		for id, state := range statuses {
+			previous, ok := last[id]
+			if !ok {
+				previous = "stopped"
+			}
+			if state.Status == previous {
+				continue
+			}
+			last[id] = state.Status
+			if state.Status == "starting" || m.webhookURL() == "" {
+				continue
+			}
+
+			name := id
+			if cfg, err := m.cfgMgr.GetTunnel(id); err == nil {
+				name = cfg.Name
+			}
+			ev := notify.StatusEvent(id, name, state.Status, previous, state.PublicURL, state.Error)
+			select {
+			case queue <- ev:
+			default:
+				logger.ForTunnel(id).Warnf("Notification queue full, dropping status change of tunnel %s to %s", name, state.Status)
+			}
+		}
+	}
+}
+
+// webhookURL is the webhook_url setting, empty when notifications are off
+func (m *Manager) webhookURL() string {
+	settings, err := m.cfgMgr.GetSettings()
+	if err != nil {
+		return ""
+	}
+	return settings.WebhookURL
+}
@@ -0,0 +1,135 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"pont/internal/config"
+)
+
+// Reachability is the result of the last request to a tunnel's public URL.
+// It tells whether traffic gets through the tunnel to the upstream service,
+// which a running status alone does not.
+type Reachability struct {
+	Reachable  bool      `json:"reachable"`
+	StatusCode int       `json:"status_code,omitempty"`
+	Error      string    `json:"error,omitempty"`
+	CheckedAt  time.Time `json:"checked_at"`
+
+	// Attempts is how many requests the probe took, see probe_retries
+	Attempts int `json:"attempts,omitempty"`
+}
+
+const (
+	// probeURLWait is how soon to look again for a public URL that cloudflared
+	// has not reported yet
+	probeURLWait = 5 * time.Second
+
+	// probeRetryDelay is the pause between attempts of one probe
+	probeRetryDelay = 2 * time.Second
+)
+
+// probeClient has no timeout of its own, each attempt sets one
+var probeClient = &http.Client{}
+
+// probeTunnel probes the public URL of a tunnel with its probe options,
+// retrying up to probe_retries times while the URL is unreachable
+func probeTunnel(ctx context.Context, publicURL string, tunnelCfg *config.TunnelConfig) Reachability {
+	target := strings.TrimSuffix(publicURL, "/") + cmp.Or(tunnelCfg.ProbePath, "/")
+	timeout := tunnelCfg.ProbeTimeoutDuration()
+
+	var result Reachability
+	for attempt := 1; ; attempt++ {
+		result = probeURL(ctx, target, timeout)
+		result.Attempts = attempt
+		if result.Reachable || attempt > tunnelCfg.ProbeRetries {
+			return result
+		}
+
+		select {
+		case <-ctx.Done():
+			return result
+		case <-time.After(probeRetryDelay):
+		}
+	}
+}
+
+// probeURL requests url and reports whether it answered within timeout.
+// Responses below 500 count as reachable since they come from the upstream
+// service, while the providers answer with 502 and similar when the upstream
+// is down.
+func probeURL(ctx context.Context, url string, timeout time.Duration) Reachability {
+	result := Reachability{CheckedAt: time.Now()}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	req.Header.Set("User-Agent", "pont-probe")
+
+	resp, err := probeClient.Do(req)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	resp.Body.Close()
+
+	result.StatusCode = resp.StatusCode
+	result.Reachable = resp.StatusCode < http.StatusInternalServerError
+	return result
+}
+
+// probeLoop probes the public URL of a running tunnel until ctx is done. The
+// tunnel configuration is read again every round, so enabling or disabling
+// the probe and changing its interval apply without a restart.
+func (m *Manager) probeLoop(ctx context.Context, id string, state *TunnelState, run uint64) {
+	for {
+		interval := config.DefaultProbeInterval
+
+		m.mu.RLock()
+		service := state.service
+		m.mu.RUnlock()
+
+		var result *Reachability
+		tunnelCfg, err := m.cfgMgr.GetTunnel(id)
+		if err == nil && tunnelCfg.ProbeEnabled {
+			interval = tunnelCfg.ProbeIntervalDuration()
+			url := service.GetPublicURL()
+			switch {
+			case strings.HasPrefix(url, "http"):
+				r := probeTunnel(ctx, url, tunnelCfg)
+				if ctx.Err() != nil {
+					return
+				}
+				result = &r
+			case url == "":
+				interval = min(interval, probeURLWait)
+			}
+		}
+
+		m.mu.Lock()
+		if state.run != run {
+			m.mu.Unlock()
+			return
+		}
+		changed := result != nil || state.Reachability != nil
+		state.Reachability = result
+		m.mu.Unlock()
+		if changed {
+			m.invalidateStatusCache()
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+	}
+}
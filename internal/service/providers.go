@@ -0,0 +1,215 @@
+package service
+
+import (
+	"pont/internal/config"
+	"runtime/debug"
+	"sort"
+	"strconv"
+)
+
+// FieldMeta describes a tunnel configuration field so clients can render and
+// validate provider-specific forms
+type FieldMeta struct {
+	Key           string   `json:"key"`
+	Type          string   `json:"type"` // "string", "bool", "int", "duration", "map" or "enum"
+	Required      bool     `json:"required"`
+	Secret        bool     `json:"secret,omitempty"`
+	AllowedValues []string `json:"allowed_values,omitempty"`
+	Description   string   `json:"description"`
+	Hint          string   `json:"hint,omitempty"`
+}
+
+// ProviderMeta describes a tunnel provider
+type ProviderMeta struct {
+	Type          config.TunnelType `json:"type"`
+	Name          string            `json:"name"`
+	TargetSchemes []string          `json:"target_schemes"`
+	Fields        []FieldMeta       `json:"fields"`
+
+	// Module is the Go module of the embedded client, Capabilities the
+	// entries of AllCapabilities this provider supports in Pont
+	Module       string   `json:"-"`
+	Capabilities []string `json:"-"`
+}
+
+// AllCapabilities lists every capability reported by ProviderDetails, so a
+// missing one shows up as false rather than being left out
+var AllCapabilities = []string{
+	"http",
+	"tcp",
+	"tls",
+	"custom_domain",
+	"no_account_required",
+	"named_tunnels",
+	"basic_auth",
+	"webhook_verification",
+	"internal_endpoints",
+	"rate_limit",
+	"origin_connect_timeout",
+	"origin_tls_skip_verify",
+	"host_header_rewrite",
+	"edge_region",
+}
+
+// ProviderInfo describes the client embedded for a provider
+type ProviderInfo struct {
+	Type          config.TunnelType `json:"type"`
+	Name          string            `json:"name"`
+	Module        string            `json:"module"`
+	Version       string            `json:"version"`
+	Capabilities  map[string]bool   `json:"capabilities"`
+	TargetSchemes []string          `json:"target_schemes"`
+}
+
+// provider couples a provider's metadata with its service constructor
+type provider struct {
+	meta       ProviderMeta
+	newService func(cfg *config.TunnelConfig, opts Options) TunnelService
+}
+
+// commonFields apply to every tunnel type
+var commonFields = []FieldMeta{
+	{Key: "name", Type: "string", Required: true, Description: "Unique tunnel name"},
+	{Key: "target", Type: "string", Required: true, Description: "Local service to expose", Hint: "${VAR} placeholders are resolved from the environment at start"},
+	{Key: "group", Type: "string", Description: "Group for starting and stopping tunnels together", Hint: "must not contain '/', '?' or '#'"},
+	{Key: "enabled", Type: "bool", Description: "Start the tunnel when auto start is on"},
+	{Key: "mcp_enabled", Type: "bool", Description: "Allow the tunnel to be managed via MCP"},
+	{Key: "favorite", Type: "bool", Description: "List the tunnel first to MCP clients"},
+	{Key: "error_grace", Type: "duration", Description: "How long a transient error is hidden while running", Hint: "e.g. 30s, max " + config.MaxErrorGrace.String()},
+	{Key: "probe_enabled", Type: "bool", Description: "Periodically check that the public URL answers", Hint: "HTTP tunnels only"},
+	{Key: "probe_interval", Type: "duration", Description: "Time between reachability probes", Hint: "default " + config.DefaultProbeInterval.String() + ", between " + config.MinProbeInterval.String() + " and " + config.MaxProbeInterval.String()},
+	{Key: "probe_timeout", Type: "duration", Description: "Timeout of one probe request", Hint: "default " + config.DefaultProbeTimeout.String() + ", between " + config.MinProbeTimeout.String() + " and " + config.MaxProbeTimeout.String()},
+	{Key: "probe_retries", Type: "int", Description: "Extra attempts before the tunnel is reported unreachable", Hint: "0 to " + strconv.Itoa(config.MaxProbeRetries)},
+	{Key: "probe_path", Type: "string", Description: "Path requested by the probe", Hint: "default /, e.g. /healthz"},
+	{Key: "pre_start_hook", Type: "string", Description: "Shell command run before the tunnel starts; the start fails if it does", Hint: "requires ALLOW_HOOKS=true"},
+	{Key: "post_stop_hook", Type: "string", Description: "Shell command run after the tunnel stops", Hint: "requires ALLOW_HOOKS=true"},
+}
+
+// providers is the registry of supported tunnel types
+var providers = map[config.TunnelType]provider{
+	config.TunnelTypeCloudflare: {
+		meta: ProviderMeta{
+			Type:          config.TunnelTypeCloudflare,
+			Name:          "Cloudflare Quick Tunnel",
+			TargetSchemes: []string{"http", "https"},
+			Module:        "github.com/cloudflare/cloudflared",
+			Capabilities: []string{
+				"http", "no_account_required",
+				"origin_connect_timeout", "origin_tls_skip_verify", "host_header_rewrite",
+				"edge_region", "custom_error_page",
+			},
+			Fields: []FieldMeta{
+				{Key: "cloudflare_connect_timeout", Type: "duration", Description: "Timeout for connecting to the target", Hint: "e.g. 45s, max " + config.MaxCloudflareConnectTimeout.String()},
+				{Key: "cloudflare_no_tls_verify", Type: "bool", Description: "Accept self-signed certificates from the target", Hint: "requires an https:// target"},
+				{Key: "cloudflare_http_host_header", Type: "string", Description: "Host header sent to the target"},
+				{Key: "cloudflare_region", Type: "enum", AllowedValues: config.CloudflareRegions, Description: "Edge region to connect to", Hint: "empty for the global region"},
+				{Key: "cloudflare_env", Type: "map", AllowedValues: cloudflareEnvKeys(), Description: "cloudflared environment variables, applied as flags"},
+				{Key: "cloudflare_error_page", Type: "string", Description: "HTML page shown while the target is unreachable", Hint: "max " + strconv.Itoa(config.MaxCloudflareErrorPageSize/1024) + " KiB"},
+			},
+		},
+		newService: func(cfg *config.TunnelConfig, opts Options) TunnelService {
+			return NewCloudflareService(cfg, opts.URLCaptureTimeout, opts.CloudflareStopTimeout)
+		},
+	},
+	config.TunnelTypeNgrok: {
+		meta: ProviderMeta{
+			Type:          config.TunnelTypeNgrok,
+			Name:          "ngrok",
+			TargetSchemes: []string{"http", "https", "tcp", "tls"},
+			Module:        "golang.ngrok.com/ngrok/v2",
+			Capabilities: []string{
+				"http", "tcp", "tls", "custom_domain",
+				"webhook_verification", "internal_endpoints", "rate_limit",
+			},
+			Fields: []FieldMeta{
+				{Key: "ngrok_authtoken", Type: "string", Secret: true, Description: "ngrok authtoken of the account", Hint: "required unless NGROK_AUTHTOKEN is set or ngrok_credential is used"},
+				{Key: "ngrok_credential", Type: "string", Description: "Name of a stored ngrok credential to use instead of ngrok_authtoken", Hint: "see /api/ngrok/credentials"},
+				{Key: "ngrok_domain", Type: "string", Description: "Reserved domain or URL of the endpoint", Hint: "must end in .internal for internal endpoints"},
+				{Key: "ngrok_webhook_provider", Type: "enum", AllowedValues: ngrokWebhookProviderNames(), Description: "Verify webhook signatures of this provider at the edge", Hint: "HTTP targets only"},
+				{Key: "ngrok_webhook_secret", Type: "string", Secret: true, Description: "Webhook signing secret", Hint: "required with ngrok_webhook_provider"},
+				{Key: "ngrok_internal", Type: "bool", Description: "Create an internal endpoint reachable only through ngrok", Hint: "HTTP targets only"},
+				{Key: "idle_timeout", Type: "duration", Description: "Stop the tunnel after this long without traffic", Hint: "e.g. 30m, between " + config.MinIdleTimeout.String() + " and " + config.MaxIdleTimeout.String()},
+				{Key: "ngrok_rate_limit", Type: "string", Description: "Maximum requests per client IP and time window, e.g. 100/1m", Hint: "HTTP targets only"},
+			},
+		},
+		newService: func(cfg *config.TunnelConfig, opts Options) TunnelService {
+			return NewNgrokService(cfg, opts.NgrokAuthtoken, opts.ngrokCredential)
+		},
+	},
+}
+
+// Providers returns the metadata of every supported tunnel type, each with
+// the common fields followed by its own
+func Providers() []ProviderMeta {
+	result := make([]ProviderMeta, 0, len(providers))
+	for _, p := range providers {
+		meta := p.meta
+		meta.TargetSchemes = append([]string(nil), meta.TargetSchemes...)
+		meta.Fields = append(append([]FieldMeta(nil), commonFields...), meta.Fields...)
+		result = append(result, meta)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Type < result[j].Type })
+	return result
+}
+
+// ProviderDetails returns the embedded client version and capabilities of
+// every supported tunnel type
+func ProviderDetails() []ProviderInfo {
+	result := make([]ProviderInfo, 0, len(providers))
+	for _, p := range providers {
+		caps := make(map[string]bool, len(AllCapabilities))
+		for _, c := range AllCapabilities {
+			caps[c] = false
+		}
+		for _, c := range p.meta.Capabilities {
+			caps[c] = true
+		}
+		result = append(result, ProviderInfo{
+			Type:          p.meta.Type,
+			Name:          p.meta.Name,
+			Module:        p.meta.Module,
+			Version:       moduleVersion(p.meta.Module),
+			Capabilities:  caps,
+			TargetSchemes: append([]string(nil), p.meta.TargetSchemes...),
+		})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Type < result[j].Type })
+	return result
+}
+
+// moduleVersion is the version of a dependency compiled into the binary,
+// following replace directives
+func moduleVersion(path string) string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "unknown"
+	}
+	for _, dep := range info.Deps {
+		if dep.Path != path {
+			continue
+		}
+		if dep.Replace != nil {
+			return dep.Replace.Version
+		}
+		return dep.Version
+	}
+	return "unknown"
+}
+
+func cloudflareEnvKeys() []string {
+	keys := make([]string, 0, len(config.CloudflareEnvFlags))
+	for key := range config.CloudflareEnvFlags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func ngrokWebhookProviderNames() []string {
+	names := make([]string, 0, len(config.NgrokWebhookProviders))
+	for name := range config.NgrokWebhookProviders {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -1,4 +1,4 @@
-const API_BASE = '/api';
+const API_BASE = 'api';
 
 const state = {
     tunnels: [],
@@ -7,7 +7,10 @@ const state = {
     logStream: null,
     isStreamConnected: false,
     editingTunnelId: null,
-    pendingDeleteId: null
+    pendingDeleteId: null,
+    authToken: localStorage.getItem('authToken') || '',
+    authPrompt: null,
+    authPromptDismissed: false
 };
 
 const elements = {
@@ -17,6 +20,7 @@ const elements = {
     clearLogsBtn: document.getElementById('clear-logs'),
     toggleStreamBtn: document.getElementById('toggle-stream'),
     themeToggle: document.getElementById('theme-toggle'),
+    authTokenBtn: document.getElementById('auth-token-btn'),
     addTunnelBtn: document.getElementById('add-tunnel-btn'),
     tunnelModal: document.getElementById('tunnel-modal'),
     tunnelForm: document.getElementById('tunnel-form'),
@@ -62,6 +66,51 @@ function toggleTheme() {
     localStorage.setItem('theme', state.currentTheme);
 }
 
+// promptAuthToken asks for the API token (AUTH_TOKEN) and stores it in
+// localStorage; concurrent callers share one prompt
+function promptAuthToken() {
+    if (!state.authPrompt) {
+        state.authPrompt = Promise.resolve().then(() => {
+            const token = window.prompt(i18n.t('ui.auth.prompt'), state.authToken);
+            if (token === null) {
+                state.authPromptDismissed = true;
+                return false;
+            }
+            state.authToken = token.trim();
+            state.authPromptDismissed = false;
+            if (state.authToken) {
+                localStorage.setItem('authToken', state.authToken);
+            } else {
+                localStorage.removeItem('authToken');
+            }
+            return true;
+        }).finally(() => {
+            state.authPrompt = null;
+        });
+    }
+    return state.authPrompt;
+}
+
+// apiFetch calls the API with the stored token and, when the server answers
+// 401, asks for the token and retries once
+async function apiFetch(path, options = {}) {
+    const send = () => {
+        const headers = { ...(options.headers || {}) };
+        if (state.authToken) headers['Authorization'] = `Bearer ${state.authToken}`;
+        return fetch(`${API_BASE}/${path}`, { ...options, headers });
+    };
+
+    const res = await send();
+    if (res.status !== 401 || state.authPromptDismissed) return res;
+    if (!await promptAuthToken()) return res;
+    return send();
+}
+
+// tokenQuery passes the token to EventSource, which cannot set headers
+function tokenQuery() {
+    return state.authToken ? `?token=${encodeURIComponent(state.authToken)}` : '';
+}
+
 async function init() {
     // Load i18n first
     console.log('[i18n] Loading translations for locale:', i18n.getLocale());
@@ -86,7 +135,7 @@ async function init() {
 
 async function fetchVersion() {
     try {
-        const res = await fetch(`${API_BASE}/version`);
+        const res = await apiFetch('version');
         const data = await res.json();
         elements.versionInfo.textContent = data.version.startsWith('v') ? data.version : `v${data.version}`;
         elements.versionInfo.title = `Version: ${data.version}\nBuild: ${data.build_time}\nCommit: ${data.git_commit}`;
@@ -98,7 +147,8 @@ async function fetchVersion() {
 
 async function fetchTunnels() {
     try {
-        const res = await fetch(`${API_BASE}/tunnels`);
+        const res = await apiFetch('tunnels');
+        if (!res.ok) throw new Error(await res.text());
         state.tunnels = await res.json();
         renderTunnels();
     } catch (err) {
@@ -109,7 +159,7 @@ async function fetchTunnels() {
 
 async function fetchStatuses() {
     try {
-        const res = await fetch(`${API_BASE}/status`);
+        const res = await apiFetch('status');
         state.statuses = await res.json();
         updateTunnelStatuses();
     } catch (err) {
@@ -157,6 +207,7 @@ function renderTunnels() {
                     ${errorHtml}
                 </div>
                 <div class="tunnel-actions">
+                    ${reachabilityIndicator(status)}
                     <div class="status-indicator ${status.status}" title="${statusText}"></div>
                     ${actionBtn}
                     <button class="btn btn-ghost btn-sm" data-action="edit">${i18n.t('ui.tunnel.edit')}</button>
@@ -184,6 +235,16 @@ function renderTunnels() {
     });
 }
 
+// Separate dot for the last probe of the public URL, when probes are enabled
+function reachabilityIndicator(status) {
+    const probe = status.reachability;
+    if (!probe) return '';
+    const cls = probe.reachable ? 'reachable' : 'unreachable';
+    let title = i18n.t(`ui.tunnel.${cls}`);
+    if (probe.status_code) title += ` (HTTP ${probe.status_code})`;
+    return `<div class="reachability-indicator ${cls}" title="${title}"></div>`;
+}
+
 function updateTunnelStatuses() {
     state.tunnels.forEach(tunnel => {
         const status = state.statuses[tunnel.id] || { status: 'stopped' };
@@ -198,6 +259,7 @@ function updateTunnelStatuses() {
             `<button class="btn btn-success btn-sm" data-action="start">${i18n.t('ui.tunnel.start')}</button>`;
 
         actions.innerHTML = `
+            ${reachabilityIndicator(status)}
             <div class="status-indicator ${status.status}" title="${statusText}"></div>
             ${buttons}
             <button class="btn btn-ghost btn-sm" data-action="edit">${i18n.t('ui.tunnel.edit')}</button>
@@ -259,12 +321,22 @@ function updateTunnelStatuses() {
     });
 }
 
+// Show the state returned by start/stop without waiting for the next poll
+function applyTunnelState(id, body) {
+    if (!body || !body.tunnel) {
+        fetchStatuses();
+        return;
+    }
+    state.statuses = { ...state.statuses, [id]: body.tunnel };
+    updateTunnelStatuses();
+}
+
 async function startTunnel(id) {
     try {
-        const res = await fetch(`${API_BASE}/tunnels/${id}/start`, { method: 'POST' });
+        const res = await apiFetch(`tunnels/${id}/start`, { method: 'POST' });
         if (!res.ok) throw new Error(await res.text());
         addLog(`Starting tunnel ${id}…`, 'info');
-        await fetchStatuses();
+        applyTunnelState(id, await res.json());
     } catch (err) {
         addLog(`Failed to start tunnel: ${err.message}`, 'error');
     }
@@ -272,10 +344,10 @@ async function startTunnel(id) {
 
 async function stopTunnel(id) {
     try {
-        const res = await fetch(`${API_BASE}/tunnels/${id}/stop`, { method: 'POST' });
+        const res = await apiFetch(`tunnels/${id}/stop`, { method: 'POST' });
         if (!res.ok) throw new Error(await res.text());
         addLog(`Stopping tunnel ${id}…`, 'info');
-        await fetchStatuses();
+        applyTunnelState(id, await res.json());
     } catch (err) {
         addLog(`Failed to stop tunnel: ${err.message}`, 'error');
     }
@@ -332,9 +404,12 @@ function editTunnel(id) {
 
     if (tunnel.type === 'ngrok') {
         elements.ngrokFields.style.display = 'block';
-        authtokenInput.value = tunnel.ngrok_authtoken || '';
-        authtokenInput.required = true;
-        if (authtokenRequired) authtokenRequired.style.display = 'inline';
+        // The API redacts stored tokens; leaving the field empty keeps it
+        const storedToken = !!tunnel.ngrok_authtoken;
+        authtokenInput.value = '';
+        authtokenInput.placeholder = storedToken ? i18n.t('ui.modal.secret_stored') : '';
+        authtokenInput.required = !storedToken;
+        if (authtokenRequired) authtokenRequired.style.display = storedToken ? 'none' : 'inline';
         document.getElementById('ngrok-domain').value = tunnel.ngrok_domain || '';
         Array.from(elements.tunnelProtocol.options).forEach(opt => {
             opt.disabled = false;
@@ -363,7 +438,7 @@ async function confirmDeleteTunnel() {
     if (!id) return;
 
     try {
-        const res = await fetch(`${API_BASE}/tunnels/${id}`, { method: 'DELETE' });
+        const res = await apiFetch(`tunnels/${id}`, { method: 'DELETE' });
         if (!res.ok) throw new Error(await res.text());
         addLog(`Deleted tunnel ${id}`, 'info');
         await fetchTunnels();
@@ -388,6 +463,7 @@ function openAddTunnelModal() {
     const authtokenInput = document.getElementById('ngrok-authtoken');
     const authtokenRequired = document.getElementById('ngrok-authtoken-required');
     authtokenInput.required = false;
+    authtokenInput.placeholder = '';
     if (authtokenRequired) authtokenRequired.style.display = 'none';
     elements.tunnelModal.classList.add('active');
 }
@@ -415,20 +491,22 @@ async function saveTunnel(e) {
     };
 
     if (tunnel.type === 'ngrok') {
-        tunnel.ngrok_authtoken = document.getElementById('ngrok-authtoken').value;
+        // Omitted when left empty on edit so the stored token is kept
+        const authtoken = document.getElementById('ngrok-authtoken').value;
+        if (authtoken || !state.editingTunnelId) tunnel.ngrok_authtoken = authtoken;
         tunnel.ngrok_domain = document.getElementById('ngrok-domain').value;
     }
 
     try {
         let res;
         if (state.editingTunnelId) {
-            res = await fetch(`${API_BASE}/tunnels/${state.editingTunnelId}`, {
+            res = await apiFetch(`tunnels/${state.editingTunnelId}`, {
                 method: 'PUT',
                 headers: { 'Content-Type': 'application/json' },
                 body: JSON.stringify(tunnel)
             });
         } else {
-            res = await fetch(`${API_BASE}/tunnels`, {
+            res = await apiFetch('tunnels', {
                 method: 'POST',
                 headers: { 'Content-Type': 'application/json' },
                 body: JSON.stringify(tunnel)
@@ -449,7 +527,7 @@ function connectLogStream() {
     if (state.isStreamConnected) return;
 
     try {
-        state.logStream = new EventSource(`${API_BASE}/logs/stream`);
+        state.logStream = new EventSource(`${API_BASE}/logs/stream${tokenQuery()}`);
 
         state.logStream.onmessage = (event) => {
             const entry = JSON.parse(event.data);
@@ -506,6 +584,14 @@ function clearLogs() {
 }
 
 elements.themeToggle.addEventListener('click', toggleTheme);
+elements.authTokenBtn.addEventListener('click', async () => {
+    if (!await promptAuthToken()) return;
+    await fetchTunnels();
+    if (state.isStreamConnected) {
+        disconnectLogStream();
+        connectLogStream();
+    }
+});
 elements.addTunnelBtn.addEventListener('click', openAddTunnelModal);
 elements.clearLogsBtn.addEventListener('click', clearLogs);
 elements.toggleStreamBtn.addEventListener('click', toggleLogStream);
@@ -527,9 +613,11 @@ elements.tunnelType.addEventListener('change', (e) => {
     elements.ngrokFields.style.display = isNgrok ? 'block' : 'none';
     const authtokenInput = document.getElementById('ngrok-authtoken');
     const authtokenRequired = document.getElementById('ngrok-authtoken-required');
-    authtokenInput.required = isNgrok;
+    // A stored token (shown as a placeholder) need not be entered again
+    const needsToken = isNgrok && !authtokenInput.placeholder;
+    authtokenInput.required = needsToken;
     if (authtokenRequired) {
-        authtokenRequired.style.display = isNgrok ? 'inline' : 'none';
+        authtokenRequired.style.display = needsToken ? 'inline' : 'none';
     }
 
     // Cloudflare only supports HTTP/HTTPS
@@ -574,7 +662,7 @@ elements.tunnelModal.addEventListener('click', (e) => {
 // MCP functions
 async function loadMCPInfo() {
     try {
-        const response = await fetch('/api/mcp/info');
+        const response = await apiFetch('mcp/info');
         const data = await response.json();
 
         if (data.endpoint) {
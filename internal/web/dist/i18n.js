@@ -25,13 +25,13 @@ class I18n {
 
     async load() {
         try {
-            const response = await fetch(`/locales/${this.locale}.json`);
+            const response = await fetch(`locales/${this.locale}.json`);
             this.translations = await response.json();
         } catch (err) {
             console.error(`Failed to load locale ${this.locale}:`, err);
             // Try fallback
             if (this.locale !== this.fallbackLocale) {
-                const response = await fetch(`/locales/${this.fallbackLocale}.json`);
+                const response = await fetch(`locales/${this.fallbackLocale}.json`);
                 this.translations = await response.json();
             }
         }
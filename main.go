@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"os/signal"
 	"path/filepath"
@@ -11,102 +12,228 @@ import (
 
 	"pont/internal/config"
 	"pont/internal/db"
+	"pont/internal/i18n"
 	"pont/internal/logger"
 	"pont/internal/server"
 	"pont/internal/service"
+	"pont/internal/tracing"
 	"pont/version"
 )
 
 func main() {
-	// Get environment variables
-	dataDir := getEnv("DATA_DIR", "./data")
-	logDir := getEnv("LOG_DIR", filepath.Join(dataDir, "logs"))
-	logLevel := getEnv("LOG_LEVEL", "info")
-	port := getEnv("PORT", "13333")
-
-	// Ensure directories exist
-	if err := os.MkdirAll(dataDir, 0755); err != nil {
-		fmt.Fprintf(os.Stderr, "Failed to create data directory: %v\n", err)
+	cfg, err := config.LoadAppConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid configuration:\n%v\n", err)
 		os.Exit(1)
 	}
-	if err := os.MkdirAll(logDir, 0755); err != nil {
-		fmt.Fprintf(os.Stderr, "Failed to create log directory: %v\n", err)
-		os.Exit(1)
+
+	// Subcommands run without starting the server
+	if len(os.Args) > 1 {
+		switch cmd := os.Args[1]; cmd {
+		case "relocate":
+			if err := runRelocate(cfg.DataDir, os.Args[2:]); err != nil {
+				fmt.Fprintf(os.Stderr, "Relocate failed: %v\n", err)
+				os.Exit(1)
+			}
+		case "migrate":
+			if err := runMigrate(cfg, os.Args[2:]); err != nil {
+				fmt.Fprintf(os.Stderr, "Migration failed: %v\n", err)
+				os.Exit(1)
+			}
+		default:
+			if err := runCLI(cfg, cmd, os.Args[2:]); err != nil {
+				fmt.Fprintf(os.Stderr, "pont %s: %v\n", cmd, err)
+				os.Exit(1)
+			}
+		}
+		return
 	}
 
-	// Initialize logger
-	logFile := filepath.Join(logDir, "pont.log")
-	if err := logger.Init(logLevel, logFile); err != nil {
-		fmt.Fprintf(os.Stderr, "Failed to initialize logger: %v\n", err)
+	if err := initLogging(cfg); err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
 		os.Exit(1)
 	}
 	defer logger.Sync()
 
 	logger.Sugar.Infof("Starting Pont %s", version.GetFullVersion())
-	logger.Sugar.Infof("Data directory: %s", dataDir)
-	logger.Sugar.Infof("Log directory: %s", logDir)
+	logger.Sugar.Infof("Data directory: %s", cfg.DataDir)
+	logger.Sugar.Infof("Log directory: %s", cfg.LogDir)
+
+	// Load translations; a DEFAULT_LANG without a locale falls back to English
+	if err := i18n.Init(); err != nil {
+		logger.Sugar.Warnf("Failed to load translations: %v", err)
+	} else if err := i18n.SetDefaultLanguage(cfg.DefaultLang); err != nil {
+		logger.Sugar.Warnf("DEFAULT_LANG: %v, using %s", err, i18n.DefaultLanguage())
+	}
+
+	// Export traces when an OTLP endpoint is configured
+	shutdownTracing, err := tracing.Init(context.Background(), cfg.OTLPEndpoint)
+	if err != nil {
+		logger.Sugar.Warnf("Tracing disabled: %v", err)
+	} else if cfg.OTLPEndpoint != "" {
+		logger.Sugar.Infof("Exporting traces to %s", cfg.OTLPEndpoint)
+	}
 
 	// Start log cleanup routine
 	logger.StartCleanupRoutine()
 
 	// Initialize database
-	client, err := db.Init(dataDir)
+	client, err := db.Init(cfg.DataDir, db.Options{
+		AutoMigrate: cfg.DBAutoMigrate,
+		WAL:         cfg.DBWAL,
+		MoveCorrupt: cfg.DBMoveCorrupt,
+	})
 	if err != nil {
 		logger.Sugar.Fatalf("Failed to initialize database: %v", err)
 	}
-	defer client.Close()
 
 	logger.Sugar.Info("Database initialized successfully")
 
 	// Initialize configuration manager
-	cfgMgr := config.NewManager(client)
+	cfgMgr := config.NewManager(client, cfg.Namespace)
+	cfgMgr.SetTargetAllowlist(cfg.TargetAllowlist)
+	cfgMgr.SetLengthLimits(cfg.TunnelNameMaxLen, cfg.TunnelTargetMaxLen)
+	cfgMgr.SetAllowHooks(cfg.AllowHooks)
 	logger.Sugar.Info("Configuration manager initialized")
 
 	// Initialize service manager
-	svcMgr := service.NewManager(cfgMgr)
+	svcMgr := service.NewManager(cfgMgr, service.Options{
+		StatusCacheTTL:        cfg.StatusCacheTTL,
+		URLCaptureTimeout:     cfg.CloudflareURLTimeout,
+		CloudflareStopTimeout: cfg.CloudflareStopTimeout,
+		NgrokAuthtoken:        cfg.NgrokAuthtoken,
+		AllowHooks:            cfg.AllowHooks,
+		HookTimeout:           cfg.HookTimeout,
+	})
 	logger.Sugar.Info("Service manager initialized")
 
 	// Initialize HTTP server
-	addr := "0.0.0.0:" + port
-	srv := server.NewServer(addr, cfgMgr, svcMgr)
+	srv := server.NewServer(cfg, cfgMgr, svcMgr)
 
-	// Start server in goroutine
+	// Start the servers in goroutines
+	serverErr := make(chan error, 2)
+	go func() {
+		if err := srv.Start(); err != nil && err != http.ErrServerClosed {
+			serverErr <- err
+		}
+	}()
 	go func() {
-		logger.Sugar.Infof("HTTP server listening on %s", addr)
-		if err := srv.Start(); err != nil {
+		if err := srv.StartMCP(); err != nil && err != http.ErrServerClosed {
+			serverErr <- err
+		}
+	}()
+
+	// Wait until the ports are bound before doing any other work
+	for _, ready := range []<-chan struct{}{srv.Ready(), srv.MCPReady()} {
+		select {
+		case <-ready:
+		case err := <-serverErr:
 			logger.Sugar.Fatalf("HTTP server error: %v", err)
 		}
+	}
+	var listening []interface{}
+	if !cfg.UnixSocketOnly {
+		listening = append(listening, "address", cfg.Addr())
+	}
+	if cfg.UnixSocket != "" {
+		listening = append(listening, "unix_socket", cfg.UnixSocket)
+	}
+	if mcpAddr := cfg.MCPAddr(); mcpAddr != "" {
+		listening = append(listening, "mcp_address", mcpAddr)
+	}
+	logger.Lifecycle("Server started", append(listening, "version", version.GetVersion())...)
+
+	// Post tunnel status changes to the webhook_url setting
+	notifyCtx, stopNotifying := context.WithCancel(context.Background())
+	notifyDone := make(chan struct{})
+	go func() {
+		defer close(notifyDone)
+		svcMgr.NotifyLoop(notifyCtx)
+	}()
+
+	// Start tunnels marked enabled when auto start is on
+	svcMgr.AutoStart()
+	logger.Lifecycle("Auto-start complete")
+
+	// Delete old audit entries and URL history when a retention is set
+	pruneCtx, stopPruning := context.WithCancel(context.Background())
+	pruneDone := make(chan struct{})
+	go func() {
+		defer close(pruneDone)
+		cfgMgr.PruneLoop(pruneCtx, cfg.PruneInterval, cfg.PruneRetention)
 	}()
 
-	// Wait for interrupt signal
+	// SIGUSR1 logs diagnostics, see diagnostics.go
+	stopDiagnostics := startDiagnostics(cfg.LogDir, svcMgr)
+
+	// Wait for interrupt signal or a server failure
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
-	<-sigChan
+	select {
+	case <-sigChan:
+	case err := <-serverErr:
+		logger.Sugar.Errorf("HTTP server error: %v", err)
+	}
 
-	logger.Sugar.Info("Shutdown signal received, gracefully shutting down...")
+	logger.Lifecycle("Shutdown initiated")
 
 	// Create shutdown context with timeout
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
-	// Stop all tunnels
+	// Everything that uses the database stops before it is closed: first the
+	// HTTP server, so no request is in flight and none starts a tunnel, then
+	// the tunnels with their probe and idle goroutines
+	logger.Sugar.Info("Shutting down HTTP server...")
+	if err := srv.Shutdown(ctx); err != nil {
+		logger.Sugar.Warnf("Error shutting down server: %v", err)
+	}
+
 	logger.Sugar.Info("Stopping all tunnels...")
-	if err := svcMgr.StopAll(); err != nil {
+	if err := svcMgr.Shutdown(ctx); err != nil {
 		logger.Sugar.Warnf("Error stopping tunnels: %v", err)
 	}
+	logger.Lifecycle("All tunnels stopped")
 
-	// Shutdown HTTP server
-	logger.Sugar.Info("Shutting down HTTP server...")
-	if err := srv.Shutdown(ctx); err != nil {
-		logger.Sugar.Warnf("Error shutting down server: %v", err)
+	stopPruning()
+	<-pruneDone
+	stopNotifying()
+	<-notifyDone
+
+	// Diagnostics read tunnel statuses from the database too
+	stopDiagnostics()
+
+	// Flush pending spans
+	if shutdownTracing != nil {
+		if err := shutdownTracing(ctx); err != nil {
+			logger.Sugar.Warnf("Error flushing traces: %v", err)
+		}
 	}
 
-	logger.Sugar.Info("Shutdown complete")
+	if err := client.Close(); err != nil {
+		logger.Sugar.Warnf("Error closing database: %v", err)
+	}
+
+	logger.Lifecycle("Shutdown complete")
 }
 
-func getEnv(key, defaultValue string) string {
-	if value := os.Getenv(key); value != "" {
-		return value
+// initLogging creates the data and log directories and initializes the logger
+func initLogging(cfg *config.AppConfig) error {
+	if err := os.MkdirAll(cfg.DataDir, 0755); err != nil {
+		return fmt.Errorf("failed to create data directory: %w", err)
+	}
+	if err := os.MkdirAll(cfg.LogDir, 0755); err != nil {
+		return fmt.Errorf("failed to create log directory: %w", err)
+	}
+
+	if err := logger.Init(logger.Options{
+		Level:          cfg.LogLevel,
+		File:           filepath.Join(cfg.LogDir, "pont.log"),
+		Location:       cfg.LogLocation(),
+		BroadcastQueue: cfg.LogBroadcastQueue,
+		MaxMessageSize: cfg.LogMaxMessageSize,
+	}); err != nil {
+		return fmt.Errorf("failed to initialize logger: %w", err)
 	}
-	return defaultValue
+	return nil
 }
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"pont/internal/config"
+	"pont/internal/db"
+)
+
+// runMigrate implements "pont migrate": it applies the database schema and
+// exits, so deployments can migrate as a step of their own before starting
+// Pont. DB_AUTO_MIGRATE does not apply; migrating is the point.
+func runMigrate(cfg *config.AppConfig, args []string) error {
+	if len(args) != 0 {
+		return fmt.Errorf("usage: pont migrate")
+	}
+
+	if err := initLogging(cfg); err != nil {
+		return err
+	}
+
+	client, err := db.Init(cfg.DataDir, db.Options{
+		AutoMigrate: true,
+		WAL:         cfg.DBWAL,
+		MoveCorrupt: cfg.DBMoveCorrupt,
+	})
+	if err != nil {
+		return err
+	}
+	if err := client.Close(); err != nil {
+		return err
+	}
+
+	fmt.Printf("Database schema of %s is up to date\n", filepath.Join(cfg.DataDir, "pont.db"))
+	return nil
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"pont/internal/db"
+)
+
+// runRelocate implements "pont relocate <new-data-dir>": it copies the
+// database and every other file in dataDir to a new directory, leaving the
+// original untouched. Pont must not be running while it executes.
+func runRelocate(dataDir string, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("usage: pont relocate <new-data-dir>")
+	}
+
+	src, err := filepath.Abs(dataDir)
+	if err != nil {
+		return err
+	}
+	dst, err := filepath.Abs(args[0])
+	if err != nil {
+		return err
+	}
+	if src == dst || strings.HasPrefix(dst, src+string(filepath.Separator)) {
+		return fmt.Errorf("new data directory must be outside %s", src)
+	}
+
+	if err := os.MkdirAll(dst, 0755); err != nil {
+		return fmt.Errorf("failed to create %s: %w", dst, err)
+	}
+
+	// Snapshot the database first so a half-copied file never ends up in dst
+	if err := db.Export(src, filepath.Join(dst, "pont.db")); err != nil {
+		return err
+	}
+
+	err = filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		// The database and its journal files were exported above
+		if rel == "pont.db" || strings.HasPrefix(rel, "pont.db-") {
+			return nil
+		}
+		target := filepath.Join(dst, rel)
+		if d.IsDir() {
+			return os.MkdirAll(target, 0755)
+		}
+		return copyFile(path, target)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to copy data directory: %w", err)
+	}
+
+	fmt.Printf("Copied %s to %s\n", src, dst)
+	fmt.Printf("Start Pont with DATA_DIR=%s; the old directory can be removed once everything works.\n", dst)
+	return nil
+}
+
+// copyFile copies a regular file, refusing to overwrite an existing one
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	info, err := in.Stat()
+	if err != nil {
+		return err
+	}
+
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, info.Mode().Perm())
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}